@@ -0,0 +1,171 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+type ListMCPAuditLogsOptions struct {
+	types.AuditLogExportFilters
+	StartTime time.Time
+	EndTime   time.Time
+	// Limit is the maximum number of audit logs to return. The server returns 100 audit logs if it isn't set.
+	Limit  int
+	Offset int
+	// SortBy is the field to sort by, such as "created_at". The server sorts by "created_at" if it isn't set.
+	SortBy string
+	// SortOrder is "asc" or "desc". The server sorts in descending order if it isn't set.
+	SortOrder string
+}
+
+func (o ListMCPAuditLogsOptions) query() url.Values {
+	q := url.Values{}
+	for key, values := range map[string][]string{
+		"user_id":                       o.UserIDs,
+		"mcp_id":                        o.MCPIDs,
+		"mcp_server_display_name":       o.MCPServerDisplayNames,
+		"mcp_server_catalog_entry_name": o.MCPServerCatalogEntryNames,
+		"call_type":                     o.CallTypes,
+		"call_identifier":               o.CallIdentifiers,
+		"session_id":                    o.SessionIDs,
+		"client_name":                   o.ClientNames,
+		"client_version":                o.ClientVersions,
+		"response_status":               o.ResponseStatuses,
+		"client_ip":                     o.ClientIPs,
+	} {
+		if len(values) > 0 {
+			q.Set(key, strings.Join(values, ","))
+		}
+	}
+	if o.Query != "" {
+		q.Set("query", o.Query)
+	}
+	if !o.StartTime.IsZero() {
+		q.Set("start_time", o.StartTime.Format(time.RFC3339))
+	}
+	if !o.EndTime.IsZero() {
+		q.Set("end_time", o.EndTime.Format(time.RFC3339))
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		q.Set("offset", strconv.Itoa(o.Offset))
+	}
+	if o.SortBy != "" {
+		q.Set("sort_by", o.SortBy)
+	}
+	if o.SortOrder != "" {
+		q.Set("sort_order", o.SortOrder)
+	}
+	return q
+}
+
+func (c *Client) ListMCPAuditLogs(ctx context.Context, opts ListMCPAuditLogsOptions) (result types.MCPAuditLogResponse, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/mcp-audit-logs?"+opts.query().Encode(), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// GetMCPAuditLog returns an audit log, including the request and response if the caller is allowed to see them.
+func (c *Client) GetMCPAuditLog(ctx context.Context, id uint) (*types.MCPAuditLog, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-audit-logs/detail/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPAuditLog{})
+}
+
+type MCPUsageStatsOptions struct {
+	MCPID                      string
+	MCPServerDisplayNames      []string
+	MCPServerCatalogEntryNames []string
+	UserIDs                    []string
+	// StartTime and EndTime default to the last 24 hours.
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (c *Client) GetMCPUsageStats(ctx context.Context, opts MCPUsageStatsOptions) (*types.MCPUsageStats, error) {
+	q := url.Values{}
+	if opts.MCPID != "" {
+		q.Set("mcp_id", opts.MCPID)
+	}
+	for key, values := range map[string][]string{
+		"mcp_server_display_names":       opts.MCPServerDisplayNames,
+		"mcp_server_catalog_entry_names": opts.MCPServerCatalogEntryNames,
+		"user_ids":                       opts.UserIDs,
+	} {
+		if len(values) > 0 {
+			q.Set(key, strings.Join(values, ","))
+		}
+	}
+	if !opts.StartTime.IsZero() {
+		q.Set("start_time", opts.StartTime.Format(time.RFC3339))
+	}
+	if !opts.EndTime.IsZero() {
+		q.Set("end_time", opts.EndTime.Format(time.RFC3339))
+	}
+
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/mcp-stats?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPUsageStats{})
+}
+
+func (c *Client) CreateAuditLogExport(ctx context.Context, request types.AuditLogExportCreateRequest) (*types.AuditLogExportResponse, error) {
+	_, resp, err := c.postJSON(ctx, "/audit-log-exports", request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.AuditLogExportResponse{})
+}
+
+func (c *Client) GetAuditLogExport(ctx context.Context, id string) (*types.AuditLogExportResponse, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/audit-log-exports/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.AuditLogExportResponse{})
+}
+
+func (c *Client) ListAuditLogExports(ctx context.Context) (result types.AuditLogExportListResponse, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/audit-log-exports", nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+func (c *Client) DeleteAuditLogExport(ctx context.Context, id string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/audit-log-exports/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
@@ -76,6 +76,14 @@ func (c *Client) putJSON(ctx context.Context, path string, obj any, headerKV ...
 	return c.doRequest(ctx, http.MethodPut, path, bytes.NewBuffer(data), append(headerKV, "Content-Type", "application/json")...)
 }
 
+func (c *Client) patchJSON(ctx context.Context, path string, obj any, headerKV ...string) (*http.Request, *http.Response, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.doRequest(ctx, http.MethodPatch, path, bytes.NewBuffer(data), append(headerKV, "Content-Type", "application/json")...)
+}
+
 func (c *Client) postJSON(ctx context.Context, path string, obj any, headerKV ...string) (*http.Request, *http.Response, error) {
 	var body io.Reader
 
@@ -219,6 +227,27 @@ func toStream[T any](resp *http.Response) chan T {
 	return ch
 }
 
+// toLogStream returns the data of the "log" events of a log stream.
+func toLogStream(resp *http.Response) chan string {
+	ch := make(chan string)
+	go func() {
+		defer resp.Body.Close()
+		defer close(ch)
+		var eventName string
+		lines := bufio.NewScanner(resp.Body)
+		for lines.Scan() {
+			if data, ok := strings.CutPrefix(lines.Text(), "data: "); ok && eventName == "log" {
+				ch <- data
+			} else if event, ok := strings.CutPrefix(lines.Text(), "event: "); ok {
+				eventName = event
+			} else if strings.TrimSpace(lines.Text()) == "" {
+				eventName = ""
+			}
+		}
+	}()
+	return ch
+}
+
 func toObject[T any](resp *http.Response, obj T) (def T, _ error) {
 	defer resp.Body.Close()
 	if err := json.NewDecoder(resp.Body).Decode(obj); err != nil {
@@ -0,0 +1,36 @@
+package apiclient
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/openapi"
+)
+
+func TestGeneratedClientIsUpToDate(t *testing.T) {
+	expected, err := openapi.GenerateClient(openapi.Operations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := os.ReadFile("zz_generated.client.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(actual) != string(expected) {
+		t.Error("zz_generated.client.go is out of date, run go generate")
+	}
+}
+
+func TestOperationsHaveClientMethods(t *testing.T) {
+	client := reflect.TypeOf(&Client{})
+	for _, o := range openapi.Operations {
+		name := o.ID
+		if o.HandWritten != "" {
+			name = o.HandWritten
+		}
+		if _, ok := client.MethodByName(name); !ok {
+			t.Errorf("operation %s has no client method %s", o.ID, name)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+func (c *Client) ListMCPCatalogs(ctx context.Context) (result types.MCPCatalogList, err error) {
+	defer func() {
+		sort.Slice(result.Items, func(i, j int) bool {
+			return result.Items[i].Created.Time.Before(result.Items[j].Created.Time)
+		})
+	}()
+
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/mcp-catalogs", nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+func (c *Client) GetMCPCatalog(ctx context.Context, id string) (*types.MCPCatalog, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-catalogs/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPCatalog{})
+}
+
+func (c *Client) UpdateMCPCatalog(ctx context.Context, id string, manifest types.MCPCatalogManifest) (*types.MCPCatalog, error) {
+	_, resp, err := c.putJSON(ctx, fmt.Sprintf("/mcp-catalogs/%s", id), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPCatalog{})
+}
+
+// RefreshMCPCatalog starts a sync of the catalog from its source URLs.
+func (c *Client) RefreshMCPCatalog(ctx context.Context, id string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/mcp-catalogs/%s/refresh", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (c *Client) ListAccessControlRules(ctx context.Context, catalogID string) (result types.AccessControlRuleList, err error) {
+	defer func() {
+		sort.Slice(result.Items, func(i, j int) bool {
+			return result.Items[i].Created.Time.Before(result.Items[j].Created.Time)
+		})
+	}()
+
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-catalogs/%s/access-control-rules", catalogID), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+func (c *Client) GetAccessControlRule(ctx context.Context, catalogID, id string) (*types.AccessControlRule, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-catalogs/%s/access-control-rules/%s", catalogID, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.AccessControlRule{})
+}
+
+func (c *Client) CreateAccessControlRule(ctx context.Context, catalogID string, manifest types.AccessControlRuleManifest) (*types.AccessControlRule, error) {
+	_, resp, err := c.postJSON(ctx, fmt.Sprintf("/mcp-catalogs/%s/access-control-rules", catalogID), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.AccessControlRule{})
+}
+
+func (c *Client) UpdateAccessControlRule(ctx context.Context, catalogID, id string, manifest types.AccessControlRuleManifest) (*types.AccessControlRule, error) {
+	_, resp, err := c.putJSON(ctx, fmt.Sprintf("/mcp-catalogs/%s/access-control-rules/%s", catalogID, id), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.AccessControlRule{})
+}
+
+func (c *Client) DeleteAccessControlRule(ctx context.Context, catalogID, id string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/mcp-catalogs/%s/access-control-rules/%s", catalogID, id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
@@ -0,0 +1,187 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+type MCPServerOptions struct {
+	// CatalogID selects the multi-user servers of a catalog instead of the single-user servers of the caller.
+	CatalogID string
+}
+
+func (o MCPServerOptions) url(id string) string {
+	url := "/mcp-servers"
+	if o.CatalogID != "" {
+		url = fmt.Sprintf("/mcp-catalogs/%s/servers", o.CatalogID)
+	}
+	if id != "" {
+		url += "/" + id
+	}
+	return url
+}
+
+func (c *Client) ListMCPServers(ctx context.Context, opts MCPServerOptions) (result types.MCPServerList, err error) {
+	defer func() {
+		sort.Slice(result.Items, func(i, j int) bool {
+			return result.Items[i].Created.Time.Before(result.Items[j].Created.Time)
+		})
+	}()
+
+	_, resp, err := c.doRequest(ctx, http.MethodGet, opts.url(""), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+func (c *Client) GetMCPServer(ctx context.Context, id string, opts MCPServerOptions) (*types.MCPServer, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, opts.url(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServer{})
+}
+
+func (c *Client) CreateMCPServer(ctx context.Context, server types.MCPServer, opts MCPServerOptions) (*types.MCPServer, error) {
+	_, resp, err := c.postJSON(ctx, opts.url(""), server)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServer{})
+}
+
+func (c *Client) UpdateMCPServer(ctx context.Context, id string, manifest types.MCPServerManifest, opts MCPServerOptions) (*types.MCPServer, error) {
+	_, resp, err := c.putJSON(ctx, opts.url(id), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServer{})
+}
+
+func (c *Client) DeleteMCPServer(ctx context.Context, id string, opts MCPServerOptions) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, opts.url(id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+func (c *Client) RestartMCPServer(ctx context.Context, id string, opts MCPServerOptions) error {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, opts.url(id)+"/restart", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// MCPServerLogs streams the log lines of the deployment of an MCP server until the context is canceled or the
+// server closes the stream.
+func (c *Client) MCPServerLogs(ctx context.Context, id string, opts MCPServerOptions) (<-chan string, error) {
+	_, resp, err := c.doStream(ctx, http.MethodGet, opts.url(id)+"/logs", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return toLogStream(resp), nil
+}
+
+// GetMCPServerOAuthURL returns the URL at which the caller has to authorize the server, or an empty string if the
+// server doesn't need authorization.
+func (c *Client) GetMCPServerOAuthURL(ctx context.Context, id string, opts MCPServerOptions) (string, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, opts.url(id)+"/oauth-url", nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	result, err := toObject(resp, &struct {
+		OAuthURL string `json:"oauthURL"`
+	}{})
+	if err != nil {
+		return "", err
+	}
+	return result.OAuthURL, nil
+}
+
+func (c *Client) ListMCPServerCatalogEntries(ctx context.Context, catalogID string) (result types.MCPServerCatalogEntryList, err error) {
+	defer func() {
+		sort.Slice(result.Items, func(i, j int) bool {
+			return result.Items[i].Created.Time.Before(result.Items[j].Created.Time)
+		})
+	}()
+
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-catalogs/%s/entries", catalogID), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+func (c *Client) GetMCPServerCatalogEntry(ctx context.Context, catalogID, id string) (*types.MCPServerCatalogEntry, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-catalogs/%s/entries/%s", catalogID, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServerCatalogEntry{})
+}
+
+func (c *Client) CreateMCPServerCatalogEntry(ctx context.Context, catalogID string, manifest types.MCPServerCatalogEntryManifest) (*types.MCPServerCatalogEntry, error) {
+	_, resp, err := c.postJSON(ctx, fmt.Sprintf("/mcp-catalogs/%s/entries", catalogID), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServerCatalogEntry{})
+}
+
+func (c *Client) UpdateMCPServerCatalogEntry(ctx context.Context, catalogID, id string, manifest types.MCPServerCatalogEntryManifest) (*types.MCPServerCatalogEntry, error) {
+	_, resp, err := c.putJSON(ctx, fmt.Sprintf("/mcp-catalogs/%s/entries/%s", catalogID, id), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServerCatalogEntry{})
+}
+
+func (c *Client) DeleteMCPServerCatalogEntry(ctx context.Context, catalogID, id string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/mcp-catalogs/%s/entries/%s", catalogID, id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// GetMCPClientConfig renders the configuration of an MCP client for the servers the caller can connect to.
+func (c *Client) GetMCPClientConfig(ctx context.Context, req types.MCPClientConfigRequest) (*types.MCPClientConfig, error) {
+	_, resp, err := c.postJSON(ctx, "/mcp-client-config", req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPClientConfig{})
+}
@@ -0,0 +1,66 @@
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+func (c *Client) ListMCPWebhookValidations(ctx context.Context) (result types.MCPWebhookValidationList, err error) {
+	defer func() {
+		sort.Slice(result.Items, func(i, j int) bool {
+			return result.Items[i].Created.Time.Before(result.Items[j].Created.Time)
+		})
+	}()
+
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/mcp-webhook-validations", nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+func (c *Client) GetMCPWebhookValidation(ctx context.Context, id string) (*types.MCPWebhookValidation, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-webhook-validations/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPWebhookValidation{})
+}
+
+func (c *Client) CreateMCPWebhookValidation(ctx context.Context, manifest types.MCPWebhookValidationManifest) (*types.MCPWebhookValidation, error) {
+	_, resp, err := c.postJSON(ctx, "/mcp-webhook-validations", manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPWebhookValidation{})
+}
+
+func (c *Client) UpdateMCPWebhookValidation(ctx context.Context, id string, manifest types.MCPWebhookValidationManifest) (*types.MCPWebhookValidation, error) {
+	_, resp, err := c.putJSON(ctx, fmt.Sprintf("/mcp-webhook-validations/%s", id), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPWebhookValidation{})
+}
+
+func (c *Client) DeleteMCPWebhookValidation(ctx context.Context, id string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/mcp-webhook-validations/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
@@ -0,0 +1,194 @@
+package openapi
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"net/http"
+	"reflect"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+var clientTemplate = template.Must(template.New("client").Parse(`// Code generated by go generate; DO NOT EDIT.
+
+package apiclient
+
+import (
+	"context"
+{{- if .UsesFmt }}
+	"fmt"
+{{- end }}
+	"net/http"
+{{- if .UsesURL }}
+	"net/url"
+{{- end }}
+{{- if .UsesTypes }}
+
+	"github.com/obot-platform/obot/apiclient/types"
+{{- end }}
+)
+{{ range .Methods }}
+{{- if .Query }}
+type {{ .Name }}Options struct {
+{{- range .Query }}
+	// {{ .Description }}.
+	{{ .Field }} string
+{{- end }}
+}
+
+func (o {{ .Name }}Options) query() url.Values {
+	q := url.Values{}
+{{- range .Query }}
+	if o.{{ .Field }} != "" {
+		q.Set({{ printf "%q" .Name }}, o.{{ .Field }})
+	}
+{{- end }}
+	return q
+}
+{{ end }}
+// {{ .Name }} {{ .Summary }}
+func (c *Client) {{ .Name }}(ctx context.Context{{ range .Params }}, {{ . }} string{{ end }}{{ if .Body }}, {{ .Body }} {{ .BodyType }}{{ end }}{{ if .Query }}, opts {{ .Name }}Options{{ end }}) {{ if .Object }}(*{{ .Object }}, error){{ else if .ResultType }}(result {{ .ResultType }}, err error){{ else }}error{{ end }} {
+	_, resp, err := {{ .Call }}
+	if err != nil {
+		return {{ if .Object }}nil, err{{ else if .ResultType }}{{ else }}err{{ end }}
+	}
+	defer resp.Body.Close()
+{{ if .Object }}
+	return toObject(resp, &{{ .Object }}{})
+{{- else if .ResultType }}
+	_, err = toObject(resp, &result)
+	return
+{{- else }}
+	return nil
+{{- end }}
+}
+{{ end -}}
+`))
+
+type clientMethod struct {
+	Name       string
+	Summary    string
+	Params     []string
+	Query      []clientQueryParameter
+	Body       string
+	BodyType   string
+	ResultType string
+	// Object is the type of the response if it is a struct other than a list, which is returned as a pointer. Lists,
+	// maps and slices are returned as values, like in the hand-written methods.
+	Object string
+	Call   string
+}
+
+type clientQueryParameter struct {
+	Name        string
+	Field       string
+	Description string
+}
+
+// GenerateClient returns the source code of the client methods of the operations that don't have a hand-written method.
+func GenerateClient(operations []Operation) ([]byte, error) {
+	data := struct {
+		Methods                     []clientMethod
+		UsesFmt, UsesURL, UsesTypes bool
+	}{}
+
+	for _, o := range operations {
+		if o.HandWritten != "" {
+			continue
+		}
+		if o.Stream {
+			return nil, fmt.Errorf("operation %s streams its response, which requires a hand-written method", o.ID)
+		}
+
+		m := clientMethod{
+			Name:    o.ID,
+			Summary: o.Summary,
+		}
+
+		path := fmt.Sprintf("%q", o.Path)
+		if params := o.PathParameters(); len(params) > 0 {
+			for _, param := range params {
+				m.Params = append(m.Params, goName(param, false))
+			}
+			path = fmt.Sprintf("fmt.Sprintf(%q, %s)", pathParameter.ReplaceAllString(o.Path, "%s"), strings.Join(m.Params, ", "))
+			data.UsesFmt = true
+		}
+
+		for _, param := range o.Query {
+			m.Query = append(m.Query, clientQueryParameter{
+				Name:        param.Name,
+				Field:       goName(param.Name, true),
+				Description: param.Description,
+			})
+		}
+		if len(m.Query) > 0 {
+			path += `+"?"+opts.query().Encode()`
+			data.UsesURL = true
+		}
+
+		if o.Request != nil {
+			t := reflect.TypeOf(o.Request)
+			m.Body = "input"
+			if strings.HasSuffix(t.Name(), "Manifest") {
+				m.Body = "manifest"
+			}
+			m.BodyType = t.String()
+		}
+
+		if o.Response != nil {
+			t := reflect.TypeOf(o.Response)
+			m.ResultType = t.String()
+			if t.Kind() == reflect.Struct && !strings.HasSuffix(t.Name(), "List") {
+				m.Object = m.ResultType
+			}
+		}
+
+		for _, typeName := range []string{m.BodyType, m.ResultType} {
+			if strings.Contains(typeName, "types.") {
+				data.UsesTypes = true
+			}
+		}
+
+		switch {
+		case o.Method == http.MethodPost && m.Body != "":
+			m.Call = fmt.Sprintf("c.postJSON(ctx, %s, %s)", path, m.Body)
+		case o.Method == http.MethodPut && m.Body != "":
+			m.Call = fmt.Sprintf("c.putJSON(ctx, %s, %s)", path, m.Body)
+		case o.Method == http.MethodPatch && m.Body != "":
+			m.Call = fmt.Sprintf("c.patchJSON(ctx, %s, %s)", path, m.Body)
+		case m.Body == "":
+			m.Call = fmt.Sprintf("c.doRequest(ctx, http.Method%s%s, %s, nil)", o.Method[:1], strings.ToLower(o.Method[1:]), path)
+		default:
+			return nil, fmt.Errorf("operation %s: %s requests with a body are not supported", o.ID, o.Method)
+		}
+
+		data.Methods = append(data.Methods, m)
+	}
+
+	var buf bytes.Buffer
+	if err := clientTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// goName converts the name of a parameter, like mcp_server_id or includeDeleted, to a Go name like mcpServerID or
+// IncludeDeleted.
+func goName(name string, exported bool) string {
+	var b strings.Builder
+	for i, part := range strings.Split(name, "_") {
+		switch {
+		case part == "":
+		case i > 0 && (part == "id" || part == "url" || part == "ip"):
+			b.WriteString(strings.ToUpper(part))
+		case i > 0 || exported:
+			b.WriteRune(unicode.ToUpper(rune(part[0])))
+			b.WriteString(part[1:])
+		default:
+			b.WriteString(part)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,25 @@
+// Command gen writes the generated methods of the API client to the file given as its argument.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/obot-platform/obot/apiclient/openapi"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: gen FILE")
+		os.Exit(1)
+	}
+
+	src, err := openapi.GenerateClient(openapi.Operations)
+	if err == nil {
+		err = os.WriteFile(os.Args[1], src, 0644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
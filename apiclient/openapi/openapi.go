@@ -0,0 +1,339 @@
+// Package openapi describes the REST API of Obot. The OpenAPI document that the server serves at /api/openapi.json
+// and the methods of the API client are generated from these descriptions.
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// Operation describes an endpoint of the Obot API.
+type Operation struct {
+	// ID is the operationId of the endpoint, and the name of the generated client method.
+	ID     string
+	Method string
+	// Path is the path of the endpoint below /api, with path parameters in braces like in the router.
+	Path    string
+	Tag     string
+	Summary string
+	Query   []Parameter
+	// Request is a value of the type of the request body. It is nil if the endpoint doesn't read a body.
+	Request any
+	// Response is a value of the type of the response body. It is nil if the endpoint doesn't return a body.
+	Response any
+	// Status is the status code of successful responses, http.StatusOK if it isn't set.
+	Status int
+	// Stream indicates that the endpoint responds with server-sent events.
+	Stream bool
+	// HandWritten is the name of the hand-written client method that calls the endpoint. No method is generated for
+	// these operations.
+	HandWritten string
+	// Undescribed operations are derived from routes that Operations doesn't describe, see RouteOperations. Their
+	// bodies may be any JSON.
+	Undescribed bool
+}
+
+type Parameter struct {
+	Name        string
+	Description string
+}
+
+var pathParameter = regexp.MustCompile(`\{([^}]+)\}`)
+
+// PathParameters returns the names of the path parameters of the operation, in order.
+func (o Operation) PathParameters() []string {
+	var names []string
+	for _, match := range pathParameter.FindAllStringSubmatch(o.Path, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+func (o Operation) status() int {
+	if o.Status == 0 {
+		return http.StatusOK
+	}
+	return o.Status
+}
+
+type Document struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       Info                                    `json:"info"`
+	Servers    []Server                                `json:"servers,omitempty"`
+	Paths      map[string]map[string]DocumentOperation `json:"paths"`
+	Components Components                              `json:"components"`
+	Security   []map[string][]string                   `json:"security"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+type Components struct {
+	Schemas         map[string]Schema `json:"schemas"`
+	SecuritySchemes map[string]Schema `json:"securitySchemes"`
+}
+
+type DocumentOperation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	Parameters  []DocumentParameter `json:"parameters,omitempty"`
+	RequestBody *Body               `json:"requestBody,omitempty"`
+	Responses   map[string]Body     `json:"responses"`
+}
+
+type DocumentParameter struct {
+	Name        string `json:"name"`
+	In          string `json:"in"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Schema      Schema `json:"schema"`
+}
+
+type Body struct {
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema Schema `json:"schema"`
+}
+
+// Schema is a JSON schema, as used by OpenAPI 3.1.
+type Schema map[string]any
+
+// NewDocument returns the OpenAPI document of the operations, for a server that serves the API at apiURL.
+func NewDocument(version, apiURL string, operations []Operation) Document {
+	s := schemas{}
+	doc := Document{
+		OpenAPI: "3.1.0",
+		Info: Info{
+			Title:   "Obot API",
+			Version: version,
+		},
+		Paths: map[string]map[string]DocumentOperation{},
+		Components: Components{
+			Schemas: s,
+			SecuritySchemes: map[string]Schema{
+				"bearerAuth": {"type": "http", "scheme": "bearer"},
+			},
+		},
+		Security: []map[string][]string{{"bearerAuth": {}}},
+	}
+	if apiURL != "" {
+		doc.Servers = []Server{{URL: apiURL}}
+	}
+
+	for _, o := range operations {
+		op := DocumentOperation{
+			OperationID: o.ID,
+			Summary:     summary(o),
+			Tags:        []string{o.Tag},
+			Responses: map[string]Body{
+				"default": {Description: "An error message"},
+			},
+		}
+
+		for _, name := range o.PathParameters() {
+			op.Parameters = append(op.Parameters, DocumentParameter{
+				Name:     name,
+				In:       "path",
+				Required: true,
+				Schema:   Schema{"type": "string"},
+			})
+		}
+		for _, param := range o.Query {
+			op.Parameters = append(op.Parameters, DocumentParameter{
+				Name:        param.Name,
+				In:          "query",
+				Description: param.Description,
+				Schema:      Schema{"type": "string"},
+			})
+		}
+
+		if o.Undescribed && o.Method != http.MethodGet && o.Method != http.MethodDelete {
+			op.RequestBody = &Body{
+				Content: map[string]MediaType{
+					"application/json": {Schema: Schema{}},
+				},
+			}
+		}
+		if o.Request != nil {
+			op.RequestBody = &Body{
+				Required: true,
+				Content: map[string]MediaType{
+					"application/json": {Schema: s.schema(reflect.TypeOf(o.Request))},
+				},
+			}
+		}
+
+		response := Body{Description: http.StatusText(o.status())}
+		switch {
+		case o.Stream:
+			response.Content = map[string]MediaType{
+				"text/event-stream": {Schema: Schema{"type": "string"}},
+			}
+		case o.Response != nil:
+			response.Content = map[string]MediaType{
+				"application/json": {Schema: s.schema(reflect.TypeOf(o.Response))},
+			}
+		case o.Undescribed:
+			response.Content = map[string]MediaType{
+				"application/json": {Schema: Schema{}},
+			}
+		}
+		op.Responses[strconv.Itoa(o.status())] = response
+
+		if doc.Paths[o.Path] == nil {
+			doc.Paths[o.Path] = map[string]DocumentOperation{}
+		}
+		doc.Paths[o.Path][strings.ToLower(o.Method)] = op
+	}
+
+	return doc
+}
+
+// summary returns the summary of the operation capitalized. Summaries start with a lowercase verb, so that they follow
+// the name of the client method in its doc comment.
+func summary(o Operation) string {
+	if o.Summary == "" {
+		return ""
+	}
+	return strings.ToUpper(o.Summary[:1]) + o.Summary[1:]
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	apiTimeType    = reflect.TypeOf(types.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemas are the schemas of the named types that the operations use, by type name.
+type schemas map[string]Schema
+
+// schema returns the JSON schema of t. Named struct types are added to the schemas and referenced.
+func (s schemas) schema(t reflect.Type) Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType, apiTimeType:
+		return Schema{"type": "string", "format": "date-time"}
+	case rawMessageType:
+		return Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Schema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return Schema{"type": "string", "contentEncoding": "base64"}
+		}
+		return Schema{"type": "array", "items": s.schema(t.Elem())}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": s.schema(t.Elem())}
+	case reflect.Struct:
+		name := schemaName(t)
+		if name == "" {
+			return s.object(t)
+		}
+		if _, ok := s[name]; !ok {
+			// Add a placeholder first, so that recursive types reference themselves.
+			s[name] = Schema{}
+			s[name] = s.object(t)
+		}
+		return Schema{"$ref": "#/components/schemas/" + name}
+	default:
+		return Schema{}
+	}
+}
+
+// object returns the schema of a struct, following the rules of encoding/json for the names of fields and for embedded
+// structs.
+func (s schemas) object(t reflect.Type) Schema {
+	properties := map[string]Schema{}
+	s.addProperties(t, properties)
+	return Schema{"type": "object", "properties": properties}
+}
+
+func (s schemas) addProperties(t reflect.Type, properties map[string]Schema) {
+	var embedded []reflect.Type
+	for i := range t.NumField() {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				embedded = append(embedded, fieldType)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = s.schema(field.Type)
+	}
+
+	// Fields of the struct win over the fields of embedded structs.
+	for _, e := range embedded {
+		embeddedProperties := map[string]Schema{}
+		s.addProperties(e, embeddedProperties)
+		for name, schema := range embeddedProperties {
+			if _, ok := properties[name]; !ok {
+				properties[name] = schema
+			}
+		}
+	}
+}
+
+// schemaName returns the name of the schema of a named type, or an empty string for unnamed types. Instances of generic
+// types, like types.List[types.User], are named after their type arguments.
+func schemaName(t reflect.Type) string {
+	name := t.Name()
+	if name == "" {
+		return ""
+	}
+	if base, args, ok := strings.Cut(name, "["); ok {
+		args = strings.TrimSuffix(args, "]")
+		var argNames []string
+		for arg := range strings.SplitSeq(args, ",") {
+			argNames = append(argNames, arg[strings.LastIndex(arg, ".")+1:])
+		}
+		name = base + "_" + strings.Join(argNames, "_")
+	}
+	return name
+}
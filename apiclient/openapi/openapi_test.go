@@ -0,0 +1,119 @@
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestOperationIDsAreUnique(t *testing.T) {
+	seen := map[string]bool{}
+	for _, o := range Operations {
+		if seen[o.ID] {
+			t.Errorf("operation ID %s is used more than once", o.ID)
+		}
+		seen[o.ID] = true
+	}
+}
+
+func TestDocumentReferences(t *testing.T) {
+	doc := NewDocument("test", "http://localhost:8080/api", Operations)
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var refs []string
+	collectRefs(json.RawMessage(data), &refs)
+	if len(refs) == 0 {
+		t.Fatal("expected the document to reference schemas")
+	}
+	for _, ref := range refs {
+		name, ok := strings.CutPrefix(ref, "#/components/schemas/")
+		if !ok {
+			t.Errorf("unexpected reference %s", ref)
+			continue
+		}
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			t.Errorf("reference %s has no schema", ref)
+		}
+	}
+}
+
+func collectRefs(data json.RawMessage, refs *[]string) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err == nil {
+		for key, value := range object {
+			var ref string
+			if key == "$ref" && json.Unmarshal(value, &ref) == nil {
+				*refs = append(*refs, ref)
+				continue
+			}
+			collectRefs(value, refs)
+		}
+		return
+	}
+
+	var array []json.RawMessage
+	if err := json.Unmarshal(data, &array); err == nil {
+		for _, value := range array {
+			collectRefs(value, refs)
+		}
+	}
+}
+
+func TestGoName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"id":             "id",
+		"mcp_server_id":  "mcpServerID",
+		"projectv2_id":   "projectv2ID",
+		"includeDeleted": "includeDeleted",
+	} {
+		if actual := goName(name, false); actual != expected {
+			t.Errorf("goName(%q) = %q, expected %q", name, actual, expected)
+		}
+	}
+	if actual := goName("includeDeleted", true); actual != "IncludeDeleted" {
+		t.Errorf("exported goName(\"includeDeleted\") = %q, expected \"IncludeDeleted\"", actual)
+	}
+}
+
+func TestRouteOperations(t *testing.T) {
+	described := []Operation{
+		{ID: "GetMCPServer", Method: "GET", Path: "/mcp-servers/{mcp_server_id}"},
+	}
+	operations := RouteOperations(described, []string{
+		"GET /api/mcp-servers/{mcp_server_id}",
+		"GET /api/agents/{id}/threads",
+		"POST /api/assistants/{assistant_id}/projects/{project_id}/files/{file...}",
+		"GET /api/version",
+		"/api/",
+		"GET /v0.1/servers",
+		"GET /api/version",
+	})
+
+	var ids []string
+	for _, o := range operations {
+		ids = append(ids, o.Method+" "+o.Path+" "+o.ID)
+	}
+	expected := []string{
+		"GET /mcp-servers/{mcp_server_id} GetMCPServer",
+		"GET /agents/{id}/threads getAgentsByIDThreads",
+		"POST /assistants/{assistant_id}/projects/{project_id}/files/{file} postAssistantsByAssistantIDProjectsByProjectIDFilesByFile",
+		"GET /version getVersion",
+	}
+	if strings.Join(ids, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected operations\n%s\ngot\n%s", strings.Join(expected, "\n"), strings.Join(ids, "\n"))
+	}
+	if operations[0].Undescribed || !operations[1].Undescribed || operations[1].Tag != "agents" {
+		t.Errorf("expected only the derived operations to be undescribed, got %+v", operations)
+	}
+
+	doc := NewDocument("test", "", operations)
+	if body := doc.Paths["/assistants/{assistant_id}/projects/{project_id}/files/{file}"]["post"].RequestBody; body == nil || body.Required {
+		t.Errorf("expected an optional request body for an undescribed POST, got %+v", body)
+	}
+	if params := doc.Paths["/agents/{id}/threads"]["get"].Parameters; len(params) != 1 || params[0].Name != "id" {
+		t.Errorf("expected the path parameter of the route, got %+v", params)
+	}
+}
@@ -0,0 +1,156 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+const (
+	tagMCPServers          = "MCP Servers"
+	tagMCPCatalogs         = "MCP Catalogs"
+	tagAccessControlRules  = "Access Control Rules"
+	tagModelAccessPolicies = "Model Access Policies"
+	tagAuditLogs           = "Audit Logs"
+	tagUsers               = "Users"
+	tagAPIKeys             = "API Keys"
+	tagProjectsV2          = "Projects"
+)
+
+var auditLogQuery = []Parameter{
+	{Name: "user_id", Description: "Comma separated user IDs to filter by"},
+	{Name: "mcp_id", Description: "Comma separated MCP server IDs to filter by"},
+	{Name: "mcp_server_display_name", Description: "Comma separated MCP server names to filter by"},
+	{Name: "mcp_server_catalog_entry_name", Description: "Comma separated catalog entry names to filter by"},
+	{Name: "call_type", Description: "Comma separated JSON-RPC methods to filter by"},
+	{Name: "call_identifier", Description: "Comma separated tool, resource or prompt names to filter by"},
+	{Name: "session_id", Description: "Comma separated MCP session IDs to filter by"},
+	{Name: "client_name", Description: "Comma separated MCP client names to filter by"},
+	{Name: "client_version", Description: "Comma separated MCP client versions to filter by"},
+	{Name: "response_status", Description: "Comma separated HTTP status codes to filter by"},
+	{Name: "client_ip", Description: "Comma separated client IP addresses to filter by"},
+	{Name: "trace_id", Description: "Comma separated trace IDs to filter by"},
+	{Name: "query", Description: "Text to search for"},
+	{Name: "start_time", Description: "The RFC 3339 time to list audit logs from"},
+	{Name: "end_time", Description: "The RFC 3339 time to list audit logs until"},
+	{Name: "limit", Description: "The maximum number of audit logs to return, 100 by default"},
+	{Name: "offset", Description: "The number of audit logs to skip"},
+	{Name: "sort_by", Description: "The field to sort by, created_at by default"},
+	{Name: "sort_order", Description: "asc or desc, desc by default"},
+}
+
+// Operations describes the endpoints of the Obot API that the client covers: those of MCP servers, MCP catalogs and
+// their entries, access control rules, model access policies, MCP audit logs, users, API keys and projects. Other
+// endpoints only appear in the OpenAPI document as untyped stubs, see RouteOperations. The OpenAPI document is built
+// from these descriptions, and a client method is generated for each operation that doesn't have a hand-written one.
+var Operations = []Operation{
+	// MCP servers of the user
+	{ID: "ListMCPServers", Method: http.MethodGet, Path: "/mcp-servers", Tag: tagMCPServers, Summary: "lists the single-user MCP servers of the caller.", Response: types.MCPServerList{}, HandWritten: "ListMCPServers"},
+	{ID: "CreateMCPServer", Method: http.MethodPost, Path: "/mcp-servers", Tag: tagMCPServers, Summary: "creates a single-user MCP server.", Request: types.MCPServer{}, Response: types.MCPServer{}, Status: http.StatusCreated, HandWritten: "CreateMCPServer"},
+	{ID: "GetMCPServer", Method: http.MethodGet, Path: "/mcp-servers/{mcp_server_id}", Tag: tagMCPServers, Summary: "returns an MCP server.", Response: types.MCPServer{}, HandWritten: "GetMCPServer"},
+	{ID: "UpdateMCPServer", Method: http.MethodPut, Path: "/mcp-servers/{mcp_server_id}", Tag: tagMCPServers, Summary: "updates the manifest of an MCP server.", Request: types.MCPServerManifest{}, Response: types.MCPServer{}, HandWritten: "UpdateMCPServer"},
+	{ID: "DeleteMCPServer", Method: http.MethodDelete, Path: "/mcp-servers/{mcp_server_id}", Tag: tagMCPServers, Summary: "deletes an MCP server.", Response: types.MCPServer{}, HandWritten: "DeleteMCPServer"},
+	{ID: "LaunchMCPServer", Method: http.MethodPost, Path: "/mcp-servers/{mcp_server_id}/launch", Tag: tagMCPServers, Summary: "deploys an MCP server and waits until it is ready."},
+	{ID: "GetMCPServerOAuthURL", Method: http.MethodGet, Path: "/mcp-servers/{mcp_server_id}/oauth-url", Tag: tagMCPServers, Summary: "returns the URL to authorize an MCP server with OAuth, if it needs authorization.", Response: map[string]string{}, HandWritten: "GetMCPServerOAuthURL"},
+	{ID: "ClearMCPServerOAuth", Method: http.MethodDelete, Path: "/mcp-servers/{mcp_server_id}/oauth", Tag: tagMCPServers, Summary: "removes the OAuth credentials of the caller for an MCP server.", Status: http.StatusNoContent},
+	{ID: "GetMCPServerDetails", Method: http.MethodGet, Path: "/mcp-servers/{mcp_server_id}/details", Tag: tagMCPServers, Summary: "returns the deployment details and health of an MCP server.", Response: types.MCPServerDetails{}},
+	{ID: "MCPServerLogs", Method: http.MethodGet, Path: "/mcp-servers/{mcp_server_id}/logs", Tag: tagMCPServers, Summary: "streams the logs of an MCP server.", Stream: true, HandWritten: "MCPServerLogs"},
+	{ID: "RestartMCPServer", Method: http.MethodPost, Path: "/mcp-servers/{mcp_server_id}/restart", Tag: tagMCPServers, Summary: "restarts the deployment of an MCP server.", Status: http.StatusNoContent, HandWritten: "RestartMCPServer"},
+	{ID: "ConfigureMCPServer", Method: http.MethodPost, Path: "/mcp-servers/{mcp_server_id}/configure", Tag: tagMCPServers, Summary: "sets the environment variables and headers of an MCP server.", Request: map[string]string{}, Response: types.MCPServer{}},
+	{ID: "DeconfigureMCPServer", Method: http.MethodPost, Path: "/mcp-servers/{mcp_server_id}/deconfigure", Tag: tagMCPServers, Summary: "removes the configuration of an MCP server.", Response: types.MCPServer{}},
+	{ID: "RevealMCPServer", Method: http.MethodPost, Path: "/mcp-servers/{mcp_server_id}/reveal", Tag: tagMCPServers, Summary: "returns the configured environment variables and headers of an MCP server.", Response: map[string]string{}},
+	{ID: "ListMCPServerTools", Method: http.MethodGet, Path: "/mcp-servers/{mcp_server_id}/tools", Tag: tagMCPServers, Summary: "lists the tools of an MCP server.", Response: []types.MCPServerTool{}},
+
+	// Multi-user MCP servers of catalogs
+	{ID: "ListCatalogMCPServers", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/servers", Tag: tagMCPServers, Summary: "lists the multi-user MCP servers of a catalog.", Response: types.MCPServerList{}, HandWritten: "ListMCPServers"},
+	{ID: "CreateCatalogMCPServer", Method: http.MethodPost, Path: "/mcp-catalogs/{catalog_id}/servers", Tag: tagMCPServers, Summary: "creates a multi-user MCP server in a catalog.", Request: types.MCPServer{}, Response: types.MCPServer{}, Status: http.StatusCreated, HandWritten: "CreateMCPServer"},
+	{ID: "GetCatalogMCPServer", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}", Tag: tagMCPServers, Summary: "returns a multi-user MCP server.", Response: types.MCPServer{}, HandWritten: "GetMCPServer"},
+	{ID: "UpdateCatalogMCPServer", Method: http.MethodPut, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}", Tag: tagMCPServers, Summary: "updates the manifest of a multi-user MCP server.", Request: types.MCPServerManifest{}, Response: types.MCPServer{}, HandWritten: "UpdateMCPServer"},
+	{ID: "DeleteCatalogMCPServer", Method: http.MethodDelete, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}", Tag: tagMCPServers, Summary: "deletes a multi-user MCP server.", Response: types.MCPServer{}, HandWritten: "DeleteMCPServer"},
+	{ID: "CatalogMCPServerLogs", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/logs", Tag: tagMCPServers, Summary: "streams the logs of a multi-user MCP server.", Stream: true, HandWritten: "MCPServerLogs"},
+	{ID: "RestartCatalogMCPServer", Method: http.MethodPost, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/restart", Tag: tagMCPServers, Summary: "restarts the deployment of a multi-user MCP server.", Status: http.StatusNoContent, HandWritten: "RestartMCPServer"},
+	{ID: "GetCatalogMCPServerOAuthURL", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/oauth-url", Tag: tagMCPServers, Summary: "returns the URL to authorize a multi-user MCP server with OAuth, if it needs authorization.", Response: map[string]string{}, HandWritten: "GetMCPServerOAuthURL"},
+	{ID: "ConfigureCatalogMCPServer", Method: http.MethodPost, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/configure", Tag: tagMCPServers, Summary: "sets the environment variables and headers of a multi-user MCP server.", Request: map[string]string{}, Response: types.MCPServer{}},
+	{ID: "DeconfigureCatalogMCPServer", Method: http.MethodPost, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/deconfigure", Tag: tagMCPServers, Summary: "removes the configuration of a multi-user MCP server.", Response: types.MCPServer{}},
+	{ID: "RevealCatalogMCPServer", Method: http.MethodPost, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/reveal", Tag: tagMCPServers, Summary: "returns the configured environment variables and headers of a multi-user MCP server.", Response: map[string]string{}},
+	{ID: "ListCatalogMCPServerInstances", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/instances", Tag: tagMCPServers, Summary: "lists the instances that users created of a multi-user MCP server.", Response: types.MCPServerInstanceList{}},
+	{ID: "GetMCPServerToolChanges", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/tool-changes", Tag: tagMCPServers, Summary: "returns the tool changes detected on a multi-user MCP server.", Response: types.MCPServerToolChanges{}},
+	{ID: "ApproveMCPServerTools", Method: http.MethodPost, Path: "/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/tool-changes/approve", Tag: tagMCPServers, Summary: "enables tools that were disabled after they newly appeared on a multi-user MCP server.", Request: types.MCPServerToolApprovalRequest{}, Response: types.MCPServerToolChanges{}},
+	{ID: "GetWorkspaceMCPServerToolChanges", Method: http.MethodGet, Path: "/workspaces/{workspace_id}/servers/{mcp_server_id}/tool-changes", Tag: tagMCPServers, Summary: "returns the tool changes detected on a multi-user MCP server in a workspace.", Response: types.MCPServerToolChanges{}},
+	{ID: "ApproveWorkspaceMCPServerTools", Method: http.MethodPost, Path: "/workspaces/{workspace_id}/servers/{mcp_server_id}/tool-changes/approve", Tag: tagMCPServers, Summary: "enables tools that were disabled after they newly appeared on a multi-user MCP server in a workspace.", Request: types.MCPServerToolApprovalRequest{}, Response: types.MCPServerToolChanges{}},
+	{ID: "GetMCPClientConfig", Method: http.MethodPost, Path: "/mcp-client-config", Tag: tagMCPServers, Summary: "returns the configuration for MCP clients to connect to MCP servers.", Request: types.MCPClientConfigRequest{}, Response: types.MCPClientConfig{}, HandWritten: "GetMCPClientConfig"},
+
+	// MCP catalogs and their entries
+	{ID: "ListMCPCatalogs", Method: http.MethodGet, Path: "/mcp-catalogs", Tag: tagMCPCatalogs, Summary: "lists the MCP catalogs.", Response: types.MCPCatalogList{}, HandWritten: "ListMCPCatalogs"},
+	{ID: "GetMCPCatalog", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}", Tag: tagMCPCatalogs, Summary: "returns an MCP catalog.", Response: types.MCPCatalog{}, HandWritten: "GetMCPCatalog"},
+	{ID: "UpdateMCPCatalog", Method: http.MethodPut, Path: "/mcp-catalogs/{catalog_id}", Tag: tagMCPCatalogs, Summary: "updates the manifest of an MCP catalog.", Request: types.MCPCatalogManifest{}, Response: types.MCPCatalog{}, HandWritten: "UpdateMCPCatalog"},
+	{ID: "RefreshMCPCatalog", Method: http.MethodPost, Path: "/mcp-catalogs/{catalog_id}/refresh", Tag: tagMCPCatalogs, Summary: "reloads the entries of an MCP catalog from its sources.", HandWritten: "RefreshMCPCatalog"},
+	{ID: "ListMCPCatalogCategories", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/categories", Tag: tagMCPCatalogs, Summary: "lists the categories of the entries of an MCP catalog.", Response: []string{}},
+	{ID: "ListMCPServerCatalogEntries", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/entries", Tag: tagMCPCatalogs, Summary: "lists the entries of an MCP catalog.", Response: types.MCPServerCatalogEntryList{}, HandWritten: "ListMCPServerCatalogEntries"},
+	{ID: "CreateMCPServerCatalogEntry", Method: http.MethodPost, Path: "/mcp-catalogs/{catalog_id}/entries", Tag: tagMCPCatalogs, Summary: "creates an entry in an MCP catalog.", Request: types.MCPServerCatalogEntryManifest{}, Response: types.MCPServerCatalogEntry{}, HandWritten: "CreateMCPServerCatalogEntry"},
+	{ID: "GetMCPServerCatalogEntry", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/entries/{entry_id}", Tag: tagMCPCatalogs, Summary: "returns an entry of an MCP catalog.", Response: types.MCPServerCatalogEntry{}, HandWritten: "GetMCPServerCatalogEntry"},
+	{ID: "UpdateMCPServerCatalogEntry", Method: http.MethodPut, Path: "/mcp-catalogs/{catalog_id}/entries/{entry_id}", Tag: tagMCPCatalogs, Summary: "updates an entry of an MCP catalog.", Request: types.MCPServerCatalogEntryManifest{}, Response: types.MCPServerCatalogEntry{}, HandWritten: "UpdateMCPServerCatalogEntry"},
+	{ID: "DeleteMCPServerCatalogEntry", Method: http.MethodDelete, Path: "/mcp-catalogs/{catalog_id}/entries/{entry_id}", Tag: tagMCPCatalogs, Summary: "deletes an entry of an MCP catalog.", HandWritten: "DeleteMCPServerCatalogEntry"},
+	{ID: "ListMCPServerCatalogEntryServers", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/entries/{entry_id}/servers", Tag: tagMCPCatalogs, Summary: "lists the MCP servers that users created from an entry of an MCP catalog.", Response: types.MCPServerList{}},
+
+	// Access control rules
+	{ID: "ListAccessControlRules", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/access-control-rules", Tag: tagAccessControlRules, Summary: "lists the access control rules of an MCP catalog.", Response: types.AccessControlRuleList{}, HandWritten: "ListAccessControlRules"},
+	{ID: "CreateAccessControlRule", Method: http.MethodPost, Path: "/mcp-catalogs/{catalog_id}/access-control-rules", Tag: tagAccessControlRules, Summary: "creates an access control rule in an MCP catalog.", Request: types.AccessControlRuleManifest{}, Response: types.AccessControlRule{}, HandWritten: "CreateAccessControlRule"},
+	{ID: "GetAccessControlRule", Method: http.MethodGet, Path: "/mcp-catalogs/{catalog_id}/access-control-rules/{access_control_rule_id}", Tag: tagAccessControlRules, Summary: "returns an access control rule.", Response: types.AccessControlRule{}, HandWritten: "GetAccessControlRule"},
+	{ID: "UpdateAccessControlRule", Method: http.MethodPut, Path: "/mcp-catalogs/{catalog_id}/access-control-rules/{access_control_rule_id}", Tag: tagAccessControlRules, Summary: "updates an access control rule.", Request: types.AccessControlRuleManifest{}, Response: types.AccessControlRule{}, HandWritten: "UpdateAccessControlRule"},
+	{ID: "DeleteAccessControlRule", Method: http.MethodDelete, Path: "/mcp-catalogs/{catalog_id}/access-control-rules/{access_control_rule_id}", Tag: tagAccessControlRules, Summary: "deletes an access control rule.", HandWritten: "DeleteAccessControlRule"},
+
+	// Model access policies
+	{ID: "ListModelAccessPolicies", Method: http.MethodGet, Path: "/model-access-policies", Tag: tagModelAccessPolicies, Summary: "lists the model access policies.", Response: types.ModelAccessPolicyList{}},
+	{ID: "CreateModelAccessPolicy", Method: http.MethodPost, Path: "/model-access-policies", Tag: tagModelAccessPolicies, Summary: "creates a model access policy.", Request: types.ModelAccessPolicyManifest{}, Response: types.ModelAccessPolicy{}},
+	{ID: "GetModelAccessPolicy", Method: http.MethodGet, Path: "/model-access-policies/{id}", Tag: tagModelAccessPolicies, Summary: "returns a model access policy.", Response: types.ModelAccessPolicy{}},
+	{ID: "UpdateModelAccessPolicy", Method: http.MethodPut, Path: "/model-access-policies/{id}", Tag: tagModelAccessPolicies, Summary: "updates a model access policy.", Request: types.ModelAccessPolicyManifest{}, Response: types.ModelAccessPolicy{}},
+	{ID: "DeleteModelAccessPolicy", Method: http.MethodDelete, Path: "/model-access-policies/{id}", Tag: tagModelAccessPolicies, Summary: "deletes a model access policy."},
+
+	// MCP audit logs
+	{ID: "ListMCPAuditLogs", Method: http.MethodGet, Path: "/mcp-audit-logs", Tag: tagAuditLogs, Summary: "lists MCP audit logs.", Query: auditLogQuery, Response: types.MCPAuditLogResponse{}, HandWritten: "ListMCPAuditLogs"},
+	{ID: "GetMCPAuditLog", Method: http.MethodGet, Path: "/mcp-audit-logs/detail/{audit_log_id}", Tag: tagAuditLogs, Summary: "returns an MCP audit log with its request and response.", Response: types.MCPAuditLog{}, HandWritten: "GetMCPAuditLog"},
+	{ID: "GetMCPUsageStats", Method: http.MethodGet, Path: "/mcp-stats", Tag: tagAuditLogs, Summary: "returns usage statistics of MCP servers.", Query: []Parameter{
+		{Name: "mcp_id", Description: "The MCP server ID to return statistics for"},
+		{Name: "mcp_server_display_names", Description: "Comma separated MCP server names to filter by"},
+		{Name: "mcp_server_catalog_entry_names", Description: "Comma separated catalog entry names to filter by"},
+		{Name: "user_ids", Description: "Comma separated user IDs to filter by"},
+		{Name: "start_time", Description: "The RFC 3339 start of the statistics, 24 hours ago by default"},
+		{Name: "end_time", Description: "The RFC 3339 end of the statistics, now by default"},
+	}, Response: types.MCPUsageStats{}, HandWritten: "GetMCPUsageStats"},
+
+	// Users
+	{ID: "GetCurrentUser", Method: http.MethodGet, Path: "/me", Tag: tagUsers, Summary: "returns the caller.", Response: types.User{}},
+	{ID: "UpdateCurrentUser", Method: http.MethodPatch, Path: "/me", Tag: tagUsers, Summary: "updates the settings of the caller.", Request: types.User{}, Response: types.User{}},
+	{ID: "ListUsers", Method: http.MethodGet, Path: "/users", Tag: tagUsers, Summary: "lists users.", Query: []Parameter{
+		{Name: "username", Description: "The username to filter by"},
+		{Name: "email", Description: "The email address to filter by"},
+		{Name: "role", Description: "The role to filter by"},
+		{Name: "includeDeleted", Description: "Set to true to include deleted users"},
+	}, Response: types.UserList{}},
+	{ID: "GetUser", Method: http.MethodGet, Path: "/users/{user_id}", Tag: tagUsers, Summary: "returns a user.", Response: types.User{}},
+	{ID: "UpdateUser", Method: http.MethodPatch, Path: "/users/{user_id}", Tag: tagUsers, Summary: "updates the role and settings of a user.", Request: types.User{}, Response: types.User{}},
+	{ID: "DeleteUser", Method: http.MethodDelete, Path: "/users/{user_id}", Tag: tagUsers, Summary: "deletes a user.", Response: types.User{}},
+
+	// API keys
+	{ID: "ListAPIKeys", Method: http.MethodGet, Path: "/api-keys", Tag: tagAPIKeys, Summary: "lists the API keys of the caller.", Response: types.APIKeyList{}},
+	{ID: "CreateAPIKey", Method: http.MethodPost, Path: "/api-keys", Tag: tagAPIKeys, Summary: "creates an API key for the caller. The response is the only time the key is visible.", Request: types.APIKeyCreateRequest{}, Response: types.APIKeyCreateResponse{}, Status: http.StatusCreated},
+	{ID: "GetAPIKey", Method: http.MethodGet, Path: "/api-keys/{id}", Tag: tagAPIKeys, Summary: "returns an API key of the caller.", Response: types.APIKey{}},
+	{ID: "DeleteAPIKey", Method: http.MethodDelete, Path: "/api-keys/{id}", Tag: tagAPIKeys, Summary: "deletes an API key of the caller."},
+	{ID: "ListAllAPIKeys", Method: http.MethodGet, Path: "/admin-api-keys", Tag: tagAPIKeys, Summary: "lists the API keys of all users.", Response: types.APIKeyList{}},
+	{ID: "GetAnyAPIKey", Method: http.MethodGet, Path: "/admin-api-keys/{id}", Tag: tagAPIKeys, Summary: "returns an API key of any user.", Response: types.APIKey{}},
+	{ID: "DeleteAnyAPIKey", Method: http.MethodDelete, Path: "/admin-api-keys/{id}", Tag: tagAPIKeys, Summary: "deletes an API key of any user."},
+
+	// Projects and their nanobot agents
+	{ID: "ListProjectsV2", Method: http.MethodGet, Path: "/projectsv2", Tag: tagProjectsV2, Summary: "lists the projects of the caller.", Response: types.ProjectV2List{}},
+	{ID: "CreateProjectV2", Method: http.MethodPost, Path: "/projectsv2", Tag: tagProjectsV2, Summary: "creates a project.", Request: types.ProjectV2Manifest{}, Response: types.ProjectV2{}, Status: http.StatusCreated},
+	{ID: "GetProjectV2", Method: http.MethodGet, Path: "/projectsv2/{projectv2_id}", Tag: tagProjectsV2, Summary: "returns a project.", Response: types.ProjectV2{}},
+	{ID: "UpdateProjectV2", Method: http.MethodPut, Path: "/projectsv2/{projectv2_id}", Tag: tagProjectsV2, Summary: "updates a project.", Request: types.ProjectV2Manifest{}, Response: types.ProjectV2{}},
+	{ID: "DeleteProjectV2", Method: http.MethodDelete, Path: "/projectsv2/{projectv2_id}", Tag: tagProjectsV2, Summary: "deletes a project."},
+	{ID: "ListNanobotAgents", Method: http.MethodGet, Path: "/projectsv2/{project_id}/agents", Tag: tagProjectsV2, Summary: "lists the agents of a project.", Response: types.NanobotAgentList{}},
+	{ID: "CreateNanobotAgent", Method: http.MethodPost, Path: "/projectsv2/{project_id}/agents", Tag: tagProjectsV2, Summary: "creates an agent in a project.", Request: types.NanobotAgentManifest{}, Response: types.NanobotAgent{}, Status: http.StatusCreated},
+	{ID: "GetNanobotAgent", Method: http.MethodGet, Path: "/projectsv2/{project_id}/agents/{nanobot_agent_id}", Tag: tagProjectsV2, Summary: "returns an agent of a project.", Response: types.NanobotAgent{}},
+	{ID: "UpdateNanobotAgent", Method: http.MethodPut, Path: "/projectsv2/{project_id}/agents/{nanobot_agent_id}", Tag: tagProjectsV2, Summary: "updates an agent of a project.", Request: types.NanobotAgentManifest{}, Response: types.NanobotAgent{}},
+	{ID: "DeleteNanobotAgent", Method: http.MethodDelete, Path: "/projectsv2/{project_id}/agents/{nanobot_agent_id}", Tag: tagProjectsV2, Summary: "deletes an agent of a project."},
+	{ID: "LaunchNanobotAgent", Method: http.MethodPost, Path: "/projectsv2/{project_id}/agents/{nanobot_agent_id}/launch", Tag: tagProjectsV2, Summary: "deploys the MCP server of an agent and waits until it is ready."},
+}
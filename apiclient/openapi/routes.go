@@ -0,0 +1,80 @@
+package openapi
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// RouteOperations returns the operations, followed by an undescribed operation for each route that they don't cover,
+// so that the OpenAPI document lists every endpoint of the API. The undescribed operations are untyped stubs with
+// path parameters only, and no client methods are generated for them. Routes are the patterns that the server registered,
+// like "GET /api/agents/{id}". Patterns without a method or outside of /api are skipped.
+func RouteOperations(operations []Operation, routes []string) []Operation {
+	var (
+		result    = append([]Operation(nil), operations...)
+		described = make(map[string]bool, len(operations))
+		ids       = make(map[string]bool, len(operations))
+	)
+	for _, o := range operations {
+		described[o.Method+" "+o.Path] = true
+		ids[o.ID] = true
+	}
+
+	for _, route := range routes {
+		method, pattern, ok := strings.Cut(route, " ")
+		if !ok || strings.ToUpper(method) != method {
+			continue
+		}
+		path, ok := strings.CutPrefix(pattern, "/api/")
+		if !ok {
+			continue
+		}
+		path = "/" + strings.NewReplacer("...}", "}", "{$}", "").Replace(path)
+		if described[method+" "+path] {
+			continue
+		}
+		described[method+" "+path] = true
+
+		id := routeOperationID(method, path)
+		for i := 2; ids[id]; i++ {
+			id = routeOperationID(method, path) + strconv.Itoa(i)
+		}
+		ids[id] = true
+
+		tag, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+		result = append(result, Operation{
+			ID:          id,
+			Method:      method,
+			Path:        path,
+			Tag:         tag,
+			Undescribed: true,
+		})
+	}
+
+	return result
+}
+
+// routeOperationID derives the ID of an undescribed operation from its route, like getAgentsByIDThreads for
+// GET /agents/{id}/threads.
+func routeOperationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		if param, ok := strings.CutPrefix(segment, "{"); ok {
+			b.WriteString("By")
+			segment = strings.TrimSuffix(param, "}")
+		}
+		for _, word := range strings.FieldsFunc(segment, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			if word == "id" {
+				b.WriteString("ID")
+				continue
+			}
+			b.WriteRune(unicode.ToUpper(rune(word[0])))
+			b.WriteString(word[1:])
+		}
+	}
+	return b.String()
+}
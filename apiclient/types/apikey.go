@@ -0,0 +1,29 @@
+package types
+
+// APIKey is an API key that authenticates its user to MCP servers.
+type APIKey struct {
+	ID          uint   `json:"id"`
+	UserID      uint   `json:"userId"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	CreatedAt   Time   `json:"createdAt"`
+	LastUsedAt  *Time  `json:"lastUsedAt,omitempty"`
+	ExpiresAt   *Time  `json:"expiresAt,omitempty"`
+	// MCPServerIDs are the MCP servers the key can access. "*" grants access to all servers the user can access.
+	MCPServerIDs []string `json:"mcpServerIds,omitempty"`
+}
+
+type APIKeyList List[APIKey]
+
+type APIKeyCreateRequest struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	ExpiresAt    *Time    `json:"expiresAt,omitempty"`
+	MCPServerIDs []string `json:"mcpServerIds,omitempty"`
+}
+
+// APIKeyCreateResponse is returned when creating an API key. This is the only time the full key is visible.
+type APIKeyCreateResponse struct {
+	APIKey
+	Key string `json:"key"`
+}
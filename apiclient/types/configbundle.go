@@ -0,0 +1,83 @@
+package types
+
+const (
+	ConfigBundleAPIVersion = "obot.ai/v1"
+	ConfigBundleKind       = "ConfigBundle"
+)
+
+// ConfigBundle is a declarative snapshot of the admin-managed MCP configuration.
+// Secrets are never included; resources that have secrets configured reference them with a SecretRef instead.
+type ConfigBundle struct {
+	APIVersion         string                          `json:"apiVersion"`
+	Kind               string                          `json:"kind"`
+	ExportedAt         *Time                           `json:"exportedAt,omitempty"`
+	Catalogs           []ConfigBundleCatalog           `json:"catalogs,omitempty"`
+	CatalogEntries     []ConfigBundleCatalogEntry      `json:"catalogEntries,omitempty"`
+	Servers            []ConfigBundleServer            `json:"servers,omitempty"`
+	AccessControlRules []ConfigBundleAccessControlRule `json:"accessControlRules,omitempty"`
+	WebhookValidations []ConfigBundleWebhookValidation `json:"webhookValidations,omitempty"`
+	SystemMCPServers   []ConfigBundleSystemMCPServer   `json:"systemMCPServers,omitempty"`
+}
+
+// ConfigBundleSecretRef identifies the credential holding a resource's secrets.
+// The credential must be configured separately in the target environment.
+type ConfigBundleSecretRef struct {
+	Context string   `json:"context"`
+	Name    string   `json:"name"`
+	Keys    []string `json:"keys,omitempty"`
+}
+
+type ConfigBundleCatalog struct {
+	ID string `json:"id"`
+	MCPCatalogManifest
+}
+
+type ConfigBundleCatalogEntry struct {
+	ID               string                        `json:"id"`
+	MCPCatalogID     string                        `json:"mcpCatalogID"`
+	UnsupportedTools []string                      `json:"unsupportedTools,omitempty"`
+	Manifest         MCPServerCatalogEntryManifest `json:"manifest"`
+}
+
+type ConfigBundleServer struct {
+	ID               string                 `json:"id"`
+	MCPCatalogID     string                 `json:"mcpCatalogID"`
+	CatalogEntryID   string                 `json:"catalogEntryID,omitempty"`
+	UnsupportedTools []string               `json:"unsupportedTools,omitempty"`
+	Manifest         MCPServerManifest      `json:"manifest"`
+	SecretRef        *ConfigBundleSecretRef `json:"secretRef,omitempty"`
+}
+
+type ConfigBundleAccessControlRule struct {
+	ID           string                    `json:"id"`
+	MCPCatalogID string                    `json:"mcpCatalogID"`
+	Manifest     AccessControlRuleManifest `json:"manifest"`
+}
+
+type ConfigBundleWebhookValidation struct {
+	ID        string                       `json:"id"`
+	Manifest  MCPWebhookValidationManifest `json:"manifest"`
+	SecretRef *ConfigBundleSecretRef       `json:"secretRef,omitempty"`
+}
+
+type ConfigBundleSystemMCPServer struct {
+	ID        string                  `json:"id"`
+	Manifest  SystemMCPServerManifest `json:"manifest"`
+	SecretRef *ConfigBundleSecretRef  `json:"secretRef,omitempty"`
+}
+
+// ConfigImportResult describes the changes made, or with dry run the changes that would be made, by a config import.
+type ConfigImportResult struct {
+	DryRun    bool                 `json:"dryRun,omitempty"`
+	Created   []ConfigImportChange `json:"created"`
+	Updated   []ConfigImportChange `json:"updated"`
+	Deleted   []ConfigImportChange `json:"deleted"`
+	Unchanged int                  `json:"unchanged"`
+	// MissingSecrets lists the secret references in the bundle that are not configured in this environment.
+	MissingSecrets []ConfigBundleSecretRef `json:"missingSecrets,omitempty"`
+}
+
+type ConfigImportChange struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+}
@@ -0,0 +1,153 @@
+package types
+
+import "encoding/json"
+
+type EvalGraderType string
+
+const (
+	EvalGraderTypeExact      EvalGraderType = "exact"
+	EvalGraderTypeRegex      EvalGraderType = "regex"
+	EvalGraderTypeJSONSchema EvalGraderType = "jsonSchema"
+	EvalGraderTypeLLMJudge   EvalGraderType = "llmJudge"
+)
+
+type EvalRunState string
+
+const (
+	EvalRunStatePending  EvalRunState = "pending"
+	EvalRunStateRunning  EvalRunState = "running"
+	EvalRunStateComplete EvalRunState = "complete"
+	EvalRunStateError    EvalRunState = "error"
+)
+
+type EvalCaseState string
+
+const (
+	EvalCaseStatePending EvalCaseState = "pending"
+	EvalCaseStateRunning EvalCaseState = "running"
+	EvalCaseStatePassed  EvalCaseState = "passed"
+	EvalCaseStateFailed  EvalCaseState = "failed"
+	EvalCaseStateError   EvalCaseState = "error"
+)
+
+func (in EvalCaseState) IsTerminal() bool {
+	return in == EvalCaseStatePassed || in == EvalCaseStateFailed || in == EvalCaseStateError
+}
+
+const (
+	MaxEvalCases       = 500
+	DefaultEvalWorkers = 4
+	MaxEvalWorkers     = 16
+)
+
+type EvalDataset struct {
+	Metadata
+	EvalDatasetManifest
+	ProjectID string `json:"projectID,omitempty"`
+}
+
+type EvalDatasetManifest struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Cases       []EvalCase `json:"cases"`
+}
+
+type EvalDatasetList List[EvalDataset]
+
+type EvalCase struct {
+	// ID identifies the case in results, it defaults to the position of the case in the dataset.
+	ID     string     `json:"id,omitempty"`
+	Input  string     `json:"input"`
+	Grader EvalGrader `json:"grader"`
+}
+
+type EvalGrader struct {
+	Type EvalGraderType `json:"type"`
+	// Expected is the expected output for the exact grader.
+	Expected string `json:"expected,omitempty"`
+	// IgnoreCase makes the exact and regex graders case-insensitive.
+	IgnoreCase bool `json:"ignoreCase,omitempty"`
+	// Pattern is the regular expression that the output must match for the regex grader.
+	Pattern string `json:"pattern,omitempty"`
+	// Schema is the JSON schema that the output must conform to for the jsonSchema grader.
+	Schema json.RawMessage `json:"schema,omitempty"`
+	// Rubric is the grading instructions for the llmJudge grader.
+	Rubric string `json:"rubric,omitempty"`
+}
+
+// EvalConfiguration is the model and prompt configuration that an eval run uses. Empty fields use the
+// configuration of the project.
+type EvalConfiguration struct {
+	Name          string   `json:"name,omitempty"`
+	ModelProvider string   `json:"modelProvider,omitempty"`
+	Model         string   `json:"model,omitempty"`
+	Temperature   *float32 `json:"temperature,omitempty"`
+	// Prompt is added to the instructions of the project.
+	Prompt string `json:"prompt,omitempty"`
+	// Tools are added to the tools of the project.
+	Tools []string `json:"tools,omitempty"`
+}
+
+type EvalRunManifest struct {
+	Configuration EvalConfiguration `json:"configuration"`
+	// Workers is the number of cases that are run at the same time. Defaults to DefaultEvalWorkers.
+	Workers int `json:"workers,omitempty"`
+}
+
+type EvalRun struct {
+	Metadata
+	EvalRunManifest
+	DatasetID string           `json:"datasetID,omitempty"`
+	ProjectID string           `json:"projectID,omitempty"`
+	State     EvalRunState     `json:"state,omitempty"`
+	Error     string           `json:"error,omitempty"`
+	StartTime *Time            `json:"startTime,omitempty"`
+	EndTime   *Time            `json:"endTime,omitempty"`
+	Results   []EvalCaseResult `json:"results,omitempty"`
+	Summary   EvalSummary      `json:"summary"`
+}
+
+type EvalRunList List[EvalRun]
+
+type EvalCaseResult struct {
+	CaseID           string        `json:"caseID"`
+	State            EvalCaseState `json:"state"`
+	RunID            string        `json:"runID,omitempty"`
+	Output           string        `json:"output,omitempty"`
+	Reason           string        `json:"reason,omitempty"`
+	LatencyMillis    int64         `json:"latencyMillis,omitempty"`
+	PromptTokens     int           `json:"promptTokens,omitempty"`
+	CompletionTokens int           `json:"completionTokens,omitempty"`
+	TotalTokens      int           `json:"totalTokens,omitempty"`
+}
+
+type EvalSummary struct {
+	Total                int     `json:"total"`
+	Passed               int     `json:"passed"`
+	Failed               int     `json:"failed"`
+	Errors               int     `json:"errors"`
+	PassRate             float64 `json:"passRate"`
+	AverageLatencyMillis int64   `json:"averageLatencyMillis"`
+	PromptTokens         int     `json:"promptTokens"`
+	CompletionTokens     int     `json:"completionTokens"`
+	TotalTokens          int     `json:"totalTokens"`
+}
+
+// EvalComparison compares a candidate eval run against a baseline eval run of the same dataset.
+type EvalComparison struct {
+	Baseline                  EvalRun              `json:"baseline"`
+	Candidate                 EvalRun              `json:"candidate"`
+	PassRateDelta             float64              `json:"passRateDelta"`
+	AverageLatencyMillisDelta int64                `json:"averageLatencyMillisDelta"`
+	TotalTokensDelta          int                  `json:"totalTokensDelta"`
+	Cases                     []EvalCaseComparison `json:"cases"`
+}
+
+type EvalCaseComparison struct {
+	CaseID    string        `json:"caseID"`
+	Baseline  EvalCaseState `json:"baseline"`
+	Candidate EvalCaseState `json:"candidate"`
+	// Changed is "improved" if the case only passed in the candidate, "regressed" if it only passed in the baseline,
+	// and empty otherwise.
+	Changed string `json:"changed,omitempty"`
+}
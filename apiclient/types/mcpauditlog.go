@@ -26,6 +26,7 @@ type MCPAuditLog struct {
 	ProcessingTimeMs          int64           `json:"processingTimeMs"`
 	SessionID                 string          `json:"sessionID,omitempty"`
 	RequestID                 string          `json:"requestID,omitempty"`
+	TraceID                   string          `json:"traceID,omitempty"`
 	UserAgent                 string          `json:"userAgent,omitempty"`
 	RequestHeaders            json.RawMessage `json:"requestHeaders,omitempty"`
 	ResponseHeaders           json.RawMessage `json:"responseHeaders,omitempty"`
@@ -14,3 +14,36 @@ type MCPCatalogManifest struct {
 }
 
 type MCPCatalogList List[MCPCatalog]
+
+// MCPCatalogSyncPreview describes the changes a catalog refresh would make, without applying them.
+type MCPCatalogSyncPreview struct {
+	SourceURLs []string                    `json:"sourceURLs"`
+	Added      []MCPCatalogSyncEntryChange `json:"added"`
+	Removed    []MCPCatalogSyncEntryChange `json:"removed"`
+	Changed    []MCPCatalogSyncEntryChange `json:"changed"`
+	Unchanged  int                         `json:"unchanged"`
+	// SourceErrors contains the errors for sources that could not be read, keyed by source URL.
+	SourceErrors     map[string]string               `json:"sourceErrors,omitempty"`
+	ValidationErrors []MCPCatalogSyncValidationError `json:"validationErrors,omitempty"`
+	// PruneSkipped is true when the sync would not remove any entries because of source or validation errors.
+	PruneSkipped bool `json:"pruneSkipped,omitempty"`
+	// ServersNeedingUpdate is the number of deployed servers that would be marked as needing an update.
+	ServersNeedingUpdate int `json:"serversNeedingUpdate"`
+	// InstancesNeedingUpdate is the number of server instances connected to those servers.
+	InstancesNeedingUpdate int `json:"instancesNeedingUpdate"`
+}
+
+type MCPCatalogSyncEntryChange struct {
+	EntryID                string                       `json:"entryID"`
+	Name                   string                       `json:"name"`
+	SourceURL              string                       `json:"sourceURL,omitempty"`
+	Changes                []CatalogEntryManifestChange `json:"changes,omitempty"`
+	ServersNeedingUpdate   int                          `json:"serversNeedingUpdate,omitempty"`
+	InstancesNeedingUpdate int                          `json:"instancesNeedingUpdate,omitempty"`
+}
+
+type MCPCatalogSyncValidationError struct {
+	SourceURL string `json:"sourceURL"`
+	EntryName string `json:"entryName"`
+	Error     string `json:"error"`
+}
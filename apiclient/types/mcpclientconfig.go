@@ -0,0 +1,73 @@
+package types
+
+import "time"
+
+// MCPClientConfigFormat is the configuration file format of an MCP client.
+type MCPClientConfigFormat string
+
+const (
+	// MCPClientConfigFormatClaudeDesktop is claude_desktop_config.json. Claude Desktop only starts local servers from
+	// its configuration, so servers are connected with `obot mcp connect`.
+	MCPClientConfigFormatClaudeDesktop MCPClientConfigFormat = "claude-desktop"
+	// MCPClientConfigFormatCursor is the mcp.json of Cursor.
+	MCPClientConfigFormatCursor MCPClientConfigFormat = "cursor"
+	// MCPClientConfigFormatVSCode is the mcp.json of VS Code.
+	MCPClientConfigFormatVSCode MCPClientConfigFormat = "vscode"
+	// MCPClientConfigFormatCodex is the config.toml of Codex.
+	MCPClientConfigFormatCodex MCPClientConfigFormat = "codex"
+	// MCPClientConfigFormatMCPJSON is the .mcp.json that several clients read from the root of a project.
+	MCPClientConfigFormatMCPJSON MCPClientConfigFormat = "mcp-json"
+)
+
+// DefaultMCPClientConfigAPIKeyTTL is how long API keys minted for client configurations are valid by default.
+const DefaultMCPClientConfigAPIKeyTTL = 90 * 24 * time.Hour
+
+var MCPClientConfigFormats = []MCPClientConfigFormat{
+	MCPClientConfigFormatClaudeDesktop,
+	MCPClientConfigFormatCursor,
+	MCPClientConfigFormatVSCode,
+	MCPClientConfigFormatCodex,
+	MCPClientConfigFormatMCPJSON,
+}
+
+type MCPClientConfigRequest struct {
+	Format MCPClientConfigFormat `json:"format"`
+	// Servers limits the configuration to these servers, by registry name, short name or MCP server ID. All servers
+	// the user can connect to are included if it is empty.
+	Servers []string `json:"servers,omitempty"`
+	// MintAPIKeys creates an API key for the servers of the configuration and adds it to the configuration. It
+	// replaces the key created for the previous configuration of the same format. Without API keys, clients log in
+	// with OAuth when they connect.
+	MintAPIKeys bool `json:"mintAPIKeys,omitempty"`
+	// APIKeyExpiresAt is the expiration of the minted API key. It expires after DefaultMCPClientConfigAPIKeyTTL if it
+	// isn't set.
+	APIKeyExpiresAt *Time `json:"apiKeyExpiresAt,omitempty"`
+}
+
+type MCPClientConfig struct {
+	Format MCPClientConfigFormat `json:"format"`
+	// Path is where the client usually reads the configuration from, when it is the same on every operating system.
+	Path string `json:"path"`
+	// Paths are where the client usually reads the configuration from by operating system, such as darwin or
+	// windows, when it depends on the operating system.
+	Paths map[string]string `json:"paths,omitempty"`
+	// Content is the configuration, to be merged into existing configuration of the client.
+	Content string                   `json:"content"`
+	Servers []MCPClientConfigServer  `json:"servers"`
+	Skipped []MCPClientConfigSkipped `json:"skipped,omitempty"`
+}
+
+type MCPClientConfigServer struct {
+	// Name is the key of the server in the configuration.
+	Name        string `json:"name"`
+	Title       string `json:"title,omitempty"`
+	URL         string `json:"url"`
+	MCPServerID string `json:"mcpServerID,omitempty"`
+	// APIKeyID is the ID of the API key minted for the configuration, shared by its servers.
+	APIKeyID uint `json:"apiKeyID,omitempty"`
+}
+
+type MCPClientConfigSkipped struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
@@ -62,8 +64,24 @@ type RemoteCatalogConfig struct {
 // CompositeCatalogConfig represents configuration for composite servers in catalog entries.
 type CompositeCatalogConfig struct {
 	ComponentServers []CatalogComponentServer `json:"componentServers"`
+	// ToolConflictPolicy determines how identically named tools from different component servers are exposed.
+	ToolConflictPolicy ToolConflictPolicy `json:"toolConflictPolicy,omitempty"`
 }
 
+// ToolConflictPolicy determines how a composite server exposes tools with the same name from more than one component server.
+// Components are considered in order of their names, so the result is deterministic. When no policy is set, tool names
+// are left unchanged.
+type ToolConflictPolicy string
+
+const (
+	// ToolConflictPolicyPrefix exposes each conflicting tool prefixed with its component server's name.
+	ToolConflictPolicyPrefix ToolConflictPolicy = "prefix"
+	// ToolConflictPolicyFirst exposes only the tool from the first component server that provides it.
+	ToolConflictPolicyFirst ToolConflictPolicy = "first"
+	// ToolConflictPolicyError rejects composite configurations with conflicting tool names.
+	ToolConflictPolicyError ToolConflictPolicy = "error"
+)
+
 type CatalogComponentServer struct {
 	// CatalogEntryID if set, reference the catalog entry the component server is sourced from
 	CatalogEntryID string `json:"catalogEntryID,omitempty"`
@@ -87,6 +105,8 @@ func (c CatalogComponentServer) ComponentID() string {
 
 type CompositeRuntimeConfig struct {
 	ComponentServers []ComponentServer `json:"componentServers"`
+	// ToolConflictPolicy determines how identically named tools from different component servers are exposed.
+	ToolConflictPolicy ToolConflictPolicy `json:"toolConflictPolicy,omitempty"`
 }
 
 type ComponentServer struct {
@@ -125,8 +145,62 @@ type MCPServerCatalogEntry struct {
 	PowerUserID               string                        `json:"powerUserID,omitempty"`
 	NeedsUpdate               bool                          `json:"needsUpdate,omitempty"`
 	OAuthCredentialConfigured bool                          `json:"oauthCredentialConfigured,omitempty"`
+	Approval                  *CatalogEntryApproval         `json:"approval,omitempty"`
+}
+
+// CatalogEntryApprovalState is the review state of a power user workspace catalog entry revision.
+type CatalogEntryApprovalState string
+
+const (
+	CatalogEntryApprovalStatePending  CatalogEntryApprovalState = "pending"
+	CatalogEntryApprovalStateApproved CatalogEntryApprovalState = "approved"
+	CatalogEntryApprovalStateRejected CatalogEntryApprovalState = "rejected"
+)
+
+// CatalogEntryApproval tracks the admin review of a power user workspace catalog entry.
+// The entry's manifest always contains the most recently approved revision; submitted changes
+// are held in PendingManifest until an admin approves them.
+type CatalogEntryApproval struct {
+	// State is the review state of the most recently submitted revision.
+	State CatalogEntryApprovalState `json:"state,omitempty"`
+	// PendingManifest is the submitted revision awaiting review. It is nil once the revision has been approved.
+	PendingManifest *MCPServerCatalogEntryManifest `json:"pendingManifest,omitempty"`
+	// HasApprovedRevision indicates whether any revision of the entry has been approved.
+	// Entries without an approved revision cannot be launched.
+	HasApprovedRevision bool   `json:"hasApprovedRevision,omitempty"`
+	SubmittedBy         string `json:"submittedBy,omitempty"`
+	SubmittedAt         *Time  `json:"submittedAt,omitempty"`
+	ReviewedBy          string `json:"reviewedBy,omitempty"`
+	ReviewedAt          *Time  `json:"reviewedAt,omitempty"`
+	// Comment is the reviewer's comment on the most recent decision.
+	Comment string `json:"comment,omitempty"`
+}
+
+// CatalogEntryApprovalDecision is the request body used by admins to approve or reject a pending revision.
+type CatalogEntryApprovalDecision struct {
+	Approve bool   `json:"approve"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// CatalogEntryManifestChange describes a single changed field between two catalog entry manifests.
+// Previous and Current are JSON encoded values, empty when the field is absent.
+type CatalogEntryManifestChange struct {
+	Path     string `json:"path"`
+	Previous string `json:"previous,omitempty"`
+	Current  string `json:"current,omitempty"`
+}
+
+// CatalogEntryRevisionDiff compares the pending revision of a catalog entry with its last approved revision.
+type CatalogEntryRevisionDiff struct {
+	EntryID              string                         `json:"entryID"`
+	PowerUserWorkspaceID string                         `json:"powerUserWorkspaceID,omitempty"`
+	Approval             CatalogEntryApproval           `json:"approval"`
+	ApprovedManifest     *MCPServerCatalogEntryManifest `json:"approvedManifest,omitempty"`
+	Changes              []CatalogEntryManifestChange   `json:"changes"`
 }
 
+type CatalogEntryRevisionDiffList List[CatalogEntryRevisionDiff]
+
 type MCPServerCatalogEntryManifest struct {
 	Metadata         map[string]string `json:"metadata,omitempty"`
 	Name             string            `json:"name"`
@@ -168,6 +242,28 @@ type ToolOverride struct {
 
 	// Enabled indicates if the tool should be included in the tool allowlist.
 	Enabled bool `json:"enabled,omitempty"`
+
+	// Arguments inject default values into, or hide, the tool's arguments.
+	Arguments []ToolArgumentOverride `json:"arguments,omitempty"`
+}
+
+// ToolArgumentOverride defines how a single argument of a component tool is exposed by the composite server
+type ToolArgumentOverride struct {
+	// Name is the argument name in the tool's input schema
+	Name string `json:"name"`
+
+	// Default is the JSON value used when the caller doesn't provide the argument, so it keeps its type.
+	// String defaults may reference the composite server's configuration values with ${VAR}, and the user's ID with
+	// ${OBOT_USER_ID}.
+	Default json.RawMessage `json:"default,omitempty"`
+
+	// Hidden removes the argument from the exposed tool schema, so the default is always used. Hidden arguments require a default.
+	Hidden bool `json:"hidden,omitempty"`
+}
+
+// HasDefault returns true if the argument has a default value.
+func (a ToolArgumentOverride) HasDefault() bool {
+	return len(a.Default) > 0 && !bytes.Equal(a.Default, []byte("null"))
 }
 
 type MCPHeader struct {
@@ -276,6 +372,9 @@ type MCPServer struct {
 
 	// CompositeName is the name of the composite server that this MCP server is a component of, if there is one.
 	CompositeName string `json:"compositeName,omitempty"`
+
+	// PendingApprovalTools lists the tools that newly appeared on this server and are disabled until an admin approves them.
+	PendingApprovalTools []string `json:"pendingApprovalTools,omitempty"`
 }
 
 type DeploymentCondition struct {
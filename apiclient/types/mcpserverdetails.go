@@ -19,4 +19,6 @@ type MCPServerDetails struct {
 	Replicas       int32            `json:"replicas"`
 	IsAvailable    bool             `json:"isAvailable"`
 	Events         []MCPServerEvent `json:"events"`
+	// Health is the result of the periodic health probes. It is only set for multi-user and system MCP servers.
+	Health *MCPServerHealth `json:"health,omitempty"`
 }
@@ -0,0 +1,34 @@
+package types
+
+type MCPServerHealthState string
+
+const (
+	// MCPServerHealthStateHealthy means the last probe of the server succeeded.
+	MCPServerHealthStateHealthy MCPServerHealthState = "healthy"
+	// MCPServerHealthStateFailing means the last probe of the server failed, but not enough probes in a row failed to
+	// consider the server unhealthy.
+	MCPServerHealthStateFailing MCPServerHealthState = "failing"
+	// MCPServerHealthStateUnhealthy means the failure threshold of consecutive probes failed.
+	MCPServerHealthStateUnhealthy MCPServerHealthState = "unhealthy"
+)
+
+// MCPServerProbe is the result of a single health probe of an MCP server.
+type MCPServerProbe struct {
+	Time      Time   `json:"time"`
+	Healthy   bool   `json:"healthy"`
+	LatencyMS int64  `json:"latencyMS"`
+	Error     string `json:"error,omitempty"`
+}
+
+// MCPServerHealth is the health of a multi-user or system MCP server, as seen by the periodic health probes.
+type MCPServerHealth struct {
+	State               MCPServerHealthState `json:"state"`
+	LastChecked         *Time                `json:"lastChecked,omitempty"`
+	LastHealthy         *Time                `json:"lastHealthy,omitempty"`
+	ConsecutiveFailures int                  `json:"consecutiveFailures"`
+	LastError           string               `json:"lastError,omitempty"`
+	// Uptime is the percentage of the probes in History that succeeded.
+	Uptime float64 `json:"uptime"`
+	// History contains the most recent probes of the server, oldest first.
+	History []MCPServerProbe `json:"history,omitempty"`
+}
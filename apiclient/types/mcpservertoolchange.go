@@ -0,0 +1,45 @@
+package types
+
+type MCPServerToolChangeType string
+
+const (
+	MCPServerToolChangeTypeAdded              MCPServerToolChangeType = "added"
+	MCPServerToolChangeTypeRemoved            MCPServerToolChangeType = "removed"
+	MCPServerToolChangeTypeDescriptionChanged MCPServerToolChangeType = "descriptionChanged"
+	MCPServerToolChangeTypeSchemaChanged      MCPServerToolChangeType = "schemaChanged"
+)
+
+// MCPServerToolSnapshot is a tool as it was last observed on a running MCP server.
+type MCPServerToolSnapshot struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// SchemaHash is the hash of the tool's input schema.
+	SchemaHash  string `json:"schemaHash,omitempty"`
+	Destructive bool   `json:"destructive,omitempty"`
+}
+
+// MCPServerToolChange is a change to a tool detected on a running MCP server.
+type MCPServerToolChange struct {
+	DetectedAt          Time                    `json:"detectedAt"`
+	Tool                string                  `json:"tool"`
+	Type                MCPServerToolChangeType `json:"type"`
+	PreviousDescription string                  `json:"previousDescription,omitempty"`
+	Description         string                  `json:"description,omitempty"`
+	// Baseline is what the server's tools were compared against, either "toolPreview" or "snapshot".
+	Baseline string `json:"baseline"`
+	// SuspiciousReasons explains why the change should be reviewed. It is empty for changes that don't look suspicious.
+	SuspiciousReasons []string `json:"suspiciousReasons,omitempty"`
+	// Disabled indicates that the tool was disabled until an admin approves it.
+	Disabled bool `json:"disabled,omitempty"`
+}
+
+type MCPServerToolChanges struct {
+	LastChecked *Time                 `json:"lastChecked,omitempty"`
+	Changelog   []MCPServerToolChange `json:"changelog"`
+	// PendingApproval lists the tools that are disabled until an admin approves them.
+	PendingApproval []string `json:"pendingApproval"`
+}
+
+type MCPServerToolApprovalRequest struct {
+	Tools []string `json:"tools"`
+}
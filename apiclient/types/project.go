@@ -36,6 +36,8 @@ type OnEmail struct {
 
 type OnWebhook struct {
 	WebhookManifest `json:",inline"`
+	// URL is the address that requests are posted to. It is set by the server for task webhooks.
+	URL string `json:"url,omitempty"`
 }
 
 type ProjectManifest struct {
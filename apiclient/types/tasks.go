@@ -1,5 +1,7 @@
 package types
 
+import "encoding/json"
+
 type Task struct {
 	Metadata
 	TaskManifest
@@ -16,26 +18,158 @@ type TaskManifest struct {
 	Steps       []TaskStep    `json:"steps"`
 	Schedule    *Schedule     `json:"schedule"`
 	OnDemand    *TaskOnDemand `json:"onDemand"`
+	Webhook     *OnWebhook    `json:"webhook"`
+	// RetryPolicy controls whether failed runs are retried.
+	RetryPolicy *TaskRetryPolicy `json:"retryPolicy,omitempty"`
+	// Notifications are sent when runs finish.
+	Notifications []TaskNotification `json:"notifications,omitempty"`
+	// OutputSchema is a JSON Schema for the final output. When set, the last step is asked to respond with conforming
+	// JSON, which is returned as the structured output of the run.
+	OutputSchema json.RawMessage `json:"outputSchema,omitempty"`
+	// OutputRetries is how many times the model is asked again when the output doesn't conform to the schema.
+	// Defaults to DefaultOutputRetries when not set, and 0 turns retries off.
+	OutputRetries *int `json:"outputRetries,omitempty"`
+}
+
+const (
+	DefaultOutputRetries = 2
+	MaxOutputRetries     = 5
+)
+
+type TaskRetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int `json:"maxAttempts"`
+	// InitialBackoffSeconds is the delay before the first retry. It doubles for every later retry. Defaults to 30.
+	InitialBackoffSeconds int `json:"initialBackoffSeconds,omitempty"`
+	// MaxBackoffSeconds caps the delay between retries. Defaults to 3600.
+	MaxBackoffSeconds int `json:"maxBackoffSeconds,omitempty"`
+	// RetryOn are the kinds of failures that are retried. Defaults to rate limits and MCP server launch failures.
+	RetryOn []TaskRetryCondition `json:"retryOn,omitempty"`
+}
+
+const (
+	DefaultTaskRetryInitialBackoffSeconds = 30
+	DefaultTaskRetryMaxBackoffSeconds     = 3600
+	// MaxTaskRetryAttempts is the most attempts that a retry policy can allow.
+	MaxTaskRetryAttempts = 10
+)
+
+type TaskRetryCondition string
+
+const (
+	// TaskRetryConditionRateLimit retries runs that failed because the model provider rate limited the request.
+	TaskRetryConditionRateLimit TaskRetryCondition = "rateLimit"
+	// TaskRetryConditionMCPServerLaunch retries runs that failed because an MCP server didn't start in time.
+	TaskRetryConditionMCPServerLaunch TaskRetryCondition = "mcpServerLaunch"
+	// TaskRetryConditionAny retries every failure, except runs that were aborted or rejected.
+	TaskRetryConditionAny TaskRetryCondition = "any"
+)
+
+type TaskNotificationEvent string
+
+const (
+	TaskNotificationEventFailure TaskNotificationEvent = "failure"
+	TaskNotificationEventSuccess TaskNotificationEvent = "success"
+)
+
+// TaskNotification is sent to one destination when a run finishes. Exactly one of Email, WebhookURL, or
+// SlackWebhookURL must be set.
+type TaskNotification struct {
+	// On are the events that send the notification. Defaults to failure.
+	On []TaskNotificationEvent `json:"on,omitempty"`
+	// Email is a list of addresses to send the notification to. It requires SMTP to be configured on the server.
+	Email []string `json:"email,omitempty"`
+	// WebhookURL receives a JSON payload describing the run, including the message.
+	WebhookURL string `json:"webhookURL,omitempty"`
+	// SlackWebhookURL is a Slack incoming webhook URL.
+	SlackWebhookURL string `json:"slackWebhookURL,omitempty"`
+	// Template is a Go template for the message. The fields are .Task, .TaskID, .RunID, .State, .Error, .Output,
+	// .Attempt, .StartTime, and .EndTime.
+	Template string `json:"template,omitempty"`
 }
 
 type TaskOnDemand struct {
 	Params map[string]string `json:"params,omitempty"`
 }
 
+// TaskWebhookDelivery is a request received by a task's webhook.
+type TaskWebhookDelivery struct {
+	RunID      string `json:"runID,omitempty"`
+	DeliveryID string `json:"deliveryID,omitempty"`
+	Event      string `json:"event,omitempty"`
+	ReceivedAt Time   `json:"receivedAt"`
+}
+
 type Schedule struct {
-	// Valid values are: "hourly", "daily", "weekly", "monthly"
+	// Valid values are: "hourly", "daily", "weekly", "monthly", "cron"
 	Interval string `json:"interval"`
 	Hour     int    `json:"hour"`
 	Minute   int    `json:"minute"`
 	Day      int    `json:"day"`
 	Weekday  int    `json:"weekday"`
 	TimeZone string `json:"timezone"`
+	// Expression is a cron expression, used when the interval is "cron".
+	Expression string `json:"expression,omitempty"`
+
+	// ConcurrencyPolicy controls what happens when a run is due while the previous run is still going.
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+	// CatchUpPolicy controls which runs are started for times that were missed, for example while the server was down.
+	CatchUpPolicy CatchUpPolicy `json:"catchUpPolicy,omitempty"`
+	// Paused stops new runs from being started. Runs that are missed while paused are skipped.
+	Paused bool `json:"paused,omitempty"`
+	// JitterSeconds delays each run by a random amount up to this many seconds.
+	JitterSeconds int `json:"jitterSeconds,omitempty"`
+	// RunWindow restricts when scheduled runs start. Scheduled times outside of the window are passed over, and a run
+	// that would start after the window closed, for example when catching up or because of jitter, is skipped.
+	RunWindow *RunWindow `json:"runWindow,omitempty"`
+}
+
+// RunWindow is the part of the day, in the schedule's time zone, during which scheduled runs can start.
+type RunWindow struct {
+	// Start is the time of day the window opens, as "HH:MM".
+	Start string `json:"start"`
+	// End is the time of day the window closes, as "HH:MM". A window that ends before it starts spans midnight.
+	End string `json:"end"`
+	// Weekdays are the days the window opens on, from 0 for Sunday to 6 for Saturday. It opens every day if empty.
+	Weekdays []int `json:"weekdays,omitempty"`
 }
 
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyPolicyAllow starts runs even when the previous run is still going. This is the default.
+	ConcurrencyPolicyAllow ConcurrencyPolicy = "allow"
+	// ConcurrencyPolicyForbid skips runs while the previous run is still going.
+	ConcurrencyPolicyForbid ConcurrencyPolicy = "forbid"
+	// ConcurrencyPolicyReplace aborts the previous run before starting a new one.
+	ConcurrencyPolicyReplace ConcurrencyPolicy = "replace"
+)
+
+type CatchUpPolicy string
+
+const (
+	// CatchUpPolicyOnce starts a single run for all missed times. This is the default.
+	CatchUpPolicyOnce CatchUpPolicy = "once"
+	// CatchUpPolicySkip doesn't start runs for missed times.
+	CatchUpPolicySkip CatchUpPolicy = "skip"
+	// CatchUpPolicyAll starts a run for each missed time, up to MaxCatchUpRuns.
+	CatchUpPolicyAll CatchUpPolicy = "all"
+)
+
+// MaxCatchUpRuns is the most runs that are started for missed times with the "all" catch-up policy.
+const MaxCatchUpRuns = 10
+
+// MaxScheduleJitterSeconds is the largest jitter that a schedule can have.
+const MaxScheduleJitterSeconds = 3600
+
 type TaskStep struct {
-	ID   string   `json:"id,omitempty"`
-	Step string   `json:"step,omitempty"`
-	Loop []string `json:"loop,omitempty"`
+	ID          string       `json:"id,omitempty"`
+	Step        string       `json:"step,omitempty"`
+	Loop        []string     `json:"loop,omitempty"`
+	If          *If          `json:"if,omitempty"`
+	While       *While       `json:"while,omitempty"`
+	SubWorkflow *SubWorkflow `json:"subWorkflow,omitempty"`
+	Approval    *Approval    `json:"approval,omitempty"`
 }
 
 type TaskRun struct {
@@ -49,6 +183,56 @@ type TaskRun struct {
 	EndTime   *Time        `json:"endTime,omitempty"`
 	Error     string       `json:"error,omitempty"`
 	Warning   string       `json:"warning,omitempty"`
+	// ParentRunID is set when this run was started by a sub-workflow step of another run.
+	ParentRunID string       `json:"parentRunID,omitempty"`
+	SubRuns     []TaskSubRun `json:"subRuns,omitempty"`
+	// PendingApprovals are the approval steps of this run that are waiting for a decision.
+	PendingApprovals []TaskRunApproval `json:"pendingApprovals,omitempty"`
+	// WebhookDelivery is set when the run was triggered by the task's webhook.
+	WebhookDelivery *TaskWebhookDelivery `json:"webhookDelivery,omitempty"`
+	// Attempt is the number of this attempt, starting at 1.
+	Attempt int `json:"attempt,omitempty"`
+	// PreviousAttempts are the failed attempts that were retried by this run, oldest first.
+	PreviousAttempts []TaskRunAttempt `json:"previousAttempts,omitempty"`
+	// RetryRunID is the run that retries this run, if it was retried.
+	RetryRunID string `json:"retryRunID,omitempty"`
+	// RetryAt is when this run will be retried.
+	RetryAt *Time `json:"retryAt,omitempty"`
+	// StructuredOutput is the output as JSON, validated against the task's output schema.
+	StructuredOutput json.RawMessage `json:"structuredOutput,omitempty"`
+}
+
+type TaskRunAttempt struct {
+	RunID     string `json:"runID,omitempty"`
+	Error     string `json:"error,omitempty"`
+	StartTime *Time  `json:"startTime,omitempty"`
+	EndTime   *Time  `json:"endTime,omitempty"`
+}
+
+// TaskRunApproval is an approval step of a task run that is waiting for a decision.
+type TaskRunApproval struct {
+	TaskID      string   `json:"taskID"`
+	RunID       string   `json:"runID"`
+	StepID      string   `json:"stepID"`
+	Message     string   `json:"message,omitempty"`
+	Approvers   []string `json:"approvers,omitempty"`
+	Groups      []string `json:"groups,omitempty"`
+	RequestedAt Time     `json:"requestedAt"`
+	ExpiresAt   Time     `json:"expiresAt"`
+}
+
+type TaskRunApprovalList List[TaskRunApproval]
+
+// TaskRunApprovalDecision is the body of a request to approve or reject an approval step.
+type TaskRunApprovalDecision struct {
+	Comment string `json:"comment,omitempty"`
+}
+
+// TaskSubRun is a run started by a sub-workflow step.
+type TaskSubRun struct {
+	StepID string `json:"stepID"`
+	TaskID string `json:"taskID"`
+	RunID  string `json:"runID"`
 }
 
 type TaskRunList List[TaskRun]
@@ -21,20 +21,22 @@ func (in WorkflowState) IsTerminal() bool {
 type Thread struct {
 	Metadata
 	ThreadManifest
-	AssistantID  string   `json:"assistantID,omitempty"`
-	TaskID       string   `json:"taskID,omitempty"`
-	TaskRunID    string   `json:"taskRunID,omitempty"`
-	State        string   `json:"state,omitempty"`
-	LastRunID    string   `json:"lastRunID,omitempty"`
-	CurrentRunID string   `json:"currentRunID,omitempty"`
-	ProjectID    string   `json:"projectID,omitempty"`
-	UserID       string   `json:"userID,omitempty"`
-	Abort        bool     `json:"abort,omitempty"`
-	SystemTask   bool     `json:"systemTask,omitempty"`
-	Ephemeral    bool     `json:"ephemeral,omitempty"`
-	Project      bool     `json:"project,omitempty"`
-	Env          []string `json:"env,omitempty"`
-	Ready        bool     `json:"ready,omitempty"`
+	AssistantID        string   `json:"assistantID,omitempty"`
+	TaskID             string   `json:"taskID,omitempty"`
+	TaskRunID          string   `json:"taskRunID,omitempty"`
+	State              string   `json:"state,omitempty"`
+	LastRunID          string   `json:"lastRunID,omitempty"`
+	CurrentRunID       string   `json:"currentRunID,omitempty"`
+	ProjectID          string   `json:"projectID,omitempty"`
+	UserID             string   `json:"userID,omitempty"`
+	Abort              bool     `json:"abort,omitempty"`
+	SystemTask         bool     `json:"systemTask,omitempty"`
+	Ephemeral          bool     `json:"ephemeral,omitempty"`
+	Project            bool     `json:"project,omitempty"`
+	Env                []string `json:"env,omitempty"`
+	Ready              bool     `json:"ready,omitempty"`
+	ForkedFromThreadID string   `json:"forkedFromThreadID,omitempty"`
+	ForkedFromRunID    string   `json:"forkedFromRunID,omitempty"`
 }
 
 type ThreadList List[Thread]
@@ -53,7 +55,24 @@ type ThreadManifest struct {
 	Tools           []string            `json:"tools,omitempty"`
 	ModelProvider   string              `json:"modelProvider,omitempty"`
 	Model           string              `json:"model,omitempty"`
+	Temperature     *float32            `json:"temperature,omitempty"`
 	Prompt          string              `json:"prompt"`
 	SharedTasks     []string            `json:"sharedTasks,omitempty"`
 	AllowedMCPTools map[string][]string `json:"allowedMCPTools,omitempty"`
 }
+
+// ThreadFork creates a new thread from an existing thread at the given run. The run is executed again
+// in the new thread with the conversation that preceded it, and the original thread is left unchanged.
+type ThreadFork struct {
+	// RunID is the run to fork from.
+	RunID string `json:"runID"`
+	// Input replaces the input of the run. The run's own input is used if this is empty.
+	Input string `json:"input,omitempty"`
+	// ModelProvider and Model replace the model of the thread.
+	ModelProvider string `json:"modelProvider,omitempty"`
+	Model         string `json:"model,omitempty"`
+	// Temperature replaces the temperature of the model.
+	Temperature *float32 `json:"temperature,omitempty"`
+	// Tools replaces the tools of the thread. The thread's tools are kept if this is null.
+	Tools []string `json:"tools"`
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ToolApprovalDecision string
+
+const (
+	// ToolApprovalDecisionApprove runs the tool call without asking the user.
+	ToolApprovalDecisionApprove ToolApprovalDecision = "approve"
+	// ToolApprovalDecisionConfirm asks the user to confirm the tool call, even when autonomous tool use is enabled.
+	ToolApprovalDecisionConfirm ToolApprovalDecision = "confirm"
+	// ToolApprovalDecisionDeny rejects the tool call without asking the user.
+	ToolApprovalDecisionDeny ToolApprovalDecision = "deny"
+)
+
+type ToolApprovalPolicy struct {
+	Metadata                   `json:",inline"`
+	ToolApprovalPolicyManifest `json:",inline"`
+}
+
+// ToolApprovalPolicyManifest decides whether tool calls of the subjects of the policy need to be confirmed.
+// Tool patterns use the same format as the approved tools of a thread: the full tool name, such as
+// "GitHub -> create_issue", or a prefix followed by the wildcard "*", such as "GitHub -> *".
+type ToolApprovalPolicyManifest struct {
+	DisplayName string    `json:"displayName,omitempty"`
+	Subjects    []Subject `json:"subjects,omitempty"`
+	// AutoApproveReadOnly approves calls of MCP tools annotated with readOnlyHint.
+	AutoApproveReadOnly bool `json:"autoApproveReadOnly,omitempty"`
+	// ConfirmDestructive requires confirmation of calls of MCP tools annotated with destructiveHint.
+	ConfirmDestructive bool `json:"confirmDestructive,omitempty"`
+	// ApprovedTools are tool patterns whose calls are approved.
+	ApprovedTools []string `json:"approvedTools,omitempty"`
+	// ConfirmTools are tool patterns whose calls always require confirmation.
+	ConfirmTools []string `json:"confirmTools,omitempty"`
+	// DeniedTools are tool patterns whose calls are rejected.
+	DeniedTools []string `json:"deniedTools,omitempty"`
+	// NonInteractive decides the calls that require confirmation in tasks and workflows, where there is no user to
+	// confirm them. It is either approve, the default, or deny.
+	NonInteractive ToolApprovalDecision `json:"nonInteractive,omitempty"`
+}
+
+func (m ToolApprovalPolicyManifest) Validate() error {
+	if len(m.Subjects) == 0 {
+		return fmt.Errorf("at least one subject is required")
+	}
+
+	subjects := make(map[Subject]struct{}, len(m.Subjects))
+	for _, subject := range m.Subjects {
+		if err := subject.Validate(); err != nil {
+			return fmt.Errorf("invalid subject: %w", err)
+		}
+
+		if subject.ID == "*" && len(m.Subjects) > 1 {
+			return fmt.Errorf("wildcard subject (*) must be the only subject")
+		}
+
+		if _, ok := subjects[subject]; ok {
+			return fmt.Errorf("duplicate subject: %s/%s", subject.Type, subject.ID)
+		}
+		subjects[subject] = struct{}{}
+	}
+
+	for field, patterns := range map[string][]string{
+		"approvedTools": m.ApprovedTools,
+		"confirmTools":  m.ConfirmTools,
+		"deniedTools":   m.DeniedTools,
+	} {
+		for _, pattern := range patterns {
+			if strings.TrimSpace(pattern) == "" {
+				return fmt.Errorf("%s: tool pattern cannot be empty", field)
+			}
+			if strings.Contains(strings.TrimSuffix(pattern, "*"), "*") {
+				return fmt.Errorf("%s: the wildcard (*) is only allowed at the end of tool pattern %q", field, pattern)
+			}
+		}
+	}
+
+	switch m.NonInteractive {
+	case "", ToolApprovalDecisionApprove, ToolApprovalDecisionDeny:
+	default:
+		return fmt.Errorf("nonInteractive must be %s or %s", ToolApprovalDecisionApprove, ToolApprovalDecisionDeny)
+	}
+
+	if !m.AutoApproveReadOnly && !m.ConfirmDestructive && len(m.ApprovedTools) == 0 && len(m.ConfirmTools) == 0 && len(m.DeniedTools) == 0 {
+		return fmt.Errorf("at least one rule is required")
+	}
+
+	return nil
+}
+
+type ToolApprovalPolicyList List[ToolApprovalPolicy]
@@ -16,6 +16,29 @@ type WebhookManifest struct {
 	Headers          []string `json:"headers"`
 	Secret           string   `json:"secret"`
 	ValidationHeader string   `json:"validationHeader"`
+	// SignatureScheme selects how requests are verified with the Secret. When it is empty, only the secret URL
+	// protects the webhook. The generic scheme reads the signature from ValidationHeader, X-Signature by default.
+	SignatureScheme WebhookSignatureScheme `json:"signatureScheme,omitempty"`
+	// Filters must all match the payload for the webhook to start a run.
+	Filters []WebhookFilter `json:"filters,omitempty"`
+}
+
+type WebhookSignatureScheme string
+
+const (
+	// WebhookSignatureSchemeGitHub verifies the X-Hub-Signature-256 header sent by GitHub.
+	WebhookSignatureSchemeGitHub WebhookSignatureScheme = "github"
+	// WebhookSignatureSchemeStripe verifies the timestamped Stripe-Signature header sent by Stripe.
+	WebhookSignatureSchemeStripe WebhookSignatureScheme = "stripe"
+	// WebhookSignatureSchemeGeneric verifies a hex encoded HMAC-SHA256 of the body in ValidationHeader.
+	WebhookSignatureSchemeGeneric WebhookSignatureScheme = "generic"
+)
+
+type WebhookFilter struct {
+	// Path is a dot separated path into the JSON payload, such as "action" or "pull_request.base.ref".
+	Path string `json:"path"`
+	// Values are the allowed values at the path. When empty, the path only has to exist.
+	Values []string `json:"values,omitempty"`
 }
 
 type WebhookList List[Webhook]
@@ -1,6 +1,9 @@
 package types
 
-import "strings"
+import (
+	"encoding/json"
+	"strings"
+)
 
 type Workflow struct {
 	Metadata
@@ -17,6 +20,15 @@ type WorkflowManifest struct {
 	Output      string            `json:"output"`
 	Name        string            `json:"name,omitempty"`
 	Description string            `json:"description,omitempty"`
+	// OutputSchema is a JSON Schema that the final output must conform to.
+	OutputSchema json.RawMessage `json:"outputSchema,omitempty"`
+	// OutputRetries is how many times the model is asked again when the output doesn't conform to the schema.
+	// Defaults to DefaultOutputRetries when not set, and 0 turns retries off.
+	OutputRetries *int `json:"outputRetries,omitempty"`
+	// RetryPolicy controls whether failed runs are retried.
+	RetryPolicy *TaskRetryPolicy `json:"retryPolicy,omitempty"`
+	// Notifications are sent when runs finish.
+	Notifications []TaskNotification `json:"notifications,omitempty"`
 }
 
 type EnvVar struct {
@@ -27,9 +39,87 @@ type EnvVar struct {
 }
 
 type Step struct {
-	ID   string   `json:"id,omitempty"`
-	Step string   `json:"step,omitempty"`
-	Loop []string `json:"loop,omitempty"`
+	ID          string       `json:"id,omitempty"`
+	Step        string       `json:"step,omitempty"`
+	Loop        []string     `json:"loop,omitempty"`
+	If          *If          `json:"if,omitempty"`
+	While       *While       `json:"while,omitempty"`
+	SubWorkflow *SubWorkflow `json:"subWorkflow,omitempty"`
+	Approval    *Approval    `json:"approval,omitempty"`
+}
+
+const (
+	// DefaultWhileMaxLoops is the number of iterations a while step runs at most when MaxLoops is not set.
+	DefaultWhileMaxLoops = 10
+	// MaxWhileMaxLoops is the largest allowed value for MaxLoops.
+	MaxWhileMaxLoops = 100
+)
+
+// If runs Steps when the condition is true, and Else otherwise.
+// Exactly one of Condition and Compare must be set.
+type If struct {
+	// Condition is judged by the model based on the conversation so far.
+	Condition string `json:"condition,omitempty"`
+	// Compare is checked against the output of the previous step without calling the model.
+	Compare *Comparison `json:"compare,omitempty"`
+	Steps   []Step      `json:"steps,omitempty"`
+	Else    []Step      `json:"else,omitempty"`
+}
+
+// While runs Steps for as long as the condition is true, up to MaxLoops times.
+// Exactly one of Condition and Compare must be set.
+type While struct {
+	// Condition is judged by the model based on the conversation so far.
+	Condition string `json:"condition,omitempty"`
+	// Compare is checked against the output of the previous step without calling the model.
+	Compare  *Comparison `json:"compare,omitempty"`
+	MaxLoops int         `json:"maxLoops,omitempty"`
+	Steps    []Step      `json:"steps,omitempty"`
+}
+
+// SubWorkflow starts another workflow in the same project and waits for it to finish.
+// The output of the other workflow becomes the output of the step.
+type SubWorkflow struct {
+	// WorkflowID is the ID, alias, or name of the workflow to start.
+	WorkflowID string `json:"workflowID"`
+	// Input maps the parameters of the other workflow to values. Values can reference the input of this
+	// workflow with ${input.<param>} and the output of an earlier step with ${steps.<stepID>.output}.
+	Input map[string]string `json:"input,omitempty"`
+}
+
+// DefaultApprovalTimeoutMinutes is how long an approval step waits for a decision when TimeoutMinutes is not set.
+const DefaultApprovalTimeoutMinutes = 24 * 60
+
+// Approval pauses the workflow until one of the approvers approves or rejects it.
+// When neither Approvers nor Groups is set, any member of the project can decide.
+type Approval struct {
+	// Message is shown to the approvers.
+	Message string `json:"message,omitempty"`
+	// Approvers are the IDs of the users that can decide.
+	Approvers []string `json:"approvers,omitempty"`
+	// Groups are the IDs of the auth provider groups whose members can decide.
+	Groups []string `json:"groups,omitempty"`
+	// TimeoutMinutes is how long to wait for a decision before the run fails.
+	TimeoutMinutes int `json:"timeoutMinutes,omitempty"`
+}
+
+type ComparisonOperator string
+
+const (
+	ComparisonOperatorEquals      ComparisonOperator = "equals"
+	ComparisonOperatorNotEquals   ComparisonOperator = "notEquals"
+	ComparisonOperatorContains    ComparisonOperator = "contains"
+	ComparisonOperatorNotContains ComparisonOperator = "notContains"
+	ComparisonOperatorMatches     ComparisonOperator = "matches"
+	ComparisonOperatorGreaterThan ComparisonOperator = "greaterThan"
+	ComparisonOperatorLessThan    ComparisonOperator = "lessThan"
+)
+
+// Comparison is a deterministic condition on the output of the previous step.
+// The output is trimmed of surrounding whitespace before it is compared with Value.
+type Comparison struct {
+	Operator ComparisonOperator `json:"operator"`
+	Value    string             `json:"value"`
 }
 
 func (s Step) Display() string {
@@ -41,6 +131,22 @@ func (s Step) Display() string {
 		preamble.WriteString(" ")
 		preamble.WriteString(oneLine(s.Step))
 	}
+	if s.If != nil {
+		preamble.WriteString(" if ")
+		preamble.WriteString(oneLine(s.If.Condition))
+	}
+	if s.While != nil {
+		preamble.WriteString(" while ")
+		preamble.WriteString(oneLine(s.While.Condition))
+	}
+	if s.SubWorkflow != nil {
+		preamble.WriteString(" run workflow ")
+		preamble.WriteString(s.SubWorkflow.WorkflowID)
+	}
+	if s.Approval != nil {
+		preamble.WriteString(" wait for approval ")
+		preamble.WriteString(oneLine(s.Approval.Message))
+	}
 	return preamble.String()
 }
 
@@ -70,6 +176,19 @@ func findInSteps(parentID string, steps []Step, id string) (*Step, string) {
 		if step.ID == id {
 			return &steps[i], parentID
 		}
+		if step.If != nil {
+			if found, parentID := findInSteps(step.ID, step.If.Steps, id); found != nil {
+				return found, parentID
+			}
+			if found, parentID := findInSteps(step.ID, step.If.Else, id); found != nil {
+				return found, parentID
+			}
+		}
+		if step.While != nil {
+			if found, parentID := findInSteps(step.ID, step.While.Steps, id); found != nil {
+				return found, parentID
+			}
+		}
 	}
 	return nil, ""
 }
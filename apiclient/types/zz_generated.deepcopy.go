@@ -46,6 +46,97 @@ func (in *APIActivityList) DeepCopy() *APIActivityList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKey) DeepCopyInto(out *APIKey) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	if in.LastUsedAt != nil {
+		in, out := &in.LastUsedAt, &out.LastUsedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.MCPServerIDs != nil {
+		in, out := &in.MCPServerIDs, &out.MCPServerIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKey.
+func (in *APIKey) DeepCopy() *APIKey {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyCreateRequest) DeepCopyInto(out *APIKeyCreateRequest) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.MCPServerIDs != nil {
+		in, out := &in.MCPServerIDs, &out.MCPServerIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyCreateRequest.
+func (in *APIKeyCreateRequest) DeepCopy() *APIKeyCreateRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyCreateRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyCreateResponse) DeepCopyInto(out *APIKeyCreateResponse) {
+	*out = *in
+	in.APIKey.DeepCopyInto(&out.APIKey)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyCreateResponse.
+func (in *APIKeyCreateResponse) DeepCopy() *APIKeyCreateResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyCreateResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyList) DeepCopyInto(out *APIKeyList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]APIKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyList.
+func (in *APIKeyList) DeepCopy() *APIKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessControlRule) DeepCopyInto(out *AccessControlRule) {
 	*out = *in
@@ -292,6 +383,31 @@ func (in *AppPreferences) DeepCopy() *AppPreferences {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Approval) DeepCopyInto(out *Approval) {
+	*out = *in
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Approval.
+func (in *Approval) DeepCopy() *Approval {
+	if in == nil {
+		return nil
+	}
+	out := new(Approval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Assistant) DeepCopyInto(out *Assistant) {
 	*out = *in
@@ -624,7 +740,9 @@ func (in *CatalogComponentServer) DeepCopyInto(out *CatalogComponentServer) {
 	if in.ToolOverrides != nil {
 		in, out := &in.ToolOverrides, &out.ToolOverrides
 		*out = make([]ToolOverride, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -638,6 +756,112 @@ func (in *CatalogComponentServer) DeepCopy() *CatalogComponentServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogEntryApproval) DeepCopyInto(out *CatalogEntryApproval) {
+	*out = *in
+	if in.PendingManifest != nil {
+		in, out := &in.PendingManifest, &out.PendingManifest
+		*out = new(MCPServerCatalogEntryManifest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubmittedAt != nil {
+		in, out := &in.SubmittedAt, &out.SubmittedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ReviewedAt != nil {
+		in, out := &in.ReviewedAt, &out.ReviewedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogEntryApproval.
+func (in *CatalogEntryApproval) DeepCopy() *CatalogEntryApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogEntryApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogEntryApprovalDecision) DeepCopyInto(out *CatalogEntryApprovalDecision) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogEntryApprovalDecision.
+func (in *CatalogEntryApprovalDecision) DeepCopy() *CatalogEntryApprovalDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogEntryApprovalDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogEntryManifestChange) DeepCopyInto(out *CatalogEntryManifestChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogEntryManifestChange.
+func (in *CatalogEntryManifestChange) DeepCopy() *CatalogEntryManifestChange {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogEntryManifestChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogEntryRevisionDiff) DeepCopyInto(out *CatalogEntryRevisionDiff) {
+	*out = *in
+	in.Approval.DeepCopyInto(&out.Approval)
+	if in.ApprovedManifest != nil {
+		in, out := &in.ApprovedManifest, &out.ApprovedManifest
+		*out = new(MCPServerCatalogEntryManifest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]CatalogEntryManifestChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogEntryRevisionDiff.
+func (in *CatalogEntryRevisionDiff) DeepCopy() *CatalogEntryRevisionDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogEntryRevisionDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogEntryRevisionDiffList) DeepCopyInto(out *CatalogEntryRevisionDiffList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CatalogEntryRevisionDiff, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogEntryRevisionDiffList.
+func (in *CatalogEntryRevisionDiffList) DeepCopy() *CatalogEntryRevisionDiffList {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogEntryRevisionDiffList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientInfo) DeepCopyInto(out *ClientInfo) {
 	*out = *in
@@ -699,6 +923,21 @@ func (in *CommonProviderStatus) DeepCopy() *CommonProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Comparison) DeepCopyInto(out *Comparison) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Comparison.
+func (in *Comparison) DeepCopy() *Comparison {
+	if in == nil {
+		return nil
+	}
+	out := new(Comparison)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ComponentServer) DeepCopyInto(out *ComponentServer) {
 	*out = *in
@@ -706,7 +945,9 @@ func (in *ComponentServer) DeepCopyInto(out *ComponentServer) {
 	if in.ToolOverrides != nil {
 		in, out := &in.ToolOverrides, &out.ToolOverrides
 		*out = make([]ToolOverride, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -765,92 +1006,346 @@ func (in *CompositeRuntimeConfig) DeepCopy() *CompositeRuntimeConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerizedRuntimeConfig) DeepCopyInto(out *ContainerizedRuntimeConfig) {
+func (in *ConfigBundle) DeepCopyInto(out *ConfigBundle) {
 	*out = *in
-	if in.Args != nil {
-		in, out := &in.Args, &out.Args
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.ExportedAt != nil {
+		in, out := &in.ExportedAt, &out.ExportedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Catalogs != nil {
+		in, out := &in.Catalogs, &out.Catalogs
+		*out = make([]ConfigBundleCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CatalogEntries != nil {
+		in, out := &in.CatalogEntries, &out.CatalogEntries
+		*out = make([]ConfigBundleCatalogEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]ConfigBundleServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AccessControlRules != nil {
+		in, out := &in.AccessControlRules, &out.AccessControlRules
+		*out = make([]ConfigBundleAccessControlRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WebhookValidations != nil {
+		in, out := &in.WebhookValidations, &out.WebhookValidations
+		*out = make([]ConfigBundleWebhookValidation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SystemMCPServers != nil {
+		in, out := &in.SystemMCPServers, &out.SystemMCPServers
+		*out = make([]ConfigBundleSystemMCPServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerizedRuntimeConfig.
-func (in *ContainerizedRuntimeConfig) DeepCopy() *ContainerizedRuntimeConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigBundle.
+func (in *ConfigBundle) DeepCopy() *ConfigBundle {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerizedRuntimeConfig)
+	out := new(ConfigBundle)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Credential) DeepCopyInto(out *Credential) {
+func (in *ConfigBundleAccessControlRule) DeepCopyInto(out *ConfigBundleAccessControlRule) {
 	*out = *in
-	if in.EnvVars != nil {
-		in, out := &in.EnvVars, &out.EnvVars
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = (*in).DeepCopy()
-	}
+	in.Manifest.DeepCopyInto(&out.Manifest)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credential.
-func (in *Credential) DeepCopy() *Credential {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigBundleAccessControlRule.
+func (in *ConfigBundleAccessControlRule) DeepCopy() *ConfigBundleAccessControlRule {
 	if in == nil {
 		return nil
 	}
-	out := new(Credential)
+	out := new(ConfigBundleAccessControlRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CredentialList) DeepCopyInto(out *CredentialList) {
+func (in *ConfigBundleCatalog) DeepCopyInto(out *ConfigBundleCatalog) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Credential, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.MCPCatalogManifest.DeepCopyInto(&out.MCPCatalogManifest)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialList.
-func (in *CredentialList) DeepCopy() *CredentialList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigBundleCatalog.
+func (in *ConfigBundleCatalog) DeepCopy() *ConfigBundleCatalog {
 	if in == nil {
 		return nil
 	}
-	out := new(CredentialList)
+	out := new(ConfigBundleCatalog)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CronJob) DeepCopyInto(out *CronJob) {
+func (in *ConfigBundleCatalogEntry) DeepCopyInto(out *ConfigBundleCatalogEntry) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	in.CronJobManifest.DeepCopyInto(&out.CronJobManifest)
-	if in.LastRunStartedAt != nil {
-		in, out := &in.LastRunStartedAt, &out.LastRunStartedAt
-		*out = (*in).DeepCopy()
-	}
-	if in.LastSuccessfulRunCompleted != nil {
-		in, out := &in.LastSuccessfulRunCompleted, &out.LastSuccessfulRunCompleted
-		*out = (*in).DeepCopy()
-	}
-	if in.NextRunAt != nil {
-		in, out := &in.NextRunAt, &out.NextRunAt
-		*out = (*in).DeepCopy()
+	if in.UnsupportedTools != nil {
+		in, out := &in.UnsupportedTools, &out.UnsupportedTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
+	in.Manifest.DeepCopyInto(&out.Manifest)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJob.
-func (in *CronJob) DeepCopy() *CronJob {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigBundleCatalogEntry.
+func (in *ConfigBundleCatalogEntry) DeepCopy() *ConfigBundleCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigBundleCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigBundleSecretRef) DeepCopyInto(out *ConfigBundleSecretRef) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigBundleSecretRef.
+func (in *ConfigBundleSecretRef) DeepCopy() *ConfigBundleSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigBundleSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigBundleServer) DeepCopyInto(out *ConfigBundleServer) {
+	*out = *in
+	if in.UnsupportedTools != nil {
+		in, out := &in.UnsupportedTools, &out.UnsupportedTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(ConfigBundleSecretRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigBundleServer.
+func (in *ConfigBundleServer) DeepCopy() *ConfigBundleServer {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigBundleServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigBundleSystemMCPServer) DeepCopyInto(out *ConfigBundleSystemMCPServer) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(ConfigBundleSecretRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigBundleSystemMCPServer.
+func (in *ConfigBundleSystemMCPServer) DeepCopy() *ConfigBundleSystemMCPServer {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigBundleSystemMCPServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigBundleWebhookValidation) DeepCopyInto(out *ConfigBundleWebhookValidation) {
+	*out = *in
+	in.Manifest.DeepCopyInto(&out.Manifest)
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(ConfigBundleSecretRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigBundleWebhookValidation.
+func (in *ConfigBundleWebhookValidation) DeepCopy() *ConfigBundleWebhookValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigBundleWebhookValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigImportChange) DeepCopyInto(out *ConfigImportChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigImportChange.
+func (in *ConfigImportChange) DeepCopy() *ConfigImportChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigImportChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigImportResult) DeepCopyInto(out *ConfigImportResult) {
+	*out = *in
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = make([]ConfigImportChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Updated != nil {
+		in, out := &in.Updated, &out.Updated
+		*out = make([]ConfigImportChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Deleted != nil {
+		in, out := &in.Deleted, &out.Deleted
+		*out = make([]ConfigImportChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingSecrets != nil {
+		in, out := &in.MissingSecrets, &out.MissingSecrets
+		*out = make([]ConfigBundleSecretRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigImportResult.
+func (in *ConfigImportResult) DeepCopy() *ConfigImportResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigImportResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerizedRuntimeConfig) DeepCopyInto(out *ContainerizedRuntimeConfig) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerizedRuntimeConfig.
+func (in *ContainerizedRuntimeConfig) DeepCopy() *ContainerizedRuntimeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerizedRuntimeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Credential) DeepCopyInto(out *Credential) {
+	*out = *in
+	if in.EnvVars != nil {
+		in, out := &in.EnvVars, &out.EnvVars
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credential.
+func (in *Credential) DeepCopy() *Credential {
+	if in == nil {
+		return nil
+	}
+	out := new(Credential)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialList) DeepCopyInto(out *CredentialList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Credential, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialList.
+func (in *CredentialList) DeepCopy() *CredentialList {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CronJob) DeepCopyInto(out *CronJob) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.CronJobManifest.DeepCopyInto(&out.CronJobManifest)
+	if in.LastRunStartedAt != nil {
+		in, out := &in.LastRunStartedAt, &out.LastRunStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulRunCompleted != nil {
+		in, out := &in.LastSuccessfulRunCompleted, &out.LastSuccessfulRunCompleted
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRunAt != nil {
+		in, out := &in.NextRunAt, &out.NextRunAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJob.
+func (in *CronJob) DeepCopy() *CronJob {
 	if in == nil {
 		return nil
 	}
@@ -887,7 +1382,7 @@ func (in *CronJobManifest) DeepCopyInto(out *CronJobManifest) {
 	if in.TaskSchedule != nil {
 		in, out := &in.TaskSchedule, &out.TaskSchedule
 		*out = new(Schedule)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1088,7 +1583,265 @@ func (in *EulaStatus) DeepCopy() *EulaStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EulaStatus)
+	out := new(EulaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalCase) DeepCopyInto(out *EvalCase) {
+	*out = *in
+	in.Grader.DeepCopyInto(&out.Grader)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalCase.
+func (in *EvalCase) DeepCopy() *EvalCase {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalCase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalCaseComparison) DeepCopyInto(out *EvalCaseComparison) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalCaseComparison.
+func (in *EvalCaseComparison) DeepCopy() *EvalCaseComparison {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalCaseComparison)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalCaseResult) DeepCopyInto(out *EvalCaseResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalCaseResult.
+func (in *EvalCaseResult) DeepCopy() *EvalCaseResult {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalCaseResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalComparison) DeepCopyInto(out *EvalComparison) {
+	*out = *in
+	in.Baseline.DeepCopyInto(&out.Baseline)
+	in.Candidate.DeepCopyInto(&out.Candidate)
+	if in.Cases != nil {
+		in, out := &in.Cases, &out.Cases
+		*out = make([]EvalCaseComparison, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalComparison.
+func (in *EvalComparison) DeepCopy() *EvalComparison {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalComparison)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalConfiguration) DeepCopyInto(out *EvalConfiguration) {
+	*out = *in
+	if in.Temperature != nil {
+		in, out := &in.Temperature, &out.Temperature
+		*out = new(float32)
+		**out = **in
+	}
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalConfiguration.
+func (in *EvalConfiguration) DeepCopy() *EvalConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalDataset) DeepCopyInto(out *EvalDataset) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.EvalDatasetManifest.DeepCopyInto(&out.EvalDatasetManifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalDataset.
+func (in *EvalDataset) DeepCopy() *EvalDataset {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalDataset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalDatasetList) DeepCopyInto(out *EvalDatasetList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EvalDataset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalDatasetList.
+func (in *EvalDatasetList) DeepCopy() *EvalDatasetList {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalDatasetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalDatasetManifest) DeepCopyInto(out *EvalDatasetManifest) {
+	*out = *in
+	if in.Cases != nil {
+		in, out := &in.Cases, &out.Cases
+		*out = make([]EvalCase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalDatasetManifest.
+func (in *EvalDatasetManifest) DeepCopy() *EvalDatasetManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalDatasetManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalGrader) DeepCopyInto(out *EvalGrader) {
+	*out = *in
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalGrader.
+func (in *EvalGrader) DeepCopy() *EvalGrader {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalGrader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalRun) DeepCopyInto(out *EvalRun) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.EvalRunManifest.DeepCopyInto(&out.EvalRunManifest)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]EvalCaseResult, len(*in))
+		copy(*out, *in)
+	}
+	out.Summary = in.Summary
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalRun.
+func (in *EvalRun) DeepCopy() *EvalRun {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalRunList) DeepCopyInto(out *EvalRunList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EvalRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalRunList.
+func (in *EvalRunList) DeepCopy() *EvalRunList {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalRunList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalRunManifest) DeepCopyInto(out *EvalRunManifest) {
+	*out = *in
+	in.Configuration.DeepCopyInto(&out.Configuration)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalRunManifest.
+func (in *EvalRunManifest) DeepCopy() *EvalRunManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalRunManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvalSummary) DeepCopyInto(out *EvalSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvalSummary.
+func (in *EvalSummary) DeepCopy() *EvalSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(EvalSummary)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1316,6 +2069,40 @@ func (in *GroupRoleAssignmentList) DeepCopy() *GroupRoleAssignmentList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *If) DeepCopyInto(out *If) {
+	*out = *in
+	if in.Compare != nil {
+		in, out := &in.Compare, &out.Compare
+		*out = new(Comparison)
+		**out = **in
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Else != nil {
+		in, out := &in.Else, &out.Else
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new If.
+func (in *If) DeepCopy() *If {
+	if in == nil {
+		return nil
+	}
+	out := new(If)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Item) DeepCopyInto(out *Item) {
 	*out = *in
@@ -1718,6 +2505,180 @@ func (in *MCPCatalogManifest) DeepCopy() *MCPCatalogManifest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogSyncEntryChange) DeepCopyInto(out *MCPCatalogSyncEntryChange) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]CatalogEntryManifestChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogSyncEntryChange.
+func (in *MCPCatalogSyncEntryChange) DeepCopy() *MCPCatalogSyncEntryChange {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogSyncEntryChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogSyncPreview) DeepCopyInto(out *MCPCatalogSyncPreview) {
+	*out = *in
+	if in.SourceURLs != nil {
+		in, out := &in.SourceURLs, &out.SourceURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Added != nil {
+		in, out := &in.Added, &out.Added
+		*out = make([]MCPCatalogSyncEntryChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Removed != nil {
+		in, out := &in.Removed, &out.Removed
+		*out = make([]MCPCatalogSyncEntryChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Changed != nil {
+		in, out := &in.Changed, &out.Changed
+		*out = make([]MCPCatalogSyncEntryChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourceErrors != nil {
+		in, out := &in.SourceErrors, &out.SourceErrors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ValidationErrors != nil {
+		in, out := &in.ValidationErrors, &out.ValidationErrors
+		*out = make([]MCPCatalogSyncValidationError, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogSyncPreview.
+func (in *MCPCatalogSyncPreview) DeepCopy() *MCPCatalogSyncPreview {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogSyncPreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPCatalogSyncValidationError) DeepCopyInto(out *MCPCatalogSyncValidationError) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPCatalogSyncValidationError.
+func (in *MCPCatalogSyncValidationError) DeepCopy() *MCPCatalogSyncValidationError {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPCatalogSyncValidationError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPClientConfig) DeepCopyInto(out *MCPClientConfig) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]MCPClientConfigServer, len(*in))
+		copy(*out, *in)
+	}
+	if in.Skipped != nil {
+		in, out := &in.Skipped, &out.Skipped
+		*out = make([]MCPClientConfigSkipped, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPClientConfig.
+func (in *MCPClientConfig) DeepCopy() *MCPClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPClientConfigRequest) DeepCopyInto(out *MCPClientConfigRequest) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.APIKeyExpiresAt != nil {
+		in, out := &in.APIKeyExpiresAt, &out.APIKeyExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPClientConfigRequest.
+func (in *MCPClientConfigRequest) DeepCopy() *MCPClientConfigRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPClientConfigRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPClientConfigServer) DeepCopyInto(out *MCPClientConfigServer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPClientConfigServer.
+func (in *MCPClientConfigServer) DeepCopy() *MCPClientConfigServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPClientConfigServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPClientConfigSkipped) DeepCopyInto(out *MCPClientConfigSkipped) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPClientConfigSkipped.
+func (in *MCPClientConfigSkipped) DeepCopy() *MCPClientConfigSkipped {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPClientConfigSkipped)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPEnv) DeepCopyInto(out *MCPEnv) {
 	*out = *in
@@ -1877,6 +2838,11 @@ func (in *MCPServer) DeepCopyInto(out *MCPServer) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PendingApprovalTools != nil {
+		in, out := &in.PendingApprovalTools, &out.PendingApprovalTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
@@ -1902,6 +2868,11 @@ func (in *MCPServerCatalogEntry) DeepCopyInto(out *MCPServerCatalogEntry) {
 		in, out := &in.ToolPreviewsLastGenerated, &out.ToolPreviewsLastGenerated
 		*out = (*in).DeepCopy()
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(CatalogEntryApproval)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerCatalogEntry.
@@ -2006,6 +2977,11 @@ func (in *MCPServerDetails) DeepCopyInto(out *MCPServerDetails) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(MCPServerHealth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerDetails.
@@ -2034,6 +3010,36 @@ func (in *MCPServerEvent) DeepCopy() *MCPServerEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerHealth) DeepCopyInto(out *MCPServerHealth) {
+	*out = *in
+	if in.LastChecked != nil {
+		in, out := &in.LastChecked, &out.LastChecked
+		*out = (*in).DeepCopy()
+	}
+	if in.LastHealthy != nil {
+		in, out := &in.LastHealthy, &out.LastHealthy
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]MCPServerProbe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerHealth.
+func (in *MCPServerHealth) DeepCopy() *MCPServerHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MCPServerInstance) DeepCopyInto(out *MCPServerInstance) {
 	*out = *in
@@ -2164,73 +3170,176 @@ func (in *MCPServerManifest) DeepCopy() *MCPServerManifest {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerNeedingK8sUpdate) DeepCopyInto(out *MCPServerNeedingK8sUpdate) {
+func (in *MCPServerNeedingK8sUpdate) DeepCopyInto(out *MCPServerNeedingK8sUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerNeedingK8sUpdate.
+func (in *MCPServerNeedingK8sUpdate) DeepCopy() *MCPServerNeedingK8sUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerNeedingK8sUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerOAuthCredentialRequest) DeepCopyInto(out *MCPServerOAuthCredentialRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerOAuthCredentialRequest.
+func (in *MCPServerOAuthCredentialRequest) DeepCopy() *MCPServerOAuthCredentialRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerOAuthCredentialRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerOAuthCredentialStatus) DeepCopyInto(out *MCPServerOAuthCredentialStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerOAuthCredentialStatus.
+func (in *MCPServerOAuthCredentialStatus) DeepCopy() *MCPServerOAuthCredentialStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerOAuthCredentialStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerProbe) DeepCopyInto(out *MCPServerProbe) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerProbe.
+func (in *MCPServerProbe) DeepCopy() *MCPServerProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerTool) DeepCopyInto(out *MCPServerTool) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerTool.
+func (in *MCPServerTool) DeepCopy() *MCPServerTool {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServerTool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServerToolApprovalRequest) DeepCopyInto(out *MCPServerToolApprovalRequest) {
 	*out = *in
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerNeedingK8sUpdate.
-func (in *MCPServerNeedingK8sUpdate) DeepCopy() *MCPServerNeedingK8sUpdate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerToolApprovalRequest.
+func (in *MCPServerToolApprovalRequest) DeepCopy() *MCPServerToolApprovalRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerNeedingK8sUpdate)
+	out := new(MCPServerToolApprovalRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerOAuthCredentialRequest) DeepCopyInto(out *MCPServerOAuthCredentialRequest) {
+func (in *MCPServerToolChange) DeepCopyInto(out *MCPServerToolChange) {
 	*out = *in
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+	if in.SuspiciousReasons != nil {
+		in, out := &in.SuspiciousReasons, &out.SuspiciousReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerOAuthCredentialRequest.
-func (in *MCPServerOAuthCredentialRequest) DeepCopy() *MCPServerOAuthCredentialRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerToolChange.
+func (in *MCPServerToolChange) DeepCopy() *MCPServerToolChange {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerOAuthCredentialRequest)
+	out := new(MCPServerToolChange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerOAuthCredentialStatus) DeepCopyInto(out *MCPServerOAuthCredentialStatus) {
+func (in *MCPServerToolChanges) DeepCopyInto(out *MCPServerToolChanges) {
 	*out = *in
+	if in.LastChecked != nil {
+		in, out := &in.LastChecked, &out.LastChecked
+		*out = (*in).DeepCopy()
+	}
+	if in.Changelog != nil {
+		in, out := &in.Changelog, &out.Changelog
+		*out = make([]MCPServerToolChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PendingApproval != nil {
+		in, out := &in.PendingApproval, &out.PendingApproval
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerOAuthCredentialStatus.
-func (in *MCPServerOAuthCredentialStatus) DeepCopy() *MCPServerOAuthCredentialStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerToolChanges.
+func (in *MCPServerToolChanges) DeepCopy() *MCPServerToolChanges {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerOAuthCredentialStatus)
+	out := new(MCPServerToolChanges)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MCPServerTool) DeepCopyInto(out *MCPServerTool) {
+func (in *MCPServerToolSnapshot) DeepCopyInto(out *MCPServerToolSnapshot) {
 	*out = *in
-	if in.Params != nil {
-		in, out := &in.Params, &out.Params
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Credentials != nil {
-		in, out := &in.Credentials, &out.Credentials
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerTool.
-func (in *MCPServerTool) DeepCopy() *MCPServerTool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServerToolSnapshot.
+func (in *MCPServerToolSnapshot) DeepCopy() *MCPServerToolSnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(MCPServerTool)
+	out := new(MCPServerToolSnapshot)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4007,6 +5116,26 @@ func (in *RunList) DeepCopy() *RunList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunWindow) DeepCopyInto(out *RunWindow) {
+	*out = *in
+	if in.Weekdays != nil {
+		in, out := &in.Weekdays, &out.Weekdays
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunWindow.
+func (in *RunWindow) DeepCopy() *RunWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(RunWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimeValidationError) DeepCopyInto(out *RuntimeValidationError) {
 	*out = *in
@@ -4040,6 +5169,11 @@ func (in *S3Config) DeepCopy() *S3Config {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schedule) DeepCopyInto(out *Schedule) {
 	*out = *in
+	if in.RunWindow != nil {
+		in, out := &in.RunWindow, &out.RunWindow
+		*out = new(RunWindow)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
@@ -4055,7 +5189,7 @@ func (in *Schedule) DeepCopy() *Schedule {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduledAuditLogExportCreateRequest) DeepCopyInto(out *ScheduledAuditLogExportCreateRequest) {
 	*out = *in
-	out.Schedule = in.Schedule
+	in.Schedule.DeepCopyInto(&out.Schedule)
 	in.Filters.DeepCopyInto(&out.Filters)
 }
 
@@ -4094,7 +5228,7 @@ func (in *ScheduledAuditLogExportListResponse) DeepCopy() *ScheduledAuditLogExpo
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduledAuditLogExportResponse) DeepCopyInto(out *ScheduledAuditLogExportResponse) {
 	*out = *in
-	out.Schedule = in.Schedule
+	in.Schedule.DeepCopyInto(&out.Schedule)
 	in.Filters.DeepCopyInto(&out.Filters)
 	in.LastRunAt.DeepCopyInto(&out.LastRunAt)
 }
@@ -4125,7 +5259,7 @@ func (in *ScheduledAuditLogExportUpdateRequest) DeepCopyInto(out *ScheduledAudit
 	if in.Schedule != nil {
 		in, out := &in.Schedule, &out.Schedule
 		*out = new(Schedule)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.RetentionPeriodInDays != nil {
 		in, out := &in.RetentionPeriodInDays, &out.RetentionPeriodInDays
@@ -4167,6 +5301,26 @@ func (in *Step) DeepCopyInto(out *Step) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.If != nil {
+		in, out := &in.If, &out.If
+		*out = new(If)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.While != nil {
+		in, out := &in.While, &out.While
+		*out = new(While)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubWorkflow != nil {
+		in, out := &in.SubWorkflow, &out.SubWorkflow
+		*out = new(SubWorkflow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(Approval)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Step.
@@ -4299,6 +5453,28 @@ func (in *StorageProviderConfigInput) DeepCopy() *StorageProviderConfigInput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubWorkflow) DeepCopyInto(out *SubWorkflow) {
+	*out = *in
+	if in.Input != nil {
+		in, out := &in.Input, &out.Input
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubWorkflow.
+func (in *SubWorkflow) DeepCopy() *SubWorkflow {
+	if in == nil {
+		return nil
+	}
+	out := new(SubWorkflow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subject) DeepCopyInto(out *Subject) {
 	*out = *in
@@ -4412,109 +5588,301 @@ func (in *SystemMCPServerManifest) DeepCopy() *SystemMCPServerManifest {
 	if in == nil {
 		return nil
 	}
-	out := new(SystemMCPServerManifest)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Task) DeepCopyInto(out *Task) {
-	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	in.TaskManifest.DeepCopyInto(&out.TaskManifest)
+	out := new(SystemMCPServerManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Task) DeepCopyInto(out *Task) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.TaskManifest.DeepCopyInto(&out.TaskManifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Task.
+func (in *Task) DeepCopy() *Task {
+	if in == nil {
+		return nil
+	}
+	out := new(Task)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskList) DeepCopyInto(out *TaskList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Task, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskList.
+func (in *TaskList) DeepCopy() *TaskList {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskManifest) DeepCopyInto(out *TaskManifest) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]TaskStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(Schedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnDemand != nil {
+		in, out := &in.OnDemand, &out.OnDemand
+		*out = new(TaskOnDemand)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(OnWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(TaskRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]TaskNotification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OutputSchema != nil {
+		in, out := &in.OutputSchema, &out.OutputSchema
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutputRetries != nil {
+		in, out := &in.OutputRetries, &out.OutputRetries
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskManifest.
+func (in *TaskManifest) DeepCopy() *TaskManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskNotification) DeepCopyInto(out *TaskNotification) {
+	*out = *in
+	if in.On != nil {
+		in, out := &in.On, &out.On
+		*out = make([]TaskNotificationEvent, len(*in))
+		copy(*out, *in)
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskNotification.
+func (in *TaskNotification) DeepCopy() *TaskNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskOnDemand) DeepCopyInto(out *TaskOnDemand) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskOnDemand.
+func (in *TaskOnDemand) DeepCopy() *TaskOnDemand {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskOnDemand)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRetryPolicy) DeepCopyInto(out *TaskRetryPolicy) {
+	*out = *in
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]TaskRetryCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRetryPolicy.
+func (in *TaskRetryPolicy) DeepCopy() *TaskRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRun) DeepCopyInto(out *TaskRun) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Task.DeepCopyInto(&out.Task)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SubRuns != nil {
+		in, out := &in.SubRuns, &out.SubRuns
+		*out = make([]TaskSubRun, len(*in))
+		copy(*out, *in)
+	}
+	if in.PendingApprovals != nil {
+		in, out := &in.PendingApprovals, &out.PendingApprovals
+		*out = make([]TaskRunApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WebhookDelivery != nil {
+		in, out := &in.WebhookDelivery, &out.WebhookDelivery
+		*out = new(TaskWebhookDelivery)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreviousAttempts != nil {
+		in, out := &in.PreviousAttempts, &out.PreviousAttempts
+		*out = make([]TaskRunAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RetryAt != nil {
+		in, out := &in.RetryAt, &out.RetryAt
+		*out = (*in).DeepCopy()
+	}
+	if in.StructuredOutput != nil {
+		in, out := &in.StructuredOutput, &out.StructuredOutput
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Task.
-func (in *Task) DeepCopy() *Task {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRun.
+func (in *TaskRun) DeepCopy() *TaskRun {
 	if in == nil {
 		return nil
 	}
-	out := new(Task)
+	out := new(TaskRun)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TaskList) DeepCopyInto(out *TaskList) {
+func (in *TaskRunApproval) DeepCopyInto(out *TaskRunApproval) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Task, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
+	in.RequestedAt.DeepCopyInto(&out.RequestedAt)
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskList.
-func (in *TaskList) DeepCopy() *TaskList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunApproval.
+func (in *TaskRunApproval) DeepCopy() *TaskRunApproval {
 	if in == nil {
 		return nil
 	}
-	out := new(TaskList)
+	out := new(TaskRunApproval)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TaskManifest) DeepCopyInto(out *TaskManifest) {
+func (in *TaskRunApprovalDecision) DeepCopyInto(out *TaskRunApprovalDecision) {
 	*out = *in
-	if in.Steps != nil {
-		in, out := &in.Steps, &out.Steps
-		*out = make([]TaskStep, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Schedule != nil {
-		in, out := &in.Schedule, &out.Schedule
-		*out = new(Schedule)
-		**out = **in
-	}
-	if in.OnDemand != nil {
-		in, out := &in.OnDemand, &out.OnDemand
-		*out = new(TaskOnDemand)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskManifest.
-func (in *TaskManifest) DeepCopy() *TaskManifest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunApprovalDecision.
+func (in *TaskRunApprovalDecision) DeepCopy() *TaskRunApprovalDecision {
 	if in == nil {
 		return nil
 	}
-	out := new(TaskManifest)
+	out := new(TaskRunApprovalDecision)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TaskOnDemand) DeepCopyInto(out *TaskOnDemand) {
+func (in *TaskRunApprovalList) DeepCopyInto(out *TaskRunApprovalList) {
 	*out = *in
-	if in.Params != nil {
-		in, out := &in.Params, &out.Params
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TaskRunApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskOnDemand.
-func (in *TaskOnDemand) DeepCopy() *TaskOnDemand {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunApprovalList.
+func (in *TaskRunApprovalList) DeepCopy() *TaskRunApprovalList {
 	if in == nil {
 		return nil
 	}
-	out := new(TaskOnDemand)
+	out := new(TaskRunApprovalList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TaskRun) DeepCopyInto(out *TaskRun) {
+func (in *TaskRunAttempt) DeepCopyInto(out *TaskRunAttempt) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	in.Task.DeepCopyInto(&out.Task)
 	if in.StartTime != nil {
 		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
@@ -4525,12 +5893,12 @@ func (in *TaskRun) DeepCopyInto(out *TaskRun) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRun.
-func (in *TaskRun) DeepCopy() *TaskRun {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunAttempt.
+func (in *TaskRunAttempt) DeepCopy() *TaskRunAttempt {
 	if in == nil {
 		return nil
 	}
-	out := new(TaskRun)
+	out := new(TaskRunAttempt)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4565,6 +5933,26 @@ func (in *TaskStep) DeepCopyInto(out *TaskStep) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.If != nil {
+		in, out := &in.If, &out.If
+		*out = new(If)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.While != nil {
+		in, out := &in.While, &out.While
+		*out = new(While)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubWorkflow != nil {
+		in, out := &in.SubWorkflow, &out.SubWorkflow
+		*out = new(SubWorkflow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(Approval)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskStep.
@@ -4577,6 +5965,37 @@ func (in *TaskStep) DeepCopy() *TaskStep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskSubRun) DeepCopyInto(out *TaskSubRun) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskSubRun.
+func (in *TaskSubRun) DeepCopy() *TaskSubRun {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskSubRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskWebhookDelivery) DeepCopyInto(out *TaskWebhookDelivery) {
+	*out = *in
+	in.ReceivedAt.DeepCopyInto(&out.ReceivedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskWebhookDelivery.
+func (in *TaskWebhookDelivery) DeepCopy() *TaskWebhookDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskWebhookDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateAuthorization) DeepCopyInto(out *TemplateAuthorization) {
 	*out = *in
@@ -4716,6 +6135,31 @@ func (in *ThreadAuthorizationManifest) DeepCopy() *ThreadAuthorizationManifest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThreadFork) DeepCopyInto(out *ThreadFork) {
+	*out = *in
+	if in.Temperature != nil {
+		in, out := &in.Temperature, &out.Temperature
+		*out = new(float32)
+		**out = **in
+	}
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThreadFork.
+func (in *ThreadFork) DeepCopy() *ThreadFork {
+	if in == nil {
+		return nil
+	}
+	out := new(ThreadFork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThreadList) DeepCopyInto(out *ThreadList) {
 	*out = *in
@@ -4747,6 +6191,11 @@ func (in *ThreadManifest) DeepCopyInto(out *ThreadManifest) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Temperature != nil {
+		in, out := &in.Temperature, &out.Temperature
+		*out = new(float32)
+		**out = **in
+	}
 	if in.SharedTasks != nil {
 		in, out := &in.SharedTasks, &out.SharedTasks
 		*out = make([]string, len(*in))
@@ -4901,6 +6350,100 @@ func (in TokenUsageSeries) DeepCopy() TokenUsageSeries {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolApprovalPolicy) DeepCopyInto(out *ToolApprovalPolicy) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.ToolApprovalPolicyManifest.DeepCopyInto(&out.ToolApprovalPolicyManifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolApprovalPolicy.
+func (in *ToolApprovalPolicy) DeepCopy() *ToolApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolApprovalPolicyList) DeepCopyInto(out *ToolApprovalPolicyList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ToolApprovalPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolApprovalPolicyList.
+func (in *ToolApprovalPolicyList) DeepCopy() *ToolApprovalPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolApprovalPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolApprovalPolicyManifest) DeepCopyInto(out *ToolApprovalPolicyManifest) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	if in.ApprovedTools != nil {
+		in, out := &in.ApprovedTools, &out.ApprovedTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfirmTools != nil {
+		in, out := &in.ConfirmTools, &out.ConfirmTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedTools != nil {
+		in, out := &in.DeniedTools, &out.DeniedTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolApprovalPolicyManifest.
+func (in *ToolApprovalPolicyManifest) DeepCopy() *ToolApprovalPolicyManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolApprovalPolicyManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolArgumentOverride) DeepCopyInto(out *ToolArgumentOverride) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolArgumentOverride.
+func (in *ToolArgumentOverride) DeepCopy() *ToolArgumentOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolArgumentOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ToolCall) DeepCopyInto(out *ToolCall) {
 	*out = *in
@@ -5020,6 +6563,13 @@ func (in *ToolManifest) DeepCopy() *ToolManifest {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ToolOverride) DeepCopyInto(out *ToolOverride) {
 	*out = *in
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make([]ToolArgumentOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolOverride.
@@ -5212,6 +6762,26 @@ func (in *Webhook) DeepCopy() *Webhook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookFilter) DeepCopyInto(out *WebhookFilter) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookFilter.
+func (in *WebhookFilter) DeepCopy() *WebhookFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookList) DeepCopyInto(out *WebhookList) {
 	*out = *in
@@ -5242,6 +6812,13 @@ func (in *WebhookManifest) DeepCopyInto(out *WebhookManifest) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]WebhookFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookManifest.
@@ -5324,6 +6901,33 @@ func (in *WebsiteKnowledge) DeepCopy() *WebsiteKnowledge {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *While) DeepCopyInto(out *While) {
+	*out = *in
+	if in.Compare != nil {
+		in, out := &in.Compare, &out.Compare
+		*out = new(Comparison)
+		**out = **in
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new While.
+func (in *While) DeepCopy() *While {
+	if in == nil {
+		return nil
+	}
+	out := new(While)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Workflow) DeepCopyInto(out *Workflow) {
 	*out = *in
@@ -5424,6 +7028,28 @@ func (in *WorkflowManifest) DeepCopyInto(out *WorkflowManifest) {
 			(*out)[key] = val
 		}
 	}
+	if in.OutputSchema != nil {
+		in, out := &in.OutputSchema, &out.OutputSchema
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	if in.OutputRetries != nil {
+		in, out := &in.OutputRetries, &out.OutputRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(TaskRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]TaskNotification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowManifest.
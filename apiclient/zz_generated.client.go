@@ -0,0 +1,558 @@
+// Code generated by go generate; DO NOT EDIT.
+
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// LaunchMCPServer deploys an MCP server and waits until it is ready.
+func (c *Client) LaunchMCPServer(ctx context.Context, mcpServerID string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/mcp-servers/%s/launch", mcpServerID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// ClearMCPServerOAuth removes the OAuth credentials of the caller for an MCP server.
+func (c *Client) ClearMCPServerOAuth(ctx context.Context, mcpServerID string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/mcp-servers/%s/oauth", mcpServerID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// GetMCPServerDetails returns the deployment details and health of an MCP server.
+func (c *Client) GetMCPServerDetails(ctx context.Context, mcpServerID string) (*types.MCPServerDetails, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-servers/%s/details", mcpServerID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServerDetails{})
+}
+
+// ConfigureMCPServer sets the environment variables and headers of an MCP server.
+func (c *Client) ConfigureMCPServer(ctx context.Context, mcpServerID string, input map[string]string) (*types.MCPServer, error) {
+	_, resp, err := c.postJSON(ctx, fmt.Sprintf("/mcp-servers/%s/configure", mcpServerID), input)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServer{})
+}
+
+// DeconfigureMCPServer removes the configuration of an MCP server.
+func (c *Client) DeconfigureMCPServer(ctx context.Context, mcpServerID string) (*types.MCPServer, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/mcp-servers/%s/deconfigure", mcpServerID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServer{})
+}
+
+// RevealMCPServer returns the configured environment variables and headers of an MCP server.
+func (c *Client) RevealMCPServer(ctx context.Context, mcpServerID string) (result map[string]string, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/mcp-servers/%s/reveal", mcpServerID), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// ListMCPServerTools lists the tools of an MCP server.
+func (c *Client) ListMCPServerTools(ctx context.Context, mcpServerID string) (result []types.MCPServerTool, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-servers/%s/tools", mcpServerID), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// ConfigureCatalogMCPServer sets the environment variables and headers of a multi-user MCP server.
+func (c *Client) ConfigureCatalogMCPServer(ctx context.Context, catalogID string, mcpServerID string, input map[string]string) (*types.MCPServer, error) {
+	_, resp, err := c.postJSON(ctx, fmt.Sprintf("/mcp-catalogs/%s/servers/%s/configure", catalogID, mcpServerID), input)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServer{})
+}
+
+// DeconfigureCatalogMCPServer removes the configuration of a multi-user MCP server.
+func (c *Client) DeconfigureCatalogMCPServer(ctx context.Context, catalogID string, mcpServerID string) (*types.MCPServer, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/mcp-catalogs/%s/servers/%s/deconfigure", catalogID, mcpServerID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServer{})
+}
+
+// RevealCatalogMCPServer returns the configured environment variables and headers of a multi-user MCP server.
+func (c *Client) RevealCatalogMCPServer(ctx context.Context, catalogID string, mcpServerID string) (result map[string]string, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/mcp-catalogs/%s/servers/%s/reveal", catalogID, mcpServerID), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// ListCatalogMCPServerInstances lists the instances that users created of a multi-user MCP server.
+func (c *Client) ListCatalogMCPServerInstances(ctx context.Context, catalogID string, mcpServerID string) (result types.MCPServerInstanceList, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-catalogs/%s/servers/%s/instances", catalogID, mcpServerID), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// GetMCPServerToolChanges returns the tool changes detected on a multi-user MCP server.
+func (c *Client) GetMCPServerToolChanges(ctx context.Context, catalogID string, mcpServerID string) (*types.MCPServerToolChanges, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-catalogs/%s/servers/%s/tool-changes", catalogID, mcpServerID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServerToolChanges{})
+}
+
+// ApproveMCPServerTools enables tools that were disabled after they newly appeared on a multi-user MCP server.
+func (c *Client) ApproveMCPServerTools(ctx context.Context, catalogID string, mcpServerID string, input types.MCPServerToolApprovalRequest) (*types.MCPServerToolChanges, error) {
+	_, resp, err := c.postJSON(ctx, fmt.Sprintf("/mcp-catalogs/%s/servers/%s/tool-changes/approve", catalogID, mcpServerID), input)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServerToolChanges{})
+}
+
+// GetWorkspaceMCPServerToolChanges returns the tool changes detected on a multi-user MCP server in a workspace.
+func (c *Client) GetWorkspaceMCPServerToolChanges(ctx context.Context, workspaceID string, mcpServerID string) (*types.MCPServerToolChanges, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/workspaces/%s/servers/%s/tool-changes", workspaceID, mcpServerID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServerToolChanges{})
+}
+
+// ApproveWorkspaceMCPServerTools enables tools that were disabled after they newly appeared on a multi-user MCP server in a workspace.
+func (c *Client) ApproveWorkspaceMCPServerTools(ctx context.Context, workspaceID string, mcpServerID string, input types.MCPServerToolApprovalRequest) (*types.MCPServerToolChanges, error) {
+	_, resp, err := c.postJSON(ctx, fmt.Sprintf("/workspaces/%s/servers/%s/tool-changes/approve", workspaceID, mcpServerID), input)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.MCPServerToolChanges{})
+}
+
+// ListMCPCatalogCategories lists the categories of the entries of an MCP catalog.
+func (c *Client) ListMCPCatalogCategories(ctx context.Context, catalogID string) (result []string, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-catalogs/%s/categories", catalogID), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// ListMCPServerCatalogEntryServers lists the MCP servers that users created from an entry of an MCP catalog.
+func (c *Client) ListMCPServerCatalogEntryServers(ctx context.Context, catalogID string, entryID string) (result types.MCPServerList, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/mcp-catalogs/%s/entries/%s/servers", catalogID, entryID), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// ListModelAccessPolicies lists the model access policies.
+func (c *Client) ListModelAccessPolicies(ctx context.Context) (result types.ModelAccessPolicyList, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/model-access-policies", nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// CreateModelAccessPolicy creates a model access policy.
+func (c *Client) CreateModelAccessPolicy(ctx context.Context, manifest types.ModelAccessPolicyManifest) (*types.ModelAccessPolicy, error) {
+	_, resp, err := c.postJSON(ctx, "/model-access-policies", manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.ModelAccessPolicy{})
+}
+
+// GetModelAccessPolicy returns a model access policy.
+func (c *Client) GetModelAccessPolicy(ctx context.Context, id string) (*types.ModelAccessPolicy, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/model-access-policies/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.ModelAccessPolicy{})
+}
+
+// UpdateModelAccessPolicy updates a model access policy.
+func (c *Client) UpdateModelAccessPolicy(ctx context.Context, id string, manifest types.ModelAccessPolicyManifest) (*types.ModelAccessPolicy, error) {
+	_, resp, err := c.putJSON(ctx, fmt.Sprintf("/model-access-policies/%s", id), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.ModelAccessPolicy{})
+}
+
+// DeleteModelAccessPolicy deletes a model access policy.
+func (c *Client) DeleteModelAccessPolicy(ctx context.Context, id string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/model-access-policies/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// GetCurrentUser returns the caller.
+func (c *Client) GetCurrentUser(ctx context.Context) (*types.User, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/me", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.User{})
+}
+
+// UpdateCurrentUser updates the settings of the caller.
+func (c *Client) UpdateCurrentUser(ctx context.Context, input types.User) (*types.User, error) {
+	_, resp, err := c.patchJSON(ctx, "/me", input)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.User{})
+}
+
+type ListUsersOptions struct {
+	// The username to filter by.
+	Username string
+	// The email address to filter by.
+	Email string
+	// The role to filter by.
+	Role string
+	// Set to true to include deleted users.
+	IncludeDeleted string
+}
+
+func (o ListUsersOptions) query() url.Values {
+	q := url.Values{}
+	if o.Username != "" {
+		q.Set("username", o.Username)
+	}
+	if o.Email != "" {
+		q.Set("email", o.Email)
+	}
+	if o.Role != "" {
+		q.Set("role", o.Role)
+	}
+	if o.IncludeDeleted != "" {
+		q.Set("includeDeleted", o.IncludeDeleted)
+	}
+	return q
+}
+
+// ListUsers lists users.
+func (c *Client) ListUsers(ctx context.Context, opts ListUsersOptions) (result types.UserList, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/users"+"?"+opts.query().Encode(), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// GetUser returns a user.
+func (c *Client) GetUser(ctx context.Context, userID string) (*types.User, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/users/%s", userID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.User{})
+}
+
+// UpdateUser updates the role and settings of a user.
+func (c *Client) UpdateUser(ctx context.Context, userID string, input types.User) (*types.User, error) {
+	_, resp, err := c.patchJSON(ctx, fmt.Sprintf("/users/%s", userID), input)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.User{})
+}
+
+// DeleteUser deletes a user.
+func (c *Client) DeleteUser(ctx context.Context, userID string) (*types.User, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/users/%s", userID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.User{})
+}
+
+// ListAPIKeys lists the API keys of the caller.
+func (c *Client) ListAPIKeys(ctx context.Context) (result types.APIKeyList, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/api-keys", nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// CreateAPIKey creates an API key for the caller. The response is the only time the key is visible.
+func (c *Client) CreateAPIKey(ctx context.Context, input types.APIKeyCreateRequest) (*types.APIKeyCreateResponse, error) {
+	_, resp, err := c.postJSON(ctx, "/api-keys", input)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.APIKeyCreateResponse{})
+}
+
+// GetAPIKey returns an API key of the caller.
+func (c *Client) GetAPIKey(ctx context.Context, id string) (*types.APIKey, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/api-keys/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.APIKey{})
+}
+
+// DeleteAPIKey deletes an API key of the caller.
+func (c *Client) DeleteAPIKey(ctx context.Context, id string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/api-keys/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// ListAllAPIKeys lists the API keys of all users.
+func (c *Client) ListAllAPIKeys(ctx context.Context) (result types.APIKeyList, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/admin-api-keys", nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// GetAnyAPIKey returns an API key of any user.
+func (c *Client) GetAnyAPIKey(ctx context.Context, id string) (*types.APIKey, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/admin-api-keys/%s", id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.APIKey{})
+}
+
+// DeleteAnyAPIKey deletes an API key of any user.
+func (c *Client) DeleteAnyAPIKey(ctx context.Context, id string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/admin-api-keys/%s", id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// ListProjectsV2 lists the projects of the caller.
+func (c *Client) ListProjectsV2(ctx context.Context) (result types.ProjectV2List, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, "/projectsv2", nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// CreateProjectV2 creates a project.
+func (c *Client) CreateProjectV2(ctx context.Context, manifest types.ProjectV2Manifest) (*types.ProjectV2, error) {
+	_, resp, err := c.postJSON(ctx, "/projectsv2", manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.ProjectV2{})
+}
+
+// GetProjectV2 returns a project.
+func (c *Client) GetProjectV2(ctx context.Context, projectv2ID string) (*types.ProjectV2, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projectsv2/%s", projectv2ID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.ProjectV2{})
+}
+
+// UpdateProjectV2 updates a project.
+func (c *Client) UpdateProjectV2(ctx context.Context, projectv2ID string, manifest types.ProjectV2Manifest) (*types.ProjectV2, error) {
+	_, resp, err := c.putJSON(ctx, fmt.Sprintf("/projectsv2/%s", projectv2ID), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.ProjectV2{})
+}
+
+// DeleteProjectV2 deletes a project.
+func (c *Client) DeleteProjectV2(ctx context.Context, projectv2ID string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/projectsv2/%s", projectv2ID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// ListNanobotAgents lists the agents of a project.
+func (c *Client) ListNanobotAgents(ctx context.Context, projectID string) (result types.NanobotAgentList, err error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projectsv2/%s/agents", projectID), nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	_, err = toObject(resp, &result)
+	return
+}
+
+// CreateNanobotAgent creates an agent in a project.
+func (c *Client) CreateNanobotAgent(ctx context.Context, projectID string, manifest types.NanobotAgentManifest) (*types.NanobotAgent, error) {
+	_, resp, err := c.postJSON(ctx, fmt.Sprintf("/projectsv2/%s/agents", projectID), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.NanobotAgent{})
+}
+
+// GetNanobotAgent returns an agent of a project.
+func (c *Client) GetNanobotAgent(ctx context.Context, projectID string, nanobotAgentID string) (*types.NanobotAgent, error) {
+	_, resp, err := c.doRequest(ctx, http.MethodGet, fmt.Sprintf("/projectsv2/%s/agents/%s", projectID, nanobotAgentID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.NanobotAgent{})
+}
+
+// UpdateNanobotAgent updates an agent of a project.
+func (c *Client) UpdateNanobotAgent(ctx context.Context, projectID string, nanobotAgentID string, manifest types.NanobotAgentManifest) (*types.NanobotAgent, error) {
+	_, resp, err := c.putJSON(ctx, fmt.Sprintf("/projectsv2/%s/agents/%s", projectID, nanobotAgentID), manifest)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return toObject(resp, &types.NanobotAgent{})
+}
+
+// DeleteNanobotAgent deletes an agent of a project.
+func (c *Client) DeleteNanobotAgent(ctx context.Context, projectID string, nanobotAgentID string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/projectsv2/%s/agents/%s", projectID, nanobotAgentID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// LaunchNanobotAgent deploys the MCP server of an agent and waits until it is ready.
+func (c *Client) LaunchNanobotAgent(ctx context.Context, projectID string, nanobotAgentID string) error {
+	_, resp, err := c.doRequest(ctx, http.MethodPost, fmt.Sprintf("/projectsv2/%s/agents/%s/launch", projectID, nanobotAgentID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -27,6 +27,7 @@ const sidebars = {
         "functionality/server-scheduling",
         "functionality/chat-management",
         "functionality/model-access-policies",
+        "functionality/tool-approval-policies",
         "functionality/user-management",
         "functionality/api-keys",
         "functionality/branding",
@@ -65,6 +66,12 @@ const sidebars = {
         "configuration/audit-log-export",
         "configuration/mcp-server-oauth-configuration",
         "configuration/server-configuration",
+        "configuration/metrics",
+        "configuration/tracing",
+        "configuration/cli",
+        "configuration/api",
+        "configuration/backup-and-restore",
+        "configuration/migrating-to-postgres",
         {
           type: "category",
           label: "Encryption",
@@ -78,6 +78,8 @@ var (
 		"/api/models/",
 		"/api/model-access-policies",
 		"/api/model-access-policies/",
+		"/api/tool-approval-policies",
+		"/api/tool-approval-policies/",
 		"/api/available-models",
 		"/api/available-models/",
 		"/api/default-model-aliases",
@@ -166,6 +168,8 @@ var (
 			"GET /api/default-model-aliases",
 			"GET /api/model-access-policies",
 			"GET /api/model-access-policies/",
+			"GET /api/tool-approval-policies",
+			"GET /api/tool-approval-policies/",
 			"GET /api/user-default-role-settings",
 			"GET /api/k8s-settings",
 			"POST /api/auth-providers/",
@@ -203,6 +207,7 @@ var (
 			"POST /api/sendgrid",
 
 			"GET /api/healthz",
+			"GET /api/openapi.json",
 
 			"GET /api/app-preferences",
 
@@ -303,11 +308,19 @@ var (
 			"GET /api/version",
 			"GET /api/setup/oauth-complete",
 
+			// Approval steps that are waiting for a decision from the user. The handlers check that the user is an approver.
+			"GET /api/task-approvals",
+			"POST /api/task-approvals/{run_id}/steps/{step_id}/approve",
+			"POST /api/task-approvals/{run_id}/steps/{step_id}/reject",
+
 			// API key management for user's own keys
 			"POST /api/api-keys",
 			"GET /api/api-keys",
 			"GET /api/api-keys/{id}",
 			"DELETE /api/api-keys/{id}",
+
+			// Client configuration for the user's accessible MCP servers, which can mint API keys
+			"POST /api/mcp-client-config",
 		},
 
 		// API key users have restricted access - they can only access MCP-connect routes and /api/me
@@ -35,6 +35,16 @@ var apiResources = map[string][]string{
 		"GET    /api/assistants/{assistant_id}/projects/{project_id}/credentials",
 		"DELETE /api/assistants/{assistant_id}/projects/{project_id}/credentials/{credential_id}",
 		"GET    /api/assistants/{assistant_id}/projects/{project_id}/default-model",
+		"GET    /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets",
+		"POST   /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets",
+		"DELETE /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}",
+		"GET    /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}",
+		"PUT    /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}",
+		"GET    /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}/compare",
+		"GET    /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}/runs",
+		"POST   /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}/runs",
+		"DELETE /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}/runs/{eval_run_id}",
+		"GET    /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}/runs/{eval_run_id}",
 		"GET    /api/assistants/{assistant_id}/projects/{project_id}/env",
 		"PUT    /api/assistants/{assistant_id}/projects/{project_id}/env",
 		"DELETE /api/assistants/{assistant_id}/projects/{project_id}/file/{file...}",
@@ -111,6 +121,8 @@ var apiResources = map[string][]string{
 		"GET    /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/files/{file...}",
 		"POST   /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/files/{file...}",
 		"POST   /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/steps/{step_id}/run",
+		"POST   /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/steps/{step_id}/approve",
+		"POST   /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/steps/{step_id}/reject",
 		"PUT    /api/assistants/{assistant_id}/projects/{project_id}/templates/{template_id}",
 		"DELETE /api/assistants/{assistant_id}/projects/{project_id}/templates/{template_id}",
 		"GET    /api/assistants/{assistant_id}/projects/{project_id}/templates/{template_id}",
@@ -133,6 +145,7 @@ var apiResources = map[string][]string{
 		"DELETE /api/assistants/{assistant_id}/projects/{project_id}/threads/{thread_id}/files/{file...}",
 		"GET    /api/assistants/{assistant_id}/projects/{project_id}/threads/{thread_id}/files/{file...}",
 		"POST   /api/assistants/{assistant_id}/projects/{project_id}/threads/{thread_id}/files/{file...}",
+		"POST   /api/assistants/{assistant_id}/projects/{project_id}/threads/{thread_id}/fork",
 		"POST   /api/assistants/{assistant_id}/projects/{project_id}/threads/{thread_id}/invoke",
 		"GET    /api/assistants/{assistant_id}/projects/{project_id}/threads/{thread_id}/knowledge-files",
 		"DELETE /api/assistants/{assistant_id}/projects/{project_id}/threads/{thread_id}/knowledge-files/{file...}",
@@ -258,6 +271,7 @@ var apiResources = map[string][]string{
 		"GET    /api/workspaces/{workspace_id}/entries/{entry_id}/oauth-credentials",
 		"POST   /api/workspaces/{workspace_id}/entries/{entry_id}/oauth-credentials",
 		"DELETE /api/workspaces/{workspace_id}/entries/{entry_id}/oauth-credentials",
+		"GET    /api/workspaces/{workspace_id}/entries/{entry_id}/approval",
 	},
 	types.GroupPowerUserPlus: {
 		"GET    /api/workspaces/{workspace_id}/servers",
@@ -276,6 +290,8 @@ var apiResources = map[string][]string{
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/details",
 		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/logs",
 		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/restart",
+		"GET    /api/workspaces/{workspace_id}/servers/{mcp_server_id}/tool-changes",
+		"POST   /api/workspaces/{workspace_id}/servers/{mcp_server_id}/tool-changes/approve",
 		"GET    /api/workspaces/{workspace_id}/access-control-rules",
 		"POST   /api/workspaces/{workspace_id}/access-control-rules",
 		"DELETE /api/workspaces/{workspace_id}/access-control-rules/{access_control_rule_id}",
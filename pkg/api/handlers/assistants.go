@@ -114,6 +114,77 @@ func (a *AssistantHandler) Invoke(req api.Context) error {
 	})
 }
 
+func (a *AssistantHandler) Fork(req api.Context) error {
+	var (
+		thread v1.Thread
+		fork   types.ThreadFork
+	)
+
+	if err := req.Get(&thread, req.PathValue("thread_id")); err != nil {
+		return err
+	}
+
+	if err := req.Read(&fork); err != nil {
+		return err
+	}
+
+	if fork.RunID == "" {
+		return types.NewErrBadRequest("runID is required")
+	}
+
+	var run v1.Run
+	if err := req.Get(&run, fork.RunID); err != nil {
+		return err
+	}
+	if run.Spec.ThreadName != thread.Name {
+		return types.NewErrNotFound("run %s not found in thread %s", fork.RunID, thread.Name)
+	}
+
+	if err := validateTemperature(fork.Temperature); err != nil {
+		return err
+	}
+
+	if thread.Spec.ParentThreadName != "" {
+		var projectThread v1.Thread
+		if err := req.Get(&projectThread, thread.Spec.ParentThreadName); err != nil {
+			return err
+		}
+		if err := validateThreadModel(req, &projectThread, fork.Model, fork.ModelProvider); err != nil {
+			return err
+		}
+	}
+
+	if fork.Tools != nil {
+		var agent v1.Agent
+		if err := req.Get(&agent, thread.Spec.AgentName); err != nil {
+			return err
+		}
+		if err := validateThreadTools(&agent, fork.Tools); err != nil {
+			return err
+		}
+	}
+
+	resp, err := a.invoker.Fork(req.Context(), a.mcpSessionManager, req.GPTClient, a.cachedClient, &thread, &run, invoke.ForkOptions{
+		Input:         fork.Input,
+		ModelProvider: fork.ModelProvider,
+		Model:         fork.Model,
+		Temperature:   fork.Temperature,
+		Tools:         fork.Tools,
+		UserUID:       req.User.GetUID(),
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	req.ResponseWriter.Header().Set("X-Obot-Thread-Id", resp.Thread.Name)
+	return req.WriteCreated(map[string]string{
+		"threadID": resp.Thread.Name,
+		"runID":    resp.Run.Name,
+		"message":  resp.Message,
+	})
+}
+
 func (a *AssistantHandler) Get(req api.Context) error {
 	var (
 		id = req.PathValue("id")
@@ -436,19 +507,8 @@ func (a *AssistantHandler) SetTools(req api.Context) error {
 		return a.Tools(req)
 	}
 
-	for _, tool := range toolList {
-		if !slices.Contains(agent.Spec.Manifest.DefaultThreadTools, tool) && !slices.Contains(agent.Spec.Manifest.AvailableThreadTools, tool) {
-			return types.NewErrBadRequest("tool %s is not available for this agent", tool)
-		}
-	}
-
-	maxThreadTools := DefaultMaxUserThreadTools
-	if agent.Spec.Manifest.MaxThreadTools > 0 {
-		maxThreadTools = agent.Spec.Manifest.MaxThreadTools
-	}
-
-	if len(toolList) > maxThreadTools {
-		return types.NewErrBadRequest("too many tools for this agent")
+	if err := validateThreadTools(&agent, toolList); err != nil {
+		return err
 	}
 
 	toolList = slices.DeleteFunc(toolList, func(s string) bool {
@@ -479,6 +539,25 @@ func (a *AssistantHandler) SetTools(req api.Context) error {
 	return a.Tools(req)
 }
 
+func validateThreadTools(agent *v1.Agent, toolList []string) error {
+	for _, tool := range toolList {
+		if !slices.Contains(agent.Spec.Manifest.DefaultThreadTools, tool) && !slices.Contains(agent.Spec.Manifest.AvailableThreadTools, tool) {
+			return types.NewErrBadRequest("tool %s is not available for this agent", tool)
+		}
+	}
+
+	maxThreadTools := DefaultMaxUserThreadTools
+	if agent.Spec.Manifest.MaxThreadTools > 0 {
+		maxThreadTools = agent.Spec.Manifest.MaxThreadTools
+	}
+
+	if len(toolList) > maxThreadTools {
+		return types.NewErrBadRequest("too many tools for this agent")
+	}
+
+	return nil
+}
+
 func (a *AssistantHandler) Tools(req api.Context) error {
 	var (
 		id     = req.PathValue("assistant_id")
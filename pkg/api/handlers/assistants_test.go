@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestForkValidation(t *testing.T) {
+	storage := newTestStorage(t,
+		&v1.Thread{ObjectMeta: metav1.ObjectMeta{Name: "t1chat", Namespace: system.DefaultNamespace}},
+		&v1.Run{
+			ObjectMeta: metav1.ObjectMeta{Name: "r1other", Namespace: system.DefaultNamespace},
+			Spec:       v1.RunSpec{ThreadName: "t1other"},
+		},
+		&v1.Run{
+			ObjectMeta: metav1.ObjectMeta{Name: "r1chat", Namespace: system.DefaultNamespace},
+			Spec:       v1.RunSpec{ThreadName: "t1chat"},
+		},
+	)
+
+	tests := []struct {
+		name string
+		body string
+		code int
+	}{
+		{name: "missing run", body: `{}`, code: http.StatusBadRequest},
+		{name: "run of another thread", body: `{"runID":"r1other"}`, code: http.StatusNotFound},
+		{name: "temperature out of range", body: `{"runID":"r1chat","temperature":2.5}`, code: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := newTestContext(storage, http.MethodPost, "/api/threads/t1chat/fork", []byte(tt.body), "thread_id", "t1chat")
+			err := (&AssistantHandler{}).Fork(req)
+			var httpErr *types.ErrHTTP
+			if !errors.As(err, &httpErr) || httpErr.Code != tt.code {
+				t.Errorf("expected %d, got %v", tt.code, err)
+			}
+		})
+	}
+}
+
+func TestUpdateThreadValidatesTemperature(t *testing.T) {
+	storage := newTestStorage(t, &v1.Thread{ObjectMeta: metav1.ObjectMeta{Name: "t1chat", Namespace: system.DefaultNamespace}})
+
+	req, _ := newTestContext(storage, http.MethodPut, "/api/threads/t1chat", []byte(`{"temperature":-1}`), "id", "t1chat")
+	err := (&ThreadHandler{}).Update(req)
+	var httpErr *types.ErrHTTP
+	if !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest {
+		t.Fatalf("expected a bad request, got %v", err)
+	}
+
+	temperature := float32(0.5)
+	req, _ = newTestContext(storage, http.MethodPut, "/api/threads/t1chat", []byte(`{"temperature":0.5}`), "id", "t1chat")
+	if err := (&ThreadHandler{}).Update(req); err != nil {
+		t.Fatal(err)
+	}
+	var thread v1.Thread
+	if err := storage.Get(t.Context(), router.Key(system.DefaultNamespace, "t1chat"), &thread); err != nil {
+		t.Fatal(err)
+	}
+	if thread.Spec.Manifest.Temperature == nil || *thread.Spec.Manifest.Temperature != temperature {
+		t.Errorf("expected the temperature to be updated, got %v", thread.Spec.Manifest.Temperature)
+	}
+}
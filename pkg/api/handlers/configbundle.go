@@ -0,0 +1,746 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/validation"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	configKindCatalog           = "catalog"
+	configKindCatalogEntry      = "catalogEntry"
+	configKindServer            = "server"
+	configKindAccessControlRule = "accessControlRule"
+	configKindWebhookValidation = "webhookValidation"
+	configKindSystemMCPServer   = "systemMCPServer"
+)
+
+type ConfigBundleHandler struct{}
+
+func NewConfigBundleHandler() *ConfigBundleHandler {
+	return &ConfigBundleHandler{}
+}
+
+// Export writes the admin-managed MCP configuration as a single YAML bundle.
+// Entries synced from catalog source URLs, power user workspace resources, and system-generated resources are not included.
+func (*ConfigBundleHandler) Export(req api.Context) error {
+	bundle := types.ConfigBundle{
+		APIVersion: types.ConfigBundleAPIVersion,
+		Kind:       types.ConfigBundleKind,
+		ExportedAt: types.NewTime(time.Now()),
+	}
+
+	var catalogs v1.MCPCatalogList
+	if err := req.List(&catalogs); err != nil {
+		return fmt.Errorf("failed to list catalogs: %w", err)
+	}
+	for _, catalog := range catalogs.Items {
+		bundle.Catalogs = append(bundle.Catalogs, types.ConfigBundleCatalog{
+			ID: catalog.Name,
+			MCPCatalogManifest: types.MCPCatalogManifest{
+				DisplayName: catalog.Spec.DisplayName,
+				SourceURLs:  catalog.Spec.SourceURLs,
+			},
+		})
+	}
+
+	var entries v1.MCPServerCatalogEntryList
+	if err := req.List(&entries); err != nil {
+		return fmt.Errorf("failed to list catalog entries: %w", err)
+	}
+	for _, entry := range entries.Items {
+		if !exportableCatalogEntry(entry) {
+			continue
+		}
+		bundle.CatalogEntries = append(bundle.CatalogEntries, types.ConfigBundleCatalogEntry{
+			ID:               entry.Name,
+			MCPCatalogID:     entry.Spec.MCPCatalogName,
+			UnsupportedTools: entry.Spec.UnsupportedTools,
+			Manifest:         entry.Spec.Manifest,
+		})
+	}
+
+	var servers v1.MCPServerList
+	if err := req.List(&servers); err != nil {
+		return fmt.Errorf("failed to list servers: %w", err)
+	}
+	for _, server := range servers.Items {
+		if !exportableServer(server) {
+			continue
+		}
+		secretRef, err := configSecretRef(req, fmt.Sprintf("%s-%s", server.Spec.MCPCatalogID, server.Name), server.Name)
+		if err != nil {
+			return err
+		}
+		bundle.Servers = append(bundle.Servers, types.ConfigBundleServer{
+			ID:               server.Name,
+			MCPCatalogID:     server.Spec.MCPCatalogID,
+			CatalogEntryID:   server.Spec.MCPServerCatalogEntryName,
+			UnsupportedTools: server.Spec.UnsupportedTools,
+			Manifest:         server.Spec.Manifest,
+			SecretRef:        secretRef,
+		})
+	}
+
+	var rules v1.AccessControlRuleList
+	if err := req.List(&rules); err != nil {
+		return fmt.Errorf("failed to list access control rules: %w", err)
+	}
+	for _, rule := range rules.Items {
+		if !exportableAccessControlRule(rule) {
+			continue
+		}
+		bundle.AccessControlRules = append(bundle.AccessControlRules, types.ConfigBundleAccessControlRule{
+			ID:           rule.Name,
+			MCPCatalogID: rule.Spec.MCPCatalogID,
+			Manifest:     rule.Spec.Manifest,
+		})
+	}
+
+	var webhooks v1.MCPWebhookValidationList
+	if err := req.List(&webhooks); err != nil {
+		return fmt.Errorf("failed to list mcp webhook validations: %w", err)
+	}
+	for _, webhook := range webhooks.Items {
+		secretRef, err := configSecretRef(req, system.MCPWebhookValidationCredentialContext, webhook.Name)
+		if err != nil {
+			return err
+		}
+		bundle.WebhookValidations = append(bundle.WebhookValidations, types.ConfigBundleWebhookValidation{
+			ID:        webhook.Name,
+			Manifest:  webhook.Spec.Manifest,
+			SecretRef: secretRef,
+		})
+	}
+
+	var systemServers v1.SystemMCPServerList
+	if err := req.List(&systemServers); err != nil {
+		return fmt.Errorf("failed to list system MCP servers: %w", err)
+	}
+	for _, server := range systemServers.Items {
+		if !exportableSystemMCPServer(server) {
+			continue
+		}
+		secretRef, err := configSecretRef(req, server.Name, server.Name)
+		if err != nil {
+			return err
+		}
+		bundle.SystemMCPServers = append(bundle.SystemMCPServers, types.ConfigBundleSystemMCPServer{
+			ID:        server.Name,
+			Manifest:  server.Spec.Manifest,
+			SecretRef: secretRef,
+		})
+	}
+
+	data, err := yaml.Marshal(bundle)
+	if err != nil {
+		return fmt.Errorf("failed to marshal config bundle: %w", err)
+	}
+
+	req.ResponseWriter.Header().Set("Content-Type", "application/yaml")
+	req.ResponseWriter.Header().Set("Content-Disposition", `attachment; filename="obot-config.yaml"`)
+	_, err = req.ResponseWriter.Write(data)
+	return err
+}
+
+// Import reconciles a config bundle (YAML or JSON) onto the existing resources. Resources are matched by ID, so importing
+// the same bundle more than once is a no-op. IDs that belong to resources outside of the exported scope, like power user
+// workspace resources, are conflicts and fail the import. With dryRun=true nothing is written, and with prune=true resources
+// of the exported kinds that are not in the bundle are deleted.
+func (*ConfigBundleHandler) Import(req api.Context) error {
+	var (
+		dryRun = req.URL.Query().Get("dryRun") == "true"
+		prune  = req.URL.Query().Get("prune") == "true"
+	)
+
+	body, err := req.Body()
+	if err != nil {
+		return err
+	}
+
+	var bundle types.ConfigBundle
+	if err := yaml.UnmarshalStrict(body, &bundle); err != nil {
+		return types.NewErrBadRequest("failed to read config bundle: %v", err)
+	}
+
+	if err := validateConfigBundle(req, bundle); err != nil {
+		return err
+	}
+
+	importer := configImporter{
+		req:    req,
+		dryRun: dryRun,
+		result: types.ConfigImportResult{
+			DryRun:  dryRun,
+			Created: []types.ConfigImportChange{},
+			Updated: []types.ConfigImportChange{},
+			Deleted: []types.ConfigImportChange{},
+		},
+	}
+
+	if err := importer.checkConflicts(bundle); err != nil {
+		return err
+	}
+
+	if err := importer.importBundle(bundle); err != nil {
+		return err
+	}
+
+	if prune {
+		if err := importer.prune(bundle); err != nil {
+			return err
+		}
+	}
+
+	if err := importer.checkSecretRefs(bundle); err != nil {
+		return err
+	}
+
+	return req.Write(importer.result)
+}
+
+func exportableCatalogEntry(entry v1.MCPServerCatalogEntry) bool {
+	// Entries synced from source URLs are recreated by the catalog sync.
+	return entry.Spec.MCPCatalogName != "" && entry.Spec.SourceURL == ""
+}
+
+func exportableServer(server v1.MCPServer) bool {
+	// Component servers are created by their composite server.
+	return server.Spec.MCPCatalogID != "" && server.Spec.CompositeName == "" && !server.Spec.Template
+}
+
+func exportableAccessControlRule(rule v1.AccessControlRule) bool {
+	return rule.Spec.MCPCatalogID != "" && !rule.Spec.Generated
+}
+
+func exportableSystemMCPServer(server v1.SystemMCPServer) bool {
+	return server.Name != system.ObotMCPServerName
+}
+
+// configSecretRef returns a reference to the credential with the given context and name, or nil if it doesn't exist.
+func configSecretRef(req api.Context, credCtx, name string) (*types.ConfigBundleSecretRef, error) {
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{credCtx}, name)
+	if errors.As(err, &gptscript.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to reveal credential: %w", err)
+	}
+
+	if len(cred.Env) == 0 {
+		return nil, nil
+	}
+
+	return &types.ConfigBundleSecretRef{
+		Context: credCtx,
+		Name:    name,
+		Keys:    slices.Sorted(maps.Keys(cred.Env)),
+	}, nil
+}
+
+func validateConfigBundle(req api.Context, bundle types.ConfigBundle) error {
+	if bundle.APIVersion != types.ConfigBundleAPIVersion || bundle.Kind != types.ConfigBundleKind {
+		return types.NewErrBadRequest("unsupported config bundle %s/%s, expected %s/%s", bundle.APIVersion, bundle.Kind, types.ConfigBundleAPIVersion, types.ConfigBundleKind)
+	}
+
+	ids := make(map[string]struct{})
+	checkID := func(kind, id string) error {
+		if id == "" {
+			return types.NewErrBadRequest("%s is missing an id", kind)
+		}
+		if _, ok := ids[kind+"/"+id]; ok {
+			return types.NewErrBadRequest("duplicate %s id: %s", kind, id)
+		}
+		ids[kind+"/"+id] = struct{}{}
+		return nil
+	}
+
+	catalogs := make(map[string]struct{}, len(bundle.Catalogs))
+	for _, catalog := range bundle.Catalogs {
+		if err := checkID(configKindCatalog, catalog.ID); err != nil {
+			return err
+		}
+		catalogs[catalog.ID] = struct{}{}
+	}
+	checkCatalog := func(kind, id, catalogID string) error {
+		if _, ok := catalogs[catalogID]; ok {
+			return nil
+		}
+		if err := req.Get(&v1.MCPCatalog{}, catalogID); apierrors.IsNotFound(err) {
+			return types.NewErrBadRequest("%s %s references catalog %q which does not exist", kind, id, catalogID)
+		} else if err != nil {
+			return fmt.Errorf("failed to get catalog: %w", err)
+		}
+		return nil
+	}
+
+	for _, entry := range bundle.CatalogEntries {
+		if err := checkID(configKindCatalogEntry, entry.ID); err != nil {
+			return err
+		}
+		if err := checkCatalog(configKindCatalogEntry, entry.ID, entry.MCPCatalogID); err != nil {
+			return err
+		}
+		if err := validation.ValidateCatalogEntryManifest(entry.Manifest); err != nil {
+			return types.NewErrBadRequest("invalid catalog entry %s: %v", entry.ID, err)
+		}
+	}
+
+	for _, server := range bundle.Servers {
+		if err := checkID(configKindServer, server.ID); err != nil {
+			return err
+		}
+		if err := checkCatalog(configKindServer, server.ID, server.MCPCatalogID); err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range bundle.AccessControlRules {
+		if err := checkID(configKindAccessControlRule, rule.ID); err != nil {
+			return err
+		}
+		if err := checkCatalog(configKindAccessControlRule, rule.ID, rule.MCPCatalogID); err != nil {
+			return err
+		}
+		if err := rule.Manifest.Validate(); err != nil {
+			return types.NewErrBadRequest("invalid access control rule %s: %v", rule.ID, err)
+		}
+	}
+
+	for _, webhook := range bundle.WebhookValidations {
+		if err := checkID(configKindWebhookValidation, webhook.ID); err != nil {
+			return err
+		}
+		if webhook.Manifest.Secret != "" {
+			return types.NewErrBadRequest("webhook validation %s must not include its secret, use a secretRef instead", webhook.ID)
+		}
+		if err := webhook.Manifest.Validate(); err != nil {
+			return types.NewErrBadRequest("invalid webhook validation %s: %v", webhook.ID, err)
+		}
+	}
+
+	for _, server := range bundle.SystemMCPServers {
+		if err := checkID(configKindSystemMCPServer, server.ID); err != nil {
+			return err
+		}
+		if server.ID == system.ObotMCPServerName {
+			return types.NewErrBadRequest("system MCP server %s is managed by Obot and cannot be imported", server.ID)
+		}
+		if err := validation.ValidateSystemMCPServerManifest(server.Manifest); err != nil {
+			return types.NewErrBadRequest("invalid system MCP server %s: %v", server.ID, err)
+		}
+	}
+
+	return nil
+}
+
+type configImporter struct {
+	req    api.Context
+	dryRun bool
+	result types.ConfigImportResult
+}
+
+// checkConflicts fails the import if an ID in the bundle belongs to an existing resource that bundles don't manage,
+// such as a server in a power user workspace or an entry synced from a catalog source, which the import would
+// otherwise overwrite. Nothing is written until there are no conflicts.
+func (i *configImporter) checkConflicts(bundle types.ConfigBundle) error {
+	var conflicts []string
+	check := func(kind, id string, existing kclient.Object, managed func() bool) error {
+		if err := i.req.Get(existing, id); apierrors.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to get %s %s: %w", kind, id, err)
+		}
+		if !managed() {
+			conflicts = append(conflicts, fmt.Sprintf("%s %s", kind, id))
+		}
+		return nil
+	}
+
+	for _, item := range bundle.CatalogEntries {
+		var existing v1.MCPServerCatalogEntry
+		if err := check(configKindCatalogEntry, item.ID, &existing, func() bool {
+			return exportableCatalogEntry(existing)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range bundle.Servers {
+		var existing v1.MCPServer
+		if err := check(configKindServer, item.ID, &existing, func() bool {
+			return exportableServer(existing)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range bundle.AccessControlRules {
+		var existing v1.AccessControlRule
+		if err := check(configKindAccessControlRule, item.ID, &existing, func() bool {
+			return exportableAccessControlRule(existing)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return types.NewErrHTTP(http.StatusConflict, fmt.Sprintf("the bundle would overwrite resources that are not managed by config bundles: %s", strings.Join(conflicts, ", ")))
+	}
+	return nil
+}
+
+// reconcile creates desired if no object with its name exists. Otherwise, update is called to copy the desired
+// configuration onto existing, and it should return false if nothing changed.
+func (i *configImporter) reconcile(kind string, desired, existing kclient.Object, update func() bool) error {
+	change := types.ConfigImportChange{Kind: kind, ID: desired.GetName()}
+
+	if err := i.req.Get(existing, desired.GetName()); apierrors.IsNotFound(err) {
+		i.result.Created = append(i.result.Created, change)
+		if i.dryRun {
+			return nil
+		}
+		if err := i.req.Create(desired); err != nil {
+			return fmt.Errorf("failed to create %s %s: %w", kind, desired.GetName(), err)
+		}
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get %s %s: %w", kind, desired.GetName(), err)
+	}
+
+	if !update() {
+		i.result.Unchanged++
+		return nil
+	}
+
+	i.result.Updated = append(i.result.Updated, change)
+	if i.dryRun {
+		return nil
+	}
+	if err := i.req.Update(existing); err != nil {
+		return fmt.Errorf("failed to update %s %s: %w", kind, desired.GetName(), err)
+	}
+	return nil
+}
+
+func (i *configImporter) importBundle(bundle types.ConfigBundle) error {
+	namespace := i.req.Namespace()
+
+	for _, item := range bundle.Catalogs {
+		var (
+			spec = v1.MCPCatalogSpec{
+				DisplayName: item.DisplayName,
+				SourceURLs:  item.SourceURLs,
+			}
+			existing v1.MCPCatalog
+		)
+		if err := i.reconcile(configKindCatalog, &v1.MCPCatalog{
+			ObjectMeta: metav1.ObjectMeta{Name: item.ID, Namespace: namespace},
+			Spec:       spec,
+		}, &existing, func() bool {
+			if equality.Semantic.DeepEqual(existing.Spec, spec) {
+				return false
+			}
+			existing.Spec = spec
+			return true
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range bundle.CatalogEntries {
+		var existing v1.MCPServerCatalogEntry
+		if err := i.reconcile(configKindCatalogEntry, &v1.MCPServerCatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: item.ID, Namespace: namespace},
+			Spec: v1.MCPServerCatalogEntrySpec{
+				Editable:         true,
+				MCPCatalogName:   item.MCPCatalogID,
+				UnsupportedTools: item.UnsupportedTools,
+				Manifest:         item.Manifest,
+			},
+		}, &existing, func() bool {
+			if existing.Spec.MCPCatalogName == item.MCPCatalogID &&
+				equality.Semantic.DeepEqual(existing.Spec.UnsupportedTools, item.UnsupportedTools) &&
+				equality.Semantic.DeepEqual(existing.Spec.Manifest, item.Manifest) {
+				return false
+			}
+			existing.Spec.MCPCatalogName = item.MCPCatalogID
+			existing.Spec.UnsupportedTools = item.UnsupportedTools
+			existing.Spec.Manifest = item.Manifest
+			return true
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range bundle.Servers {
+		var existing v1.MCPServer
+		if err := i.reconcile(configKindServer, &v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       item.ID,
+				Namespace:  namespace,
+				Finalizers: []string{v1.MCPServerFinalizer},
+			},
+			Spec: v1.MCPServerSpec{
+				MCPCatalogID:              item.MCPCatalogID,
+				MCPServerCatalogEntryName: item.CatalogEntryID,
+				UnsupportedTools:          item.UnsupportedTools,
+				Manifest:                  item.Manifest,
+				UserID:                    i.req.User.GetUID(),
+			},
+		}, &existing, func() bool {
+			if existing.Spec.MCPCatalogID == item.MCPCatalogID &&
+				existing.Spec.MCPServerCatalogEntryName == item.CatalogEntryID &&
+				equality.Semantic.DeepEqual(existing.Spec.UnsupportedTools, item.UnsupportedTools) &&
+				equality.Semantic.DeepEqual(existing.Spec.Manifest, item.Manifest) {
+				return false
+			}
+			existing.Spec.MCPCatalogID = item.MCPCatalogID
+			existing.Spec.MCPServerCatalogEntryName = item.CatalogEntryID
+			existing.Spec.UnsupportedTools = item.UnsupportedTools
+			existing.Spec.Manifest = item.Manifest
+			return true
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range bundle.AccessControlRules {
+		var existing v1.AccessControlRule
+		if err := i.reconcile(configKindAccessControlRule, &v1.AccessControlRule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       item.ID,
+				Namespace:  namespace,
+				Finalizers: []string{v1.AccessControlRuleFinalizer},
+			},
+			Spec: v1.AccessControlRuleSpec{
+				MCPCatalogID: item.MCPCatalogID,
+				Manifest:     item.Manifest,
+			},
+		}, &existing, func() bool {
+			if existing.Spec.MCPCatalogID == item.MCPCatalogID && equality.Semantic.DeepEqual(existing.Spec.Manifest, item.Manifest) {
+				return false
+			}
+			existing.Spec.MCPCatalogID = item.MCPCatalogID
+			existing.Spec.Manifest = item.Manifest
+			return true
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range bundle.WebhookValidations {
+		var existing v1.MCPWebhookValidation
+		if err := i.reconcile(configKindWebhookValidation, &v1.MCPWebhookValidation{
+			ObjectMeta: metav1.ObjectMeta{Name: item.ID, Namespace: namespace},
+			Spec:       v1.MCPWebhookValidationSpec{Manifest: item.Manifest},
+		}, &existing, func() bool {
+			if equality.Semantic.DeepEqual(existing.Spec.Manifest, item.Manifest) {
+				return false
+			}
+			existing.Spec.Manifest = item.Manifest
+			return true
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range bundle.SystemMCPServers {
+		var existing v1.SystemMCPServer
+		if err := i.reconcile(configKindSystemMCPServer, &v1.SystemMCPServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       item.ID,
+				Namespace:  namespace,
+				Finalizers: []string{v1.SystemMCPServerFinalizer},
+			},
+			Spec: v1.SystemMCPServerSpec{Manifest: item.Manifest},
+		}, &existing, func() bool {
+			if equality.Semantic.DeepEqual(existing.Spec.Manifest, item.Manifest) {
+				return false
+			}
+			existing.Spec.Manifest = item.Manifest
+			return true
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prune deletes the exportable resources that are not in the bundle. Dependents are deleted before the
+// resources they reference. The default catalog is never deleted.
+func (i *configImporter) prune(bundle types.ConfigBundle) error {
+	var rules v1.AccessControlRuleList
+	if err := i.req.List(&rules); err != nil {
+		return fmt.Errorf("failed to list access control rules: %w", err)
+	}
+	ruleIDs := configBundleIDs(bundle.AccessControlRules, func(rule types.ConfigBundleAccessControlRule) string { return rule.ID })
+	for _, rule := range rules.Items {
+		if _, ok := ruleIDs[rule.Name]; !ok && exportableAccessControlRule(rule) {
+			if err := i.delete(configKindAccessControlRule, &rule); err != nil {
+				return err
+			}
+		}
+	}
+
+	var webhooks v1.MCPWebhookValidationList
+	if err := i.req.List(&webhooks); err != nil {
+		return fmt.Errorf("failed to list mcp webhook validations: %w", err)
+	}
+	webhookIDs := configBundleIDs(bundle.WebhookValidations, func(webhook types.ConfigBundleWebhookValidation) string { return webhook.ID })
+	for _, webhook := range webhooks.Items {
+		if _, ok := webhookIDs[webhook.Name]; ok {
+			continue
+		}
+		if !i.dryRun {
+			if err := i.req.GPTClient.DeleteCredential(i.req.Context(), system.MCPWebhookValidationCredentialContext, webhook.Name); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+				return fmt.Errorf("failed to delete credential: %w", err)
+			}
+		}
+		if err := i.delete(configKindWebhookValidation, &webhook); err != nil {
+			return err
+		}
+	}
+
+	var systemServers v1.SystemMCPServerList
+	if err := i.req.List(&systemServers); err != nil {
+		return fmt.Errorf("failed to list system MCP servers: %w", err)
+	}
+	systemServerIDs := configBundleIDs(bundle.SystemMCPServers, func(server types.ConfigBundleSystemMCPServer) string { return server.ID })
+	for _, server := range systemServers.Items {
+		if _, ok := systemServerIDs[server.Name]; !ok && exportableSystemMCPServer(server) {
+			if err := i.delete(configKindSystemMCPServer, &server); err != nil {
+				return err
+			}
+		}
+	}
+
+	var entries v1.MCPServerCatalogEntryList
+	if err := i.req.List(&entries); err != nil {
+		return fmt.Errorf("failed to list catalog entries: %w", err)
+	}
+	var (
+		entryIDs      = configBundleIDs(bundle.CatalogEntries, func(entry types.ConfigBundleCatalogEntry) string { return entry.ID })
+		prunedEntries = make(map[string]struct{})
+	)
+	for _, entry := range entries.Items {
+		if _, ok := entryIDs[entry.Name]; !ok && exportableCatalogEntry(entry) {
+			if err := i.delete(configKindCatalogEntry, &entry); err != nil {
+				return err
+			}
+			prunedEntries[entry.Name] = struct{}{}
+		}
+	}
+
+	var servers v1.MCPServerList
+	if err := i.req.List(&servers); err != nil {
+		return fmt.Errorf("failed to list servers: %w", err)
+	}
+	serverIDs := configBundleIDs(bundle.Servers, func(server types.ConfigBundleServer) string { return server.ID })
+	for _, server := range servers.Items {
+		if _, ok := serverIDs[server.Name]; ok || !exportableServer(server) {
+			continue
+		}
+
+		// Servers still used by a composite that is not being pruned can't be deleted.
+		dependencies, err := listCompositeDeletionDependencies(i.req, server)
+		if err != nil {
+			return fmt.Errorf("failed to list composite deletion dependencies: %w", err)
+		}
+		for _, dependency := range dependencies {
+			if _, ok := prunedEntries[dependency.CatalogEntryID]; !ok {
+				return types.NewErrHTTP(http.StatusConflict, fmt.Sprintf("server %s cannot be pruned because composite %q still uses it", server.Name, dependency.Name))
+			}
+		}
+
+		if err := i.delete(configKindServer, &server); err != nil {
+			return err
+		}
+	}
+
+	var catalogs v1.MCPCatalogList
+	if err := i.req.List(&catalogs); err != nil {
+		return fmt.Errorf("failed to list catalogs: %w", err)
+	}
+	catalogIDs := configBundleIDs(bundle.Catalogs, func(catalog types.ConfigBundleCatalog) string { return catalog.ID })
+	catalogIDs[system.DefaultCatalog] = struct{}{}
+	for _, catalog := range catalogs.Items {
+		if _, ok := catalogIDs[catalog.Name]; !ok {
+			if err := i.delete(configKindCatalog, &catalog); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func configBundleIDs[T any](items []T, id func(T) string) map[string]struct{} {
+	ids := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		ids[id(item)] = struct{}{}
+	}
+	return ids
+}
+
+func (i *configImporter) delete(kind string, obj kclient.Object) error {
+	i.result.Deleted = append(i.result.Deleted, types.ConfigImportChange{Kind: kind, ID: obj.GetName()})
+	if i.dryRun {
+		return nil
+	}
+	if err := i.req.Delete(obj); err != nil {
+		return fmt.Errorf("failed to delete %s %s: %w", kind, obj.GetName(), err)
+	}
+	return nil
+}
+
+// checkSecretRefs records the secret references in the bundle that are not configured in this environment.
+func (i *configImporter) checkSecretRefs(bundle types.ConfigBundle) error {
+	var refs []types.ConfigBundleSecretRef
+	for _, server := range bundle.Servers {
+		if server.SecretRef != nil {
+			refs = append(refs, *server.SecretRef)
+		}
+	}
+	for _, webhook := range bundle.WebhookValidations {
+		if webhook.SecretRef != nil {
+			refs = append(refs, *webhook.SecretRef)
+		}
+	}
+	for _, server := range bundle.SystemMCPServers {
+		if server.SecretRef != nil {
+			refs = append(refs, *server.SecretRef)
+		}
+	}
+
+	for _, ref := range refs {
+		current, err := configSecretRef(i.req, ref.Context, ref.Name)
+		if err != nil {
+			return err
+		}
+
+		if current == nil || slices.ContainsFunc(ref.Keys, func(key string) bool {
+			return !slices.Contains(current.Keys, key)
+		}) {
+			i.result.MissingSecrets = append(i.result.MissingSecrets, ref)
+		}
+	}
+
+	return nil
+}
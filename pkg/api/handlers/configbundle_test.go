@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+func configBundleTestObjects() []client.Object {
+	return []client.Object{
+		&v1.MCPCatalog{
+			ObjectMeta: metav1.ObjectMeta{Name: system.DefaultCatalog, Namespace: system.DefaultNamespace},
+		},
+		&v1.MCPCatalog{
+			ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPCatalogSpec{DisplayName: "Team"},
+		},
+		&v1.MCPServerCatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "fetch", Namespace: system.DefaultNamespace},
+			Spec: v1.MCPServerCatalogEntrySpec{
+				MCPCatalogName: "team",
+				Editable:       true,
+				Manifest: types.MCPServerCatalogEntryManifest{
+					Name:      "fetch",
+					Runtime:   types.RuntimeUVX,
+					UVXConfig: &types.UVXRuntimeConfig{Package: "mcp-server-fetch"},
+				},
+			},
+		},
+		&v1.AccessControlRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "everyone", Namespace: system.DefaultNamespace},
+			Spec: v1.AccessControlRuleSpec{
+				MCPCatalogID: "team",
+				Manifest: types.AccessControlRuleManifest{
+					Subjects:  []types.Subject{{Type: types.SubjectTypeSelector, ID: "*"}},
+					Resources: []types.Resource{{Type: types.ResourceTypeSelector, ID: "*"}},
+				},
+			},
+		},
+		// Synced entries and workspace resources are not part of bundles.
+		&v1.MCPServerCatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "synced", Namespace: system.DefaultNamespace},
+			Spec: v1.MCPServerCatalogEntrySpec{
+				MCPCatalogName: system.DefaultCatalog,
+				SourceURL:      "https://github.com/obot-platform/mcp-catalog",
+				Manifest:       types.MCPServerCatalogEntryManifest{Name: "synced", Runtime: types.RuntimeUVX},
+			},
+		},
+		&v1.AccessControlRule{
+			ObjectMeta: metav1.ObjectMeta{Name: "workspace-rule", Namespace: system.DefaultNamespace},
+			Spec:       v1.AccessControlRuleSpec{PowerUserWorkspaceID: "puw1"},
+		},
+	}
+}
+
+func exportConfigBundle(t *testing.T, storage client.WithWatch) []byte {
+	t.Helper()
+
+	req, rec := newTestContext(storage, http.MethodGet, "/api/config-export", nil)
+	if err := (&ConfigBundleHandler{}).Export(req); err != nil {
+		t.Fatalf("failed to export: %v", err)
+	}
+	return rec.Body.Bytes()
+}
+
+func importConfigBundle(t *testing.T, storage client.WithWatch, query string, bundle []byte) (types.ConfigImportResult, error) {
+	t.Helper()
+
+	req, rec := newTestContext(storage, http.MethodPost, "/api/config-import"+query, bundle)
+	if err := (&ConfigBundleHandler{}).Import(req); err != nil {
+		return types.ConfigImportResult{}, err
+	}
+
+	var result types.ConfigImportResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatal(err)
+	}
+	return result, nil
+}
+
+func TestConfigBundleRoundTrip(t *testing.T) {
+	data := exportConfigBundle(t, newTestStorage(t, configBundleTestObjects()...))
+
+	var bundle types.ConfigBundle
+	if err := yaml.UnmarshalStrict(data, &bundle); err != nil {
+		t.Fatalf("failed to read exported bundle: %v", err)
+	}
+	if len(bundle.Catalogs) != 2 || len(bundle.CatalogEntries) != 1 || len(bundle.AccessControlRules) != 1 {
+		t.Fatalf("expected 2 catalogs, 1 entry and 1 rule, got %d, %d and %d", len(bundle.Catalogs), len(bundle.CatalogEntries), len(bundle.AccessControlRules))
+	}
+
+	target := newTestStorage(t, &v1.MCPCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: system.DefaultCatalog, Namespace: system.DefaultNamespace},
+	})
+
+	result, err := importConfigBundle(t, target, "?dryRun=true", data)
+	if err != nil {
+		t.Fatalf("failed to import: %v", err)
+	}
+	if len(result.Created) != 3 {
+		t.Errorf("expected a dry run to report 3 created resources, got %+v", result.Created)
+	}
+	if err := target.Get(t.Context(), client.ObjectKey{Namespace: system.DefaultNamespace, Name: "team"}, &v1.MCPCatalog{}); err == nil {
+		t.Error("expected a dry run to not create the catalog")
+	}
+
+	result, err = importConfigBundle(t, target, "", data)
+	if err != nil {
+		t.Fatalf("failed to import: %v", err)
+	}
+	if len(result.Created) != 3 || result.Unchanged != 1 {
+		t.Errorf("expected 3 created resources and the default catalog unchanged, got %+v", result)
+	}
+
+	// The imported configuration exports to the same bundle, and importing it again changes nothing.
+	reexported := exportConfigBundle(t, target)
+	var rebundle types.ConfigBundle
+	if err := yaml.UnmarshalStrict(reexported, &rebundle); err != nil {
+		t.Fatal(err)
+	}
+	bundle.ExportedAt, rebundle.ExportedAt = nil, nil
+	if expected, actual := mustJSON(t, bundle), mustJSON(t, rebundle); expected != actual {
+		t.Errorf("expected the re-exported bundle to match:\n%s\ngot:\n%s", expected, actual)
+	}
+
+	result, err = importConfigBundle(t, target, "?prune=true", reexported)
+	if err != nil {
+		t.Fatalf("failed to import: %v", err)
+	}
+	if len(result.Created) != 0 || len(result.Updated) != 0 || len(result.Deleted) != 0 || result.Unchanged != 4 {
+		t.Errorf("expected importing the same bundle to change nothing, got %+v", result)
+	}
+}
+
+func TestConfigImportConflicts(t *testing.T) {
+	storage := newTestStorage(t, append(configBundleTestObjects(),
+		&v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1shared", Namespace: system.DefaultNamespace},
+			Spec: v1.MCPServerSpec{
+				PowerUserWorkspaceID: "puw1",
+				Manifest:             types.MCPServerManifest{Name: "workspace server", Runtime: types.RuntimeUVX},
+			},
+		},
+	)...)
+
+	bundle := types.ConfigBundle{
+		APIVersion: types.ConfigBundleAPIVersion,
+		Kind:       types.ConfigBundleKind,
+		Catalogs:   []types.ConfigBundleCatalog{{ID: "imported"}},
+		CatalogEntries: []types.ConfigBundleCatalogEntry{{
+			ID:           "synced",
+			MCPCatalogID: system.DefaultCatalog,
+			Manifest: types.MCPServerCatalogEntryManifest{
+				Name:      "replaced",
+				Runtime:   types.RuntimeUVX,
+				UVXConfig: &types.UVXRuntimeConfig{Package: "replaced"},
+			},
+		}},
+		Servers: []types.ConfigBundleServer{{
+			ID:           "ms1shared",
+			MCPCatalogID: system.DefaultCatalog,
+			Manifest:     types.MCPServerManifest{Name: "replaced", Runtime: types.RuntimeUVX},
+		}},
+		AccessControlRules: []types.ConfigBundleAccessControlRule{{
+			ID:           "workspace-rule",
+			MCPCatalogID: system.DefaultCatalog,
+		}},
+	}
+
+	_, err := importConfigBundle(t, storage, "", []byte(mustJSON(t, bundle)))
+	var httpErr *types.ErrHTTP
+	if !errors.As(err, &httpErr) || httpErr.Code != http.StatusConflict {
+		t.Fatalf("expected a conflict, got %v", err)
+	}
+	for _, id := range []string{"catalogEntry synced", "server ms1shared", "accessControlRule workspace-rule"} {
+		if !strings.Contains(httpErr.Message, id) {
+			t.Errorf("expected the conflict to mention %s: %s", id, httpErr.Message)
+		}
+	}
+
+	// Nothing is written when there are conflicts.
+	var server v1.MCPServer
+	if err := storage.Get(t.Context(), client.ObjectKey{Namespace: system.DefaultNamespace, Name: "ms1shared"}, &server); err != nil {
+		t.Fatal(err)
+	}
+	if server.Spec.Manifest.Name != "workspace server" || server.Spec.PowerUserWorkspaceID != "puw1" {
+		t.Errorf("expected the workspace server to be unchanged, got %+v", server.Spec)
+	}
+	if err := storage.Get(t.Context(), client.ObjectKey{Namespace: system.DefaultNamespace, Name: "imported"}, &v1.MCPCatalog{}); err == nil {
+		t.Error("expected the catalog in the bundle to not be created")
+	}
+}
+
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// indexedObject is implemented by the storage types that support field selectors.
+type indexedObject interface {
+	client.Object
+	FieldNames() []string
+	Get(field string) string
+}
+
+// newTestStorage returns a fake storage client with the objects, which supports the field selectors of the storage
+// types like the real storage does.
+func newTestStorage(t *testing.T, objs ...client.Object) client.WithWatch {
+	t.Helper()
+
+	builder := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objs...)
+	for kind, typ := range scheme.Scheme.KnownTypes(v1.SchemeGroupVersion) {
+		obj, ok := reflect.New(typ).Interface().(indexedObject)
+		if !ok {
+			continue
+		}
+		builder = builder.WithStatusSubresource(obj)
+		for _, field := range obj.FieldNames() {
+			if field == "metadata.name" || field == "metadata.namespace" {
+				continue
+			}
+			builder = builder.WithIndex(obj, field, func(o client.Object) []string {
+				indexed, ok := o.(indexedObject)
+				if !ok {
+					t.Fatalf("unexpected object for %s index: %T", kind, o)
+				}
+				return []string{indexed.Get(field)}
+			})
+		}
+	}
+
+	return builder.Build()
+}
+
+// newTestContext returns a request context for the admin user test-admin, with the path values in pairs of name and
+// value, and the recorder of the response.
+func newTestContext(storage client.WithWatch, method, target string, body []byte, pathValues ...string) (api.Context, *httptest.ResponseRecorder) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req := httptest.NewRequest(method, target, reader)
+	for i := 0; i+1 < len(pathValues); i += 2 {
+		req.SetPathValue(pathValues[i], pathValues[i+1])
+	}
+
+	rec := httptest.NewRecorder()
+	return api.Context{
+		ResponseWriter: rec,
+		Request:        req,
+		Storage:        storage,
+		User: &user.DefaultInfo{
+			Name:   "test-admin",
+			UID:    "1",
+			Groups: []string{types.GroupAdmin, types.GroupAuthenticated},
+		},
+	}, rec
+}
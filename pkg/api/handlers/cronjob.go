@@ -3,7 +3,6 @@ package handlers
 import (
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/adhocore/gronx"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -132,26 +131,12 @@ func (a *CronJobHandler) Execute(req api.Context) error {
 }
 
 func convertCronJob(cronJob v1.CronJob) types.CronJob {
-	var nextRunAt *time.Time
-	schedule, timezone := cronjob.GetScheduleAndTimezone(cronJob)
-	t := time.Now()
-	if timezone != "" {
-		loc, err := time.LoadLocation(timezone)
-		if err == nil {
-			t = t.In(loc)
-		}
-	}
-
-	if next, err := gronx.NextTickAfter(schedule, t, false); err == nil {
-		nextRunAt = &next
-	}
-
 	return types.CronJob{
 		Metadata:                   MetadataFrom(&cronJob),
 		CronJobManifest:            cronJob.Spec.CronJobManifest,
 		LastRunStartedAt:           v1.NewTime(cronJob.Status.LastRunStartedAt),
 		LastSuccessfulRunCompleted: v1.NewTime(cronJob.Status.LastSuccessfulRunCompleted),
-		NextRunAt:                  types.NewTimeFromPointer(nextRunAt),
+		NextRunAt:                  types.NewTimeFromPointer(cronjob.NextRunTime(cronJob)),
 	}
 }
 
@@ -0,0 +1,325 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/controller/handlers/evalrun"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type EvalHandler struct{}
+
+func NewEvalHandler() *EvalHandler {
+	return &EvalHandler{}
+}
+
+func (*EvalHandler) ListDatasets(req api.Context) error {
+	project, err := getProjectThread(req)
+	if err != nil {
+		return err
+	}
+
+	var datasets v1.EvalDatasetList
+	if err := req.List(&datasets, kclient.MatchingFields{
+		"spec.threadName": project.Name,
+	}); err != nil {
+		return err
+	}
+
+	resp := types.EvalDatasetList{Items: make([]types.EvalDataset, 0, len(datasets.Items))}
+	for _, dataset := range datasets.Items {
+		resp.Items = append(resp.Items, convertEvalDataset(dataset))
+	}
+	return req.Write(resp)
+}
+
+func (*EvalHandler) CreateDataset(req api.Context) error {
+	project, err := getProjectThread(req)
+	if err != nil {
+		return err
+	}
+
+	var manifest types.EvalDatasetManifest
+	if err := req.Read(&manifest); err != nil {
+		return err
+	}
+	if err := evalrun.ValidateDataset(&manifest); err != nil {
+		return types.NewErrBadRequest("invalid dataset: %v", err)
+	}
+
+	dataset := v1.EvalDataset{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.EvalDatasetPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.EvalDatasetSpec{
+			Manifest:   manifest,
+			ThreadName: project.Name,
+			UserID:     req.User.GetUID(),
+		},
+	}
+	if err := req.Create(&dataset); err != nil {
+		return err
+	}
+
+	return req.WriteCreated(convertEvalDataset(dataset))
+}
+
+func (*EvalHandler) GetDataset(req api.Context) error {
+	dataset, _, err := getEvalDataset(req)
+	if err != nil {
+		return err
+	}
+	return req.Write(convertEvalDataset(*dataset))
+}
+
+func (*EvalHandler) UpdateDataset(req api.Context) error {
+	dataset, _, err := getEvalDataset(req)
+	if err != nil {
+		return err
+	}
+
+	var manifest types.EvalDatasetManifest
+	if err := req.Read(&manifest); err != nil {
+		return err
+	}
+	if err := evalrun.ValidateDataset(&manifest); err != nil {
+		return types.NewErrBadRequest("invalid dataset: %v", err)
+	}
+
+	dataset.Spec.Manifest = manifest
+	if err := req.Update(dataset); err != nil {
+		return err
+	}
+
+	return req.Write(convertEvalDataset(*dataset))
+}
+
+func (*EvalHandler) DeleteDataset(req api.Context) error {
+	dataset, _, err := getEvalDataset(req)
+	if err != nil {
+		return kclient.IgnoreNotFound(err)
+	}
+	return req.Delete(dataset)
+}
+
+func (*EvalHandler) CreateRun(req api.Context) error {
+	dataset, project, err := getEvalDataset(req)
+	if err != nil {
+		return err
+	}
+
+	var manifest types.EvalRunManifest
+	if err := req.Read(&manifest); err != nil {
+		return err
+	}
+
+	if manifest.Workers < 0 || manifest.Workers > types.MaxEvalWorkers {
+		return types.NewErrBadRequest("workers must be between 1 and %d, or 0 for the default of %d", types.MaxEvalWorkers, types.DefaultEvalWorkers)
+	}
+
+	config := manifest.Configuration
+	if err := validateTemperature(config.Temperature); err != nil {
+		return err
+	}
+	if err := validateThreadModel(req, project, config.Model, config.ModelProvider); err != nil {
+		return err
+	}
+	if len(config.Tools) > 0 {
+		var agent v1.Agent
+		if err := req.Get(&agent, project.Spec.AgentName); err != nil {
+			return err
+		}
+		if err := validateThreadTools(&agent, config.Tools); err != nil {
+			return err
+		}
+	}
+
+	run := v1.EvalRun{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.EvalRunPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.EvalRunSpec{
+			Manifest:        manifest,
+			EvalDatasetName: dataset.Name,
+			ThreadName:      project.Name,
+			UserID:          req.User.GetUID(),
+			Cases:           dataset.Spec.Manifest.Cases,
+		},
+	}
+	if err := req.Create(&run); err != nil {
+		return err
+	}
+
+	return req.WriteCreated(convertEvalRun(run))
+}
+
+func (*EvalHandler) ListRuns(req api.Context) error {
+	dataset, _, err := getEvalDataset(req)
+	if err != nil {
+		return err
+	}
+
+	var runs v1.EvalRunList
+	if err := req.List(&runs, kclient.MatchingFields{
+		"spec.evalDatasetName": dataset.Name,
+	}); err != nil {
+		return err
+	}
+
+	resp := types.EvalRunList{Items: make([]types.EvalRun, 0, len(runs.Items))}
+	for _, run := range runs.Items {
+		resp.Items = append(resp.Items, convertEvalRun(run))
+	}
+	return req.Write(resp)
+}
+
+func (*EvalHandler) GetRun(req api.Context) error {
+	run, err := getEvalRun(req, req.PathValue("eval_run_id"))
+	if err != nil {
+		return err
+	}
+	return req.Write(convertEvalRun(*run))
+}
+
+func (*EvalHandler) DeleteRun(req api.Context) error {
+	run, err := getEvalRun(req, req.PathValue("eval_run_id"))
+	if err != nil {
+		return kclient.IgnoreNotFound(err)
+	}
+	return req.Delete(run)
+}
+
+// Compare compares the results of the candidate eval run against the baseline eval run.
+func (*EvalHandler) Compare(req api.Context) error {
+	var (
+		baselineID  = req.URL.Query().Get("baseline")
+		candidateID = req.URL.Query().Get("candidate")
+	)
+	if baselineID == "" || candidateID == "" {
+		return types.NewErrBadRequest("baseline and candidate eval run IDs are required")
+	}
+
+	baseline, err := getEvalRun(req, baselineID)
+	if err != nil {
+		return err
+	}
+	candidate, err := getEvalRun(req, candidateID)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(compareEvalRuns(convertEvalRun(*baseline), convertEvalRun(*candidate)))
+}
+
+func getEvalDataset(req api.Context) (*v1.EvalDataset, *v1.Thread, error) {
+	project, err := getProjectThread(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dataset v1.EvalDataset
+	if err := req.Get(&dataset, req.PathValue("eval_dataset_id")); err != nil {
+		return nil, nil, err
+	}
+	if dataset.Spec.ThreadName != project.Name {
+		return nil, nil, types.NewErrNotFound("eval dataset %s not found", req.PathValue("eval_dataset_id"))
+	}
+
+	return &dataset, project, nil
+}
+
+func getEvalRun(req api.Context, id string) (*v1.EvalRun, error) {
+	dataset, _, err := getEvalDataset(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var run v1.EvalRun
+	if err := req.Get(&run, id); err != nil {
+		return nil, err
+	}
+	if run.Spec.EvalDatasetName != dataset.Name {
+		return nil, types.NewErrNotFound("eval run %s not found", id)
+	}
+
+	return &run, nil
+}
+
+func compareEvalRuns(baseline, candidate types.EvalRun) types.EvalComparison {
+	comparison := types.EvalComparison{
+		Baseline:                  baseline,
+		Candidate:                 candidate,
+		PassRateDelta:             candidate.Summary.PassRate - baseline.Summary.PassRate,
+		AverageLatencyMillisDelta: candidate.Summary.AverageLatencyMillis - baseline.Summary.AverageLatencyMillis,
+		TotalTokensDelta:          candidate.Summary.TotalTokens - baseline.Summary.TotalTokens,
+	}
+
+	candidateStates := make(map[string]types.EvalCaseState, len(candidate.Results))
+	for _, result := range candidate.Results {
+		candidateStates[result.CaseID] = result.State
+	}
+
+	for _, result := range baseline.Results {
+		candidateState, ok := candidateStates[result.CaseID]
+		if !ok {
+			continue
+		}
+		c := types.EvalCaseComparison{
+			CaseID:    result.CaseID,
+			Baseline:  result.State,
+			Candidate: candidateState,
+		}
+		switch {
+		case result.State != types.EvalCaseStatePassed && candidateState == types.EvalCaseStatePassed:
+			c.Changed = "improved"
+		case result.State == types.EvalCaseStatePassed && candidateState != types.EvalCaseStatePassed:
+			c.Changed = "regressed"
+		}
+		comparison.Cases = append(comparison.Cases, c)
+	}
+
+	return comparison
+}
+
+func convertEvalDataset(dataset v1.EvalDataset) types.EvalDataset {
+	return types.EvalDataset{
+		Metadata:            MetadataFrom(&dataset),
+		EvalDatasetManifest: dataset.Spec.Manifest,
+		ProjectID:           strings.Replace(dataset.Spec.ThreadName, system.ThreadPrefix, system.ProjectPrefix, 1),
+	}
+}
+
+func convertEvalRun(run v1.EvalRun) types.EvalRun {
+	result := types.EvalRun{
+		Metadata:        MetadataFrom(&run),
+		EvalRunManifest: run.Spec.Manifest,
+		DatasetID:       run.Spec.EvalDatasetName,
+		ProjectID:       strings.Replace(run.Spec.ThreadName, system.ThreadPrefix, system.ProjectPrefix, 1),
+		State:           run.Status.State,
+		Error:           run.Status.Error,
+		Summary:         run.Status.Summary,
+	}
+	if result.State == "" {
+		result.State = types.EvalRunStatePending
+	}
+	if run.Status.StartTime != nil {
+		result.StartTime = types.NewTime(run.Status.StartTime.Time)
+	}
+	if run.Status.EndTime != nil {
+		result.EndTime = types.NewTime(run.Status.EndTime.Time)
+	}
+	for _, r := range run.Status.Results {
+		result.Results = append(result.Results, r.EvalCaseResult)
+	}
+	if result.Summary.Total == 0 {
+		result.Summary.Total = len(run.Spec.Cases)
+	}
+	return result
+}
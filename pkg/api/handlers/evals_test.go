@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestEvalDatasetsAndRuns(t *testing.T) {
+	storage := newTestStorage(t,
+		&v1.Thread{
+			ObjectMeta: metav1.ObjectMeta{Name: "t1project", Namespace: system.DefaultNamespace},
+			Spec:       v1.ThreadSpec{Project: true},
+		},
+		&v1.Thread{
+			ObjectMeta: metav1.ObjectMeta{Name: "t1other", Namespace: system.DefaultNamespace},
+			Spec:       v1.ThreadSpec{Project: true},
+		},
+	)
+	projectPath := []string{"assistant_id", "a1", "project_id", "p1project"}
+
+	req, rec := newTestContext(storage, http.MethodPost, "/api/eval-datasets", []byte(`{"name":"greetings","cases":[{"input":"hi"}]}`), projectPath...)
+	err := (&EvalHandler{}).CreateDataset(req)
+	var httpErr *types.ErrHTTP
+	if !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest {
+		t.Fatalf("expected a case without a grader to be rejected, got %v", err)
+	}
+
+	req, rec = newTestContext(storage, http.MethodPost, "/api/eval-datasets",
+		[]byte(`{"name":"greetings","cases":[{"input":"hi","grader":{"type":"regex","pattern":"hello"}}]}`), projectPath...)
+	if err := (&EvalHandler{}).CreateDataset(req); err != nil {
+		t.Fatal(err)
+	}
+	var dataset types.EvalDataset
+	if err := json.Unmarshal(rec.Body.Bytes(), &dataset); err != nil {
+		t.Fatal(err)
+	}
+	if len(dataset.Cases) != 1 || dataset.Cases[0].ID != "1" {
+		t.Fatalf("expected the case ID to be filled in, got %+v", dataset.Cases)
+	}
+
+	runPath := append(projectPath, "eval_dataset_id", dataset.ID)
+	for _, body := range []string{`{"workers":-1}`, `{"workers":17}`, `{"configuration":{"temperature":3}}`} {
+		req, _ = newTestContext(storage, http.MethodPost, "/api/eval-runs", []byte(body), runPath...)
+		err = (&EvalHandler{}).CreateRun(req)
+		if !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest {
+			t.Errorf("expected %s to be rejected, got %v", body, err)
+		}
+	}
+
+	req, rec = newTestContext(storage, http.MethodPost, "/api/eval-runs", []byte(`{"workers":0}`), runPath...)
+	if err := (&EvalHandler{}).CreateRun(req); err != nil {
+		t.Fatal(err)
+	}
+	var run types.EvalRun
+	if err := json.Unmarshal(rec.Body.Bytes(), &run); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(run.ID, system.EvalRunPrefix) {
+		t.Errorf("expected an eval run ID, got %s", run.ID)
+	}
+
+	var stored v1.EvalRun
+	if err := storage.Get(t.Context(), client.ObjectKey{Namespace: system.DefaultNamespace, Name: run.ID}, &stored); err != nil {
+		t.Fatal(err)
+	}
+	if stored.Spec.ThreadName != "t1project" || stored.Spec.UserID != "1" || len(stored.Spec.Cases) != 1 {
+		t.Errorf("expected the run to snapshot the cases of the project's dataset, got %+v", stored.Spec)
+	}
+
+	// Datasets of other projects are not found.
+	req, _ = newTestContext(storage, http.MethodGet, "/api/eval-runs", nil,
+		"assistant_id", "a1", "project_id", "p1other", "eval_dataset_id", dataset.ID)
+	err = (&EvalHandler{}).ListRuns(req)
+	if !errors.As(err, &httpErr) || httpErr.Code != http.StatusNotFound {
+		t.Errorf("expected the dataset to not be found in another project, got %v", err)
+	}
+}
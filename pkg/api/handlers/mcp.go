@@ -91,6 +91,10 @@ func (m *MCPHandler) GetEntryFromAllSources(req api.Context) error {
 		return types.NewErrForbidden("user is not authorized to access this catalog entry")
 	}
 
+	if !entryVisibleToUser(req, entry) {
+		return types.NewErrNotFound("MCP catalog entry not found")
+	}
+
 	return req.Write(ConvertMCPServerCatalogEntryWithWorkspace(entry, entry.Spec.PowerUserWorkspaceID, ""))
 }
 
@@ -131,6 +135,11 @@ func (m *MCPHandler) ListEntriesFromAllSources(req api.Context) error {
 		}
 
 		if hasAccess {
+			// Hide entries that are still awaiting their first approval from everyone but admins and the workspace owner.
+			if !entryVisibleToUser(req, entry) {
+				continue
+			}
+
 			// Hide entries that require OAuth credentials that haven't been configured (non-admins only).
 			// Workspace owners can always see their own entries (they need to configure the OAuth credentials).
 			if !req.UserIsAdmin() && entryRequiresStaticOAuthCreds(entry) {
@@ -168,6 +177,7 @@ func ConvertMCPServerCatalogEntryWithWorkspace(entry v1.MCPServerCatalogEntry, p
 		PowerUserID:               powerUserID,
 		NeedsUpdate:               entry.Status.NeedsUpdate,
 		OAuthCredentialConfigured: entry.Status.OAuthCredentialConfigured,
+		Approval:                  entry.Spec.Approval,
 	}
 }
 
@@ -1072,6 +1082,10 @@ func mcpServerOrInstanceFromConnectURL(req api.Context, id string) (v1.MCPServer
 			return v1.MCPServer{}, v1.MCPServerInstance{}, types.NewErrNotFound("catalog entry %s not found", id)
 		}
 
+		if !entry.IsApproved() {
+			return v1.MCPServer{}, v1.MCPServerInstance{}, types.NewErrNotFound("catalog entry %s is awaiting admin approval", id)
+		}
+
 		// List the MCP servers for the user and take the first one.
 		var servers v1.MCPServerList
 		if err := req.List(&servers, &kclient.ListOptions{
@@ -1409,7 +1423,8 @@ func serverManifestFromCatalogEntryManifest(
 			Runtime:          types.RuntimeComposite,
 			ToolPreview:      entry.ToolPreview,
 			CompositeConfig: &types.CompositeRuntimeConfig{
-				ComponentServers: make([]types.ComponentServer, 0, len(entry.CompositeConfig.ComponentServers)),
+				ComponentServers:   make([]types.ComponentServer, 0, len(entry.CompositeConfig.ComponentServers)),
+				ToolConflictPolicy: entry.CompositeConfig.ToolConflictPolicy,
 			},
 		}
 
@@ -1618,6 +1633,10 @@ func (m *MCPHandler) CreateServer(req api.Context) error {
 			return types.NewErrBadRequest("catalog entry requires OAuth configuration by an administrator before it can be used")
 		}
 
+		if !catalogEntry.IsApproved() {
+			return types.NewErrBadRequest("catalog entry is awaiting admin approval and cannot be used yet")
+		}
+
 		manifest, err := serverManifestFromCatalogEntryManifest(req.UserIsAdmin(), false, catalogEntry.Spec.Manifest, input.MCPServerManifest)
 		if err != nil {
 			return err
@@ -2283,7 +2302,19 @@ func toolsForServer(ctx context.Context, mcpSessionManager *mcp.SessionManager,
 		return nil, err
 	}
 
-	return mcp.ConvertTools(gTools, allowedTools, server.Spec.UnsupportedTools)
+	tools, err := mcp.ConvertTools(gTools, allowedTools, server.Spec.UnsupportedTools)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tools that are waiting for an admin's approval are disabled regardless of the allowed tools.
+	for i, tool := range tools {
+		if slices.Contains(server.Status.PendingApprovalTools, tool.Name) {
+			tools[i].Enabled = false
+		}
+	}
+
+	return tools, nil
 }
 
 func (m *MCPHandler) removeMCPServer(ctx context.Context, mcpServer v1.MCPServer) error {
@@ -2525,6 +2556,7 @@ func ConvertMCPServer(server v1.MCPServer, credEnv map[string]string, serverURL,
 		K8sSettingsHash:             server.Status.K8sSettingsHash,
 		Template:                    server.Spec.Template,
 		CompositeName:               server.Spec.CompositeName,
+		PendingApprovalTools:        server.Status.PendingApprovalTools,
 	}
 
 	// For composite servers, also consider component configuration if provided
@@ -2857,6 +2889,8 @@ func (m *MCPHandler) GetServerDetails(req api.Context) error {
 		return err
 	}
 
+	details.Health = server.Status.Health
+
 	return req.Write(details)
 }
 
@@ -2866,6 +2900,10 @@ func (m *MCPHandler) RestartServerDeployment(req api.Context) error {
 		return err
 	}
 
+	if catalogID := req.PathValue("catalog_id"); catalogID != "" && server.Spec.MCPCatalogID != catalogID {
+		return types.NewErrNotFound("MCP server %s not found", server.Name)
+	}
+
 	if !req.UserIsAdmin() {
 		// Allow users to restart their own single-user servers.
 		userOwnsServer := server.Spec.UserID == req.User.GetUID() &&
@@ -3253,6 +3291,10 @@ func (m *MCPHandler) StreamServerLogs(req api.Context) error {
 		return err
 	}
 
+	if catalogID := req.PathValue("catalog_id"); catalogID != "" && server.Spec.MCPCatalogID != catalogID {
+		return types.NewErrNotFound("MCP server %s not found", server.Name)
+	}
+
 	// If this is a single-user MCP server that belongs to the user, then let them access the logs.
 	if server.Spec.UserID != req.User.GetUID() || server.Spec.PowerUserWorkspaceID != "" || server.Spec.MCPCatalogID != "" {
 		// If the user doesn't own the server and is not an admin or auditor, check if they have access to the workspace.
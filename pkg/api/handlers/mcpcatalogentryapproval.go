@@ -0,0 +1,313 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+)
+
+// entryRequiresApproval returns true if changes to catalog entries in the given workspace must be reviewed by an admin.
+// Admins are trusted, so their changes never require approval.
+func (h *MCPCatalogHandler) entryRequiresApproval(req api.Context, workspaceID string) bool {
+	return h.requireEntryApproval && workspaceID != "" && !req.UserIsAdmin()
+}
+
+// newPendingApproval returns an approval record for a newly submitted revision.
+func newPendingApproval(req api.Context, manifest types.MCPServerCatalogEntryManifest, hasApprovedRevision bool) *types.CatalogEntryApproval {
+	return &types.CatalogEntryApproval{
+		State:               types.CatalogEntryApprovalStatePending,
+		PendingManifest:     &manifest,
+		HasApprovedRevision: hasApprovedRevision,
+		SubmittedBy:         req.User.GetUID(),
+		SubmittedAt:         types.NewTime(time.Now()),
+	}
+}
+
+// approvedBy returns a copy of the approval record marked as approved by the requesting user.
+func approvedBy(req api.Context, approval types.CatalogEntryApproval, comment string) *types.CatalogEntryApproval {
+	approval.State = types.CatalogEntryApprovalStateApproved
+	approval.PendingManifest = nil
+	approval.HasApprovedRevision = true
+	approval.ReviewedBy = req.User.GetUID()
+	approval.ReviewedAt = types.NewTime(time.Now())
+	approval.Comment = comment
+	return &approval
+}
+
+// ListPendingEntries returns all power user workspace catalog entries with a revision awaiting review (admin only).
+func (h *MCPCatalogHandler) ListPendingEntries(req api.Context) error {
+	var list v1.MCPServerCatalogEntryList
+	if err := req.List(&list); err != nil {
+		return fmt.Errorf("failed to list entries: %w", err)
+	}
+
+	diffs := make([]types.CatalogEntryRevisionDiff, 0)
+	for _, entry := range list.Items {
+		if entry.Spec.PowerUserWorkspaceID == "" || !entry.HasPendingRevision() {
+			continue
+		}
+
+		diff, err := catalogEntryRevisionDiff(entry)
+		if err != nil {
+			return err
+		}
+		diffs = append(diffs, diff)
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Approval.SubmittedAt.GetTime().Before(diffs[j].Approval.SubmittedAt.GetTime())
+	})
+
+	return req.Write(types.CatalogEntryRevisionDiffList{Items: diffs})
+}
+
+// GetEntryApproval returns the approval state of a workspace catalog entry along with
+// the changes between its pending revision and the last approved revision.
+func (h *MCPCatalogHandler) GetEntryApproval(req api.Context) error {
+	entry, err := workspaceEntryForApproval(req)
+	if err != nil {
+		return err
+	}
+
+	diff, err := catalogEntryRevisionDiff(entry)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(diff)
+}
+
+// ReviewEntry approves or rejects the pending revision of a workspace catalog entry (admin only).
+func (h *MCPCatalogHandler) ReviewEntry(req api.Context) error {
+	if !req.UserIsAdmin() {
+		return types.NewErrForbidden("only admins can review catalog entries")
+	}
+
+	entry, err := workspaceEntryForApproval(req)
+	if err != nil {
+		return err
+	}
+
+	if !entry.HasPendingRevision() {
+		return types.NewErrBadRequest("entry %s has no revision awaiting review", entry.Name)
+	}
+
+	var decision types.CatalogEntryApprovalDecision
+	if err := req.Read(&decision); err != nil {
+		return types.NewErrBadRequest("failed to read approval decision: %v", err)
+	}
+
+	if decision.Approve {
+		pending := *entry.Spec.Approval.PendingManifest
+		// Tool previews are generated against the live manifest, so keep them when promoting the revision.
+		if len(pending.ToolPreview) == 0 {
+			pending.ToolPreview = entry.Spec.Manifest.ToolPreview
+		}
+		entry.Spec.Manifest = pending
+		entry.Spec.Approval = approvedBy(req, *entry.Spec.Approval, decision.Comment)
+	} else {
+		if decision.Comment == "" {
+			return types.NewErrBadRequest("a comment is required when rejecting a revision")
+		}
+		entry.Spec.Approval.State = types.CatalogEntryApprovalStateRejected
+		entry.Spec.Approval.ReviewedBy = req.User.GetUID()
+		entry.Spec.Approval.ReviewedAt = types.NewTime(time.Now())
+		entry.Spec.Approval.Comment = decision.Comment
+	}
+
+	if err := req.Update(&entry); err != nil {
+		return fmt.Errorf("failed to update entry: %w", err)
+	}
+
+	return req.Write(ConvertMCPServerCatalogEntryWithWorkspace(entry, entry.Spec.PowerUserWorkspaceID, ""))
+}
+
+func workspaceEntryForApproval(req api.Context) (v1.MCPServerCatalogEntry, error) {
+	var (
+		workspaceID = req.PathValue("workspace_id")
+		entry       v1.MCPServerCatalogEntry
+	)
+
+	if err := req.Get(&v1.PowerUserWorkspace{}, workspaceID); err != nil {
+		return entry, fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	if err := req.Get(&entry, req.PathValue("entry_id")); err != nil {
+		return entry, fmt.Errorf("failed to get entry: %w", err)
+	}
+
+	if entry.Spec.PowerUserWorkspaceID != workspaceID {
+		return entry, types.NewErrBadRequest("entry does not belong to workspace")
+	}
+
+	return entry, nil
+}
+
+func catalogEntryRevisionDiff(entry v1.MCPServerCatalogEntry) (types.CatalogEntryRevisionDiff, error) {
+	diff := types.CatalogEntryRevisionDiff{
+		EntryID:              entry.Name,
+		PowerUserWorkspaceID: entry.Spec.PowerUserWorkspaceID,
+		Changes:              []types.CatalogEntryManifestChange{},
+	}
+
+	if entry.Spec.Approval == nil {
+		// Entries outside the approval workflow are implicitly approved.
+		diff.Approval = types.CatalogEntryApproval{
+			State:               types.CatalogEntryApprovalStateApproved,
+			HasApprovedRevision: true,
+		}
+		diff.ApprovedManifest = &entry.Spec.Manifest
+		return diff, nil
+	}
+
+	diff.Approval = *entry.Spec.Approval
+	if entry.Spec.Approval.HasApprovedRevision {
+		diff.ApprovedManifest = &entry.Spec.Manifest
+	}
+
+	if entry.Spec.Approval.PendingManifest == nil {
+		return diff, nil
+	}
+
+	changes, err := diffCatalogEntryManifests(diff.ApprovedManifest, entry.Spec.Approval.PendingManifest)
+	if err != nil {
+		return diff, fmt.Errorf("failed to compute manifest diff for entry %s: %w", entry.Name, err)
+	}
+	diff.Changes = changes
+
+	return diff, nil
+}
+
+// derivedManifestFields are the fields of catalog entry manifests that Obot regenerates from the servers themselves,
+// such as the tool previews. Differences in them are not changes to review or to sync.
+var derivedManifestFields = []string{"toolPreview"}
+
+// diffCatalogEntryManifests returns the fields that differ between the previous and current manifests, keyed by
+// their JSON path (for example "remoteConfig.headers[0].value"). A nil previous manifest is treated as empty.
+// Derived fields are ignored.
+func diffCatalogEntryManifests(previous, current *types.MCPServerCatalogEntryManifest) ([]types.CatalogEntryManifestChange, error) {
+	prevFields, err := flattenManifest(previous)
+	if err != nil {
+		return nil, err
+	}
+	currFields, err := flattenManifest(current)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make(map[string]struct{}, len(prevFields)+len(currFields))
+	for path := range prevFields {
+		paths[path] = struct{}{}
+	}
+	for path := range currFields {
+		paths[path] = struct{}{}
+	}
+
+	changes := make([]types.CatalogEntryManifestChange, 0)
+	for path := range paths {
+		if isDerivedManifestField(path) {
+			continue
+		}
+
+		prev, prevOK := prevFields[path]
+		curr, currOK := currFields[path]
+		if prevOK && currOK && reflect.DeepEqual(prev, curr) {
+			continue
+		}
+
+		change := types.CatalogEntryManifestChange{Path: path}
+		if prevOK {
+			if change.Previous, err = encodeManifestValue(prev); err != nil {
+				return nil, err
+			}
+		}
+		if currOK {
+			if change.Current, err = encodeManifestValue(curr); err != nil {
+				return nil, err
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes, nil
+}
+
+func isDerivedManifestField(path string) bool {
+	for _, field := range derivedManifestFields {
+		if path == field || strings.HasPrefix(path, field+".") || strings.HasPrefix(path, field+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+func flattenManifest(manifest *types.MCPServerCatalogEntryManifest) (map[string]any, error) {
+	fields := make(map[string]any)
+	if manifest == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	var obj any
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+	}
+
+	flattenValue("", obj, fields)
+	return fields, nil
+}
+
+func flattenValue(prefix string, value any, fields map[string]any) {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			flattenValue(path, child, fields)
+		}
+	case []any:
+		for i, child := range v {
+			flattenValue(prefix+"["+strconv.Itoa(i)+"]", child, fields)
+		}
+	case nil, string:
+		// Treat empty values as absent so that omitted and zero-valued fields compare equal.
+		if v != nil && v != "" {
+			fields[prefix] = v
+		}
+	default:
+		fields[prefix] = v
+	}
+}
+
+func encodeManifestValue(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest value: %w", err)
+	}
+	return string(data), nil
+}
+
+// entryVisibleToUser returns true if the entry has an approved revision, or if the user is an admin or owns the entry's workspace.
+// Entries that have never been approved are hidden from everyone else.
+func entryVisibleToUser(req api.Context, entry v1.MCPServerCatalogEntry) bool {
+	return entry.IsApproved() || req.UserIsAdmin() || entry.Spec.PowerUserWorkspaceID == system.GetPowerUserWorkspaceID(req.User.GetUID())
+}
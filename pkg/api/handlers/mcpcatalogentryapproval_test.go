@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+func TestDiffCatalogEntryManifests(t *testing.T) {
+	base := types.MCPServerCatalogEntryManifest{
+		Name:    "github",
+		Runtime: types.RuntimeRemote,
+		RemoteConfig: &types.RemoteCatalogConfig{
+			FixedURL: "https://api.githubcopilot.com/mcp/",
+			Headers: []types.MCPHeader{
+				{Name: "Token", Key: "Authorization", Sensitive: true, Required: true},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		previous *types.MCPServerCatalogEntryManifest
+		current  func() *types.MCPServerCatalogEntryManifest
+		expected []types.CatalogEntryManifestChange
+	}{
+		{
+			name:     "identical manifests",
+			previous: &base,
+			current: func() *types.MCPServerCatalogEntryManifest {
+				m := base
+				return &m
+			},
+			expected: []types.CatalogEntryManifestChange{},
+		},
+		{
+			name:     "changed URL",
+			previous: &base,
+			current: func() *types.MCPServerCatalogEntryManifest {
+				m := base
+				m.RemoteConfig = &types.RemoteCatalogConfig{
+					FixedURL: "https://evil.example.com/mcp/",
+					Headers:  base.RemoteConfig.Headers,
+				}
+				return &m
+			},
+			expected: []types.CatalogEntryManifestChange{
+				{
+					Path:     "remoteConfig.fixedURL",
+					Previous: `"https://api.githubcopilot.com/mcp/"`,
+					Current:  `"https://evil.example.com/mcp/"`,
+				},
+			},
+		},
+		{
+			name:     "added header and description",
+			previous: &base,
+			current: func() *types.MCPServerCatalogEntryManifest {
+				m := base
+				m.Description = "GitHub tools"
+				m.RemoteConfig = &types.RemoteCatalogConfig{
+					FixedURL: base.RemoteConfig.FixedURL,
+					Headers: append([]types.MCPHeader{base.RemoteConfig.Headers[0]}, types.MCPHeader{
+						Key:   "X-Org",
+						Value: "acme",
+					}),
+				}
+				return &m
+			},
+			expected: []types.CatalogEntryManifestChange{
+				{Path: "description", Current: `"GitHub tools"`},
+				{Path: "remoteConfig.headers[1].key", Current: `"X-Org"`},
+				{Path: "remoteConfig.headers[1].required", Current: "false"},
+				{Path: "remoteConfig.headers[1].sensitive", Current: "false"},
+				{Path: "remoteConfig.headers[1].value", Current: `"acme"`},
+			},
+		},
+		{
+			name:     "regenerated tool previews",
+			previous: &base,
+			current: func() *types.MCPServerCatalogEntryManifest {
+				m := base
+				m.ToolPreview = []types.MCPServerTool{{Name: "create_issue", Description: "Creates an issue"}}
+				return &m
+			},
+			expected: []types.CatalogEntryManifestChange{},
+		},
+		{
+			name:     "no previously approved revision",
+			previous: nil,
+			current: func() *types.MCPServerCatalogEntryManifest {
+				return &types.MCPServerCatalogEntryManifest{
+					Name:    "fetch",
+					Runtime: types.RuntimeUVX,
+				}
+			},
+			expected: []types.CatalogEntryManifestChange{
+				{Path: "name", Current: `"fetch"`},
+				{Path: "runtime", Current: `"uvx"`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := diffCatalogEntryManifests(tt.previous, tt.current())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(changes) != len(tt.expected) {
+				t.Fatalf("expected %d changes, got %d: %+v", len(tt.expected), len(changes), changes)
+			}
+
+			for i := range changes {
+				if changes[i] != tt.expected[i] {
+					t.Errorf("change %d: expected %+v, got %+v", i, tt.expected[i], changes[i])
+				}
+			}
+		})
+	}
+}
@@ -36,16 +36,19 @@ type MCPCatalogHandler struct {
 	oauthChecker       MCPOAuthChecker
 	gatewayClient      *gclient.Client
 	acrHelper          *accesscontrolrule.Helper
+	// requireEntryApproval gates power user workspace catalog entries behind admin approval.
+	requireEntryApproval bool
 }
 
-func NewMCPCatalogHandler(defaultCatalogPath string, serverURL string, sessionManager *mcp.SessionManager, oauthChecker MCPOAuthChecker, gatewayClient *gclient.Client, acrHelper *accesscontrolrule.Helper) *MCPCatalogHandler {
+func NewMCPCatalogHandler(defaultCatalogPath string, serverURL string, sessionManager *mcp.SessionManager, oauthChecker MCPOAuthChecker, gatewayClient *gclient.Client, acrHelper *accesscontrolrule.Helper, requireEntryApproval bool) *MCPCatalogHandler {
 	return &MCPCatalogHandler{
-		defaultCatalogPath: defaultCatalogPath,
-		serverURL:          serverURL,
-		sessionManager:     sessionManager,
-		oauthChecker:       oauthChecker,
-		gatewayClient:      gatewayClient,
-		acrHelper:          acrHelper,
+		defaultCatalogPath:   defaultCatalogPath,
+		serverURL:            serverURL,
+		sessionManager:       sessionManager,
+		oauthChecker:         oauthChecker,
+		gatewayClient:        gatewayClient,
+		acrHelper:            acrHelper,
+		requireEntryApproval: requireEntryApproval,
 	}
 }
 
@@ -110,7 +113,22 @@ func (h *MCPCatalogHandler) Update(req api.Context) error {
 	}
 
 	// The only field that can be updated is the source URLs.
-	for _, urlStr := range manifest.SourceURLs {
+	if err := h.validateSourceURLs(manifest.SourceURLs); err != nil {
+		return err
+	}
+
+	catalog.Spec.SourceURLs = manifest.SourceURLs
+
+	if err := req.Update(&catalog); err != nil {
+		return fmt.Errorf("failed to update catalog: %w", err)
+	}
+
+	return req.Write(convertMCPCatalog(catalog))
+}
+
+// validateSourceURLs ensures that the catalog source URLs are HTTPS URLs (or the default catalog path) with no duplicates.
+func (h *MCPCatalogHandler) validateSourceURLs(sourceURLs []string) error {
+	for _, urlStr := range sourceURLs {
 		if urlStr != "" && urlStr != h.defaultCatalogPath {
 			u, err := url.Parse(urlStr)
 			if err != nil {
@@ -124,8 +142,8 @@ func (h *MCPCatalogHandler) Update(req api.Context) error {
 	}
 
 	// Check for duplicate URLs
-	seen := make(map[string]struct{}, len(manifest.SourceURLs))
-	for _, urlStr := range manifest.SourceURLs {
+	seen := make(map[string]struct{}, len(sourceURLs))
+	for _, urlStr := range sourceURLs {
 		if urlStr != "" {
 			if _, ok := seen[urlStr]; ok {
 				return types.NewErrBadRequest("duplicate URL found: %s", urlStr)
@@ -134,13 +152,7 @@ func (h *MCPCatalogHandler) Update(req api.Context) error {
 		}
 	}
 
-	catalog.Spec.SourceURLs = manifest.SourceURLs
-
-	if err := req.Update(&catalog); err != nil {
-		return fmt.Errorf("failed to update catalog: %w", err)
-	}
-
-	return req.Write(convertMCPCatalog(catalog))
+	return nil
 }
 
 // ListEntries lists all entries for a catalog or workspace.
@@ -207,6 +219,11 @@ func (h *MCPCatalogHandler) ListEntries(req api.Context) error {
 		}
 
 		if hasAccess {
+			// Hide entries that are still awaiting their first approval.
+			if !entryVisibleToUser(req, entry) {
+				continue
+			}
+
 			// Hide catalog entries that require OAuth credentials that haven't been configured (non-admins only).
 			if !req.UserIsAdmin() && entryRequiresStaticOAuthCreds(entry) {
 				continue
@@ -317,6 +334,11 @@ func (h *MCPCatalogHandler) CreateEntry(req api.Context) error {
 		entry.Spec.PowerUserWorkspaceID = workspaceID
 	}
 
+	if h.entryRequiresApproval(req, workspaceID) {
+		// New entries are held for review; they are not launchable until an admin approves them.
+		entry.Spec.Approval = newPendingApproval(req, manifest, false)
+	}
+
 	if err := req.Create(&entry); err != nil {
 		return fmt.Errorf("failed to create entry: %w", err)
 	}
@@ -370,8 +392,23 @@ func (h *MCPCatalogHandler) UpdateEntry(req api.Context) error {
 	// Copy the tool previews over so that they don't get wiped out when updating the manifest
 	manifest.ToolPreview = entry.Spec.Manifest.ToolPreview
 
-	// Update the manifest
-	entry.Spec.Manifest = manifest
+	switch {
+	case h.entryRequiresApproval(req, workspaceID):
+		if entry.Spec.Approval == nil || entry.Spec.Approval.HasApprovedRevision {
+			// The current manifest stays live until the new revision is approved.
+			entry.Spec.Approval = newPendingApproval(req, manifest, true)
+		} else {
+			// The entry has never been approved, so there is nothing live to preserve.
+			entry.Spec.Manifest = manifest
+			entry.Spec.Approval = newPendingApproval(req, manifest, false)
+		}
+	default:
+		// Admin edits, and edits made while approval is disabled, are applied directly.
+		entry.Spec.Manifest = manifest
+		if entry.Spec.Approval != nil {
+			entry.Spec.Approval = approvedBy(req, *entry.Spec.Approval, "")
+		}
+	}
 
 	if err := req.Update(&entry); err != nil {
 		return fmt.Errorf("failed to update entry: %w", err)
@@ -1598,7 +1635,8 @@ func convertServerManifestToCatalogManifest(serverManifest types.MCPServerManife
 				}
 			}
 			catalogManifest.CompositeConfig = &types.CompositeCatalogConfig{
-				ComponentServers: componentServers,
+				ComponentServers:   componentServers,
+				ToolConflictPolicy: serverManifest.CompositeConfig.ToolConflictPolicy,
 			}
 		}
 	}
@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/controller/handlers/mcpcatalog"
+	"github.com/obot-platform/obot/pkg/controller/handlers/mcpserver"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PreviewRefresh reads the catalog sources the same way the catalog controller does and reports
+// the differences from the existing entries. Nothing is written.
+// Admins can pass sourceURL query parameters to preview those sources instead of the catalog's own.
+func (h *MCPCatalogHandler) PreviewRefresh(req api.Context) error {
+	var catalog v1.MCPCatalog
+	if err := req.Get(&catalog, req.PathValue("catalog_id")); err != nil {
+		return fmt.Errorf("failed to get catalog: %w", err)
+	}
+
+	sourceURLs := catalog.Spec.SourceURLs
+	if override := req.URL.Query()["sourceURL"]; len(override) > 0 {
+		// Previewing other sources makes the server fetch them, so it is limited to the users who can change them.
+		if !req.UserIsAdmin() && !req.UserIsOwner() {
+			return types.NewErrForbidden("only admins can preview other catalog sources")
+		}
+		if err := h.validateSourceURLs(override); err != nil {
+			return err
+		}
+		sourceURLs = override
+	}
+
+	preview := types.MCPCatalogSyncPreview{
+		SourceURLs: sourceURLs,
+		Added:      []types.MCPCatalogSyncEntryChange{},
+		Removed:    []types.MCPCatalogSyncEntryChange{},
+		Changed:    []types.MCPCatalogSyncEntryChange{},
+	}
+
+	incoming := make(map[string]*v1.MCPServerCatalogEntry)
+	for _, sourceURL := range sourceURLs {
+		objs, err := mcpcatalog.ReadMCPCatalog(catalog.Name, sourceURL)
+		if err != nil {
+			addSyncPreviewError(&preview, sourceURL, err)
+		}
+
+		for _, obj := range objs {
+			entry := obj.(*v1.MCPServerCatalogEntry)
+			incoming[entry.Name] = entry
+		}
+	}
+
+	// The controller doesn't prune entries if any source had errors.
+	preview.PruneSkipped = len(preview.SourceErrors) > 0 || len(preview.ValidationErrors) > 0
+
+	var existing v1.MCPServerCatalogEntryList
+	if err := req.List(&existing, client.MatchingFields{"spec.mcpCatalogName": catalog.Name}); err != nil {
+		return fmt.Errorf("failed to list catalog entries: %w", err)
+	}
+
+	existingByName := make(map[string]v1.MCPServerCatalogEntry, len(existing.Items))
+	for _, entry := range existing.Items {
+		// Entries created through the API are not managed by the sync.
+		if entry.Spec.SourceURL == "" {
+			continue
+		}
+		existingByName[entry.Name] = entry
+
+		if _, ok := incoming[entry.Name]; !ok && !preview.PruneSkipped {
+			preview.Removed = append(preview.Removed, types.MCPCatalogSyncEntryChange{
+				EntryID:   entry.Name,
+				Name:      entry.Spec.Manifest.Name,
+				SourceURL: entry.Spec.SourceURL,
+			})
+		}
+	}
+
+	for name, entry := range incoming {
+		current, ok := existingByName[name]
+		if !ok {
+			preview.Added = append(preview.Added, types.MCPCatalogSyncEntryChange{
+				EntryID:   name,
+				Name:      entry.Spec.Manifest.Name,
+				SourceURL: entry.Spec.SourceURL,
+			})
+			continue
+		}
+
+		changes, err := diffCatalogEntryManifests(&current.Spec.Manifest, &entry.Spec.Manifest)
+		if err != nil {
+			return fmt.Errorf("failed to compute changes for entry %s: %w", name, err)
+		}
+		if len(changes) == 0 {
+			preview.Unchanged++
+			continue
+		}
+
+		servers, instances, err := countServersNeedingUpdate(req, name, entry.Spec.Manifest)
+		if err != nil {
+			return err
+		}
+
+		preview.Changed = append(preview.Changed, types.MCPCatalogSyncEntryChange{
+			EntryID:                name,
+			Name:                   entry.Spec.Manifest.Name,
+			SourceURL:              entry.Spec.SourceURL,
+			Changes:                changes,
+			ServersNeedingUpdate:   servers,
+			InstancesNeedingUpdate: instances,
+		})
+		preview.ServersNeedingUpdate += servers
+		preview.InstancesNeedingUpdate += instances
+	}
+
+	for _, changes := range [][]types.MCPCatalogSyncEntryChange{preview.Added, preview.Removed, preview.Changed} {
+		sort.Slice(changes, func(i, j int) bool {
+			return changes[i].Name < changes[j].Name
+		})
+	}
+	sort.Slice(preview.ValidationErrors, func(i, j int) bool {
+		if preview.ValidationErrors[i].SourceURL != preview.ValidationErrors[j].SourceURL {
+			return preview.ValidationErrors[i].SourceURL < preview.ValidationErrors[j].SourceURL
+		}
+		return preview.ValidationErrors[i].EntryName < preview.ValidationErrors[j].EntryName
+	})
+
+	return req.Write(preview)
+}
+
+// addSyncPreviewError records the error from reading a catalog source. Entry validation errors are
+// reported individually, and anything else means the source could not be read at all.
+func addSyncPreviewError(preview *types.MCPCatalogSyncPreview, sourceURL string, err error) {
+	errs := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+
+	for _, err := range errs {
+		if validationErr := (*mcpcatalog.EntryValidationError)(nil); errors.As(err, &validationErr) {
+			preview.ValidationErrors = append(preview.ValidationErrors, types.MCPCatalogSyncValidationError{
+				SourceURL: sourceURL,
+				EntryName: validationErr.EntryName,
+				Error:     validationErr.Err.Error(),
+			})
+			continue
+		}
+
+		if preview.SourceErrors == nil {
+			preview.SourceErrors = make(map[string]string)
+		}
+		preview.SourceErrors[sourceURL] = err.Error()
+	}
+}
+
+// countServersNeedingUpdate returns the number of servers created from the catalog entry that would drift from the
+// given manifest and are not already marked as needing an update, along with the number of instances of those servers.
+func countServersNeedingUpdate(req api.Context, entryName string, manifest types.MCPServerCatalogEntryManifest) (int, int, error) {
+	var servers v1.MCPServerList
+	if err := req.List(&servers, client.MatchingFields{"spec.mcpServerCatalogEntryName": entryName}); err != nil {
+		return 0, 0, fmt.Errorf("failed to list servers for entry %s: %w", entryName, err)
+	}
+
+	var serverCount, instanceCount int
+	for _, server := range servers.Items {
+		if server.Spec.CompositeName != "" || server.Status.NeedsUpdate {
+			continue
+		}
+
+		drifted, err := mcpserver.ConfigurationHasDrifted(server.Spec.Manifest, manifest)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to check drift for server %s: %w", server.Name, err)
+		}
+		if !drifted {
+			continue
+		}
+		serverCount++
+
+		var instances v1.MCPServerInstanceList
+		if err := req.List(&instances, client.MatchingFields{"spec.mcpServerName": server.Name}); err != nil {
+			return 0, 0, fmt.Errorf("failed to list instances for server %s: %w", server.Name, err)
+		}
+		instanceCount += len(instances.Items)
+	}
+
+	return serverCount, instanceCount, nil
+}
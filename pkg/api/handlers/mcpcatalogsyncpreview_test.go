@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/controller/handlers/mcpcatalog"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const previewCatalog = `
+- name: fetch
+  description: Fetches URLs
+  runtime: uvx
+  uvxConfig:
+    package: mcp-server-fetch
+- name: time
+  runtime: uvx
+  uvxConfig:
+    package: mcp-server-time
+`
+
+func TestPreviewRefresh(t *testing.T) {
+	sourceURL := filepath.Join(t.TempDir(), "catalog.yaml")
+	if err := os.WriteFile(sourceURL, []byte(previewCatalog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	fetchEntryName := name.SafeHashConcatName(system.DefaultCatalog, "fetch")
+	staleEntryName := name.SafeHashConcatName(system.DefaultCatalog, "stale")
+	timeEntryName := name.SafeHashConcatName(system.DefaultCatalog, "time")
+
+	objs := []client.Object{
+		&v1.MCPCatalog{
+			ObjectMeta: metav1.ObjectMeta{Name: system.DefaultCatalog, Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPCatalogSpec{SourceURLs: []string{sourceURL}},
+		},
+		&v1.MCPServerCatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: fetchEntryName, Namespace: system.DefaultNamespace},
+			Spec: v1.MCPServerCatalogEntrySpec{
+				MCPCatalogName: system.DefaultCatalog,
+				SourceURL:      sourceURL,
+				Manifest: types.MCPServerCatalogEntryManifest{
+					Name:        "fetch",
+					Description: "Fetches URLs",
+					Runtime:     types.RuntimeUVX,
+					UVXConfig:   &types.UVXRuntimeConfig{Package: "mcp-server-fetch==1.0"},
+					// Tool previews are generated by Obot and never come from the source.
+					ToolPreview: []types.MCPServerTool{{Name: "fetch"}},
+				},
+			},
+		},
+		&v1.MCPServerCatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: staleEntryName, Namespace: system.DefaultNamespace},
+			Spec: v1.MCPServerCatalogEntrySpec{
+				MCPCatalogName: system.DefaultCatalog,
+				SourceURL:      sourceURL,
+				Manifest:       types.MCPServerCatalogEntryManifest{Name: "stale", Runtime: types.RuntimeUVX},
+			},
+		},
+		&v1.MCPServerCatalogEntry{
+			// Entries created through the API are not managed by the sync.
+			ObjectMeta: metav1.ObjectMeta{Name: "manual", Namespace: system.DefaultNamespace},
+			Spec: v1.MCPServerCatalogEntrySpec{
+				MCPCatalogName: system.DefaultCatalog,
+				Manifest:       types.MCPServerCatalogEntryManifest{Name: "manual", Runtime: types.RuntimeUVX},
+			},
+		},
+		&v1.MCPServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "ms1fetch", Namespace: system.DefaultNamespace},
+			Spec: v1.MCPServerSpec{
+				MCPServerCatalogEntryName: fetchEntryName,
+				Manifest: types.MCPServerManifest{
+					Name:      "fetch",
+					Runtime:   types.RuntimeUVX,
+					UVXConfig: &types.UVXRuntimeConfig{Package: "mcp-server-fetch==1.0"},
+				},
+			},
+		},
+		&v1.MCPServerInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "msi1fetch", Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPServerInstanceSpec{MCPServerName: "ms1fetch"},
+		},
+	}
+
+	storage := newTestStorage(t, objs...)
+	h := &MCPCatalogHandler{}
+	req, rec := newTestContext(storage, http.MethodGet, "/api/mcp-catalogs/default/refresh-preview", nil, "catalog_id", system.DefaultCatalog)
+	if err := h.PreviewRefresh(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var preview types.MCPCatalogSyncPreview
+	if err := json.Unmarshal(rec.Body.Bytes(), &preview); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(preview.Added) != 1 || preview.Added[0].EntryID != timeEntryName {
+		t.Errorf("expected the time entry to be added, got %+v", preview.Added)
+	}
+	if len(preview.Removed) != 1 || preview.Removed[0].EntryID != staleEntryName {
+		t.Errorf("expected the stale entry to be removed, got %+v", preview.Removed)
+	}
+	if len(preview.Changed) != 1 || preview.Changed[0].EntryID != fetchEntryName {
+		t.Fatalf("expected the fetch entry to change, got %+v", preview.Changed)
+	}
+	if changes := preview.Changed[0].Changes; len(changes) != 1 || changes[0].Path != "uvxConfig.package" {
+		t.Errorf("expected only the package of the fetch entry to change, got %+v", changes)
+	}
+	if preview.ServersNeedingUpdate != 1 || preview.InstancesNeedingUpdate != 1 {
+		t.Errorf("expected 1 server and 1 instance to need an update, got %d and %d", preview.ServersNeedingUpdate, preview.InstancesNeedingUpdate)
+	}
+	if preview.PruneSkipped {
+		t.Error("expected the sync to prune")
+	}
+
+	// Nothing is written.
+	var entries v1.MCPServerCatalogEntryList
+	if err := storage.List(t.Context(), &entries); err != nil {
+		t.Fatal(err)
+	}
+	if len(entries.Items) != 3 {
+		t.Errorf("expected the 3 existing entries to be unchanged, got %d entries", len(entries.Items))
+	}
+}
+
+func TestPreviewRefreshSourceOverride(t *testing.T) {
+	storage := newTestStorage(t, &v1.MCPCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: system.DefaultCatalog, Namespace: system.DefaultNamespace},
+	})
+	h := &MCPCatalogHandler{}
+
+	req, _ := newTestContext(storage, http.MethodGet, "/api/mcp-catalogs/default/refresh-preview?sourceURL=http://example.com/catalog.yaml", nil, "catalog_id", system.DefaultCatalog)
+	var httpErr *types.ErrHTTP
+	if err := h.PreviewRefresh(req); !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest {
+		t.Errorf("expected a bad request for an HTTP source, got %v", err)
+	}
+
+	req, _ = newTestContext(storage, http.MethodGet, "/api/mcp-catalogs/default/refresh-preview?sourceURL=https://example.com/catalog.yaml", nil, "catalog_id", system.DefaultCatalog)
+	req.User = &user.DefaultInfo{Groups: []string{types.GroupAuditor}}
+	if err := h.PreviewRefresh(req); !errors.As(err, &httpErr) || httpErr.Code != http.StatusForbidden {
+		t.Errorf("expected auditors to be forbidden from previewing other sources, got %v", err)
+	}
+}
+
+func TestAddSyncPreviewError(t *testing.T) {
+	var preview types.MCPCatalogSyncPreview
+
+	addSyncPreviewError(&preview, "https://example.com/a.yaml", errors.Join(
+		&mcpcatalog.EntryValidationError{EntryName: "bad", Err: errors.New("missing package")},
+		&mcpcatalog.EntryValidationError{EntryName: "worse", Err: errors.New("unsupported runtime")},
+	))
+	addSyncPreviewError(&preview, "https://example.com/b.yaml", fmt.Errorf("failed to read catalog: %w", errors.New("not found")))
+
+	if len(preview.ValidationErrors) != 2 {
+		t.Fatalf("expected 2 validation errors, got %+v", preview.ValidationErrors)
+	}
+	if preview.ValidationErrors[0].EntryName != "bad" || preview.ValidationErrors[0].Error != "missing package" {
+		t.Errorf("unexpected validation error: %+v", preview.ValidationErrors[0])
+	}
+	if len(preview.SourceErrors) != 1 || preview.SourceErrors["https://example.com/b.yaml"] != "failed to read catalog: not found" {
+		t.Errorf("unexpected source errors: %+v", preview.SourceErrors)
+	}
+}
+
+func TestPreviewRefreshSkipsPruneOnValidationErrors(t *testing.T) {
+	sourceURL := filepath.Join(t.TempDir(), "catalog.yaml")
+	if err := os.WriteFile(sourceURL, []byte("- name: broken\n  runtime: unknown\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	storage := newTestStorage(t,
+		&v1.MCPCatalog{
+			ObjectMeta: metav1.ObjectMeta{Name: system.DefaultCatalog, Namespace: system.DefaultNamespace},
+			Spec:       v1.MCPCatalogSpec{SourceURLs: []string{sourceURL}},
+		},
+		&v1.MCPServerCatalogEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "stale", Namespace: system.DefaultNamespace},
+			Spec: v1.MCPServerCatalogEntrySpec{
+				MCPCatalogName: system.DefaultCatalog,
+				SourceURL:      sourceURL,
+				Manifest:       types.MCPServerCatalogEntryManifest{Name: "stale", Runtime: types.RuntimeUVX},
+			},
+		},
+	)
+
+	req, rec := newTestContext(storage, http.MethodGet, "/api/mcp-catalogs/default/refresh-preview", nil, "catalog_id", system.DefaultCatalog)
+	if err := (&MCPCatalogHandler{}).PreviewRefresh(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var preview types.MCPCatalogSyncPreview
+	if err := json.Unmarshal(rec.Body.Bytes(), &preview); err != nil {
+		t.Fatal(err)
+	}
+	if len(preview.ValidationErrors) != 1 || preview.ValidationErrors[0].EntryName != "broken" {
+		t.Errorf("expected a validation error for the broken entry, got %+v", preview.ValidationErrors)
+	}
+	if !preview.PruneSkipped || len(preview.Removed) != 0 {
+		t.Errorf("expected no entries to be removed when the source has errors, got %+v", preview.Removed)
+	}
+}
@@ -127,6 +127,9 @@ func (h *AuditLogHandler) SubmitAuditLogs(req api.Context) error {
 		if auditLog.MCPServerDisplayName == "" {
 			auditLog.MCPServerDisplayName = auditLog.Metadata["mcpServerDisplayName"]
 		}
+		if auditLog.TraceID == "" {
+			auditLog.TraceID = traceID(auditLog.RequestHeaders, auditLog.RequestBody)
+		}
 
 		req.GatewayClient.LogMCPAuditEntry(auditLog.MCPAuditLog)
 	}
@@ -153,6 +156,7 @@ func (h *AuditLogHandler) ListAuditLogs(req api.Context) error {
 		CallType:                  parseMultiValueParam(query, "call_type"),
 		CallIdentifier:            parseMultiValueParam(query, "call_identifier"),
 		SessionID:                 parseMultiValueParam(query, "session_id"),
+		TraceID:                   parseMultiValueParam(query, "trace_id"),
 		ClientName:                parseMultiValueParam(query, "client_name"),
 		ClientVersion:             parseMultiValueParam(query, "client_version"),
 		ResponseStatus:            parseMultiValueParam(query, "response_status"),
@@ -324,6 +328,7 @@ var filterOptions = map[string]any{
 	"call_type":                     "",
 	"call_identifier":               "",
 	"session_id":                    "",
+	"trace_id":                      "",
 	"client_name":                   "",
 	"client_version":                "",
 	"response_status":               0,
@@ -351,6 +356,7 @@ func (h *AuditLogHandler) ListAuditLogFilterOptions(req api.Context) error {
 		CallType:                  parseMultiValueParam(query, "call_type"),
 		CallIdentifier:            parseMultiValueParam(query, "call_identifier"),
 		SessionID:                 parseMultiValueParam(query, "session_id"),
+		TraceID:                   parseMultiValueParam(query, "trace_id"),
 		ClientName:                parseMultiValueParam(query, "client_name"),
 		ClientVersion:             parseMultiValueParam(query, "client_version"),
 		ResponseStatus:            parseMultiValueParam(query, "response_status"),
@@ -6,6 +6,7 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -13,8 +14,11 @@ import (
 	"github.com/obot-platform/obot/pkg/api/handlers"
 	"github.com/obot-platform/obot/pkg/controller/handlers/systemmcpserver"
 	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/metrics"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -45,18 +49,47 @@ func (h *Handler) Proxy(req api.Context) error {
 		return apierrors.NewUnauthorized("user is not authenticated")
 	}
 
-	mcpURL, allowDifferentPaths, err := h.ensureServerIsDeployed(req)
+	server, err := h.ensureServerIsDeployed(req)
 	if err != nil {
 		return fmt.Errorf("failed to ensure server is deployed: %v", err)
 	}
 
-	u, err := url.Parse(mcpURL)
+	request, err := readMCPRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if rejected, err := rejectPendingToolCalls(req, request, server.pendingApprovalTools); err != nil || rejected {
+		return err
+	}
+
+	if rejected, err := applyToolArguments(req, request, server.toolArguments); err != nil || rejected {
+		return err
+	}
+	rewriteToolList := server.toolListRewriter()
+
+	u, err := url.Parse(server.url)
 	if err != nil {
 		http.Error(req.ResponseWriter, err.Error(), http.StatusInternalServerError)
 	}
 
+	var (
+		start        = time.Now()
+		method, tool = requestMethod(req.Method, request)
+		recorder     = &statusRecorder{ResponseWriter: req.ResponseWriter}
+	)
+
+	ctx, span := mcp.StartSpan(req.Context(), req.PathValue("mcp_id"), method, tool)
+	if err := injectTraceMeta(ctx, req.Request, request); err != nil {
+		mcp.EndSpan(span, http.StatusInternalServerError, false)
+		return err
+	}
+
 	(&httputil.ReverseProxy{
 		Director: func(r *http.Request) {
+			// Replace the trace headers of the client with the span of the gateway.
+			otel.GetTextMapPropagator().Inject(r.Context(), propagation.HeaderCarrier(r.Header))
+
 			r.Header.Set("X-Forwarded-Host", r.Host)
 			scheme := "https"
 			if strings.HasPrefix(r.Host, "localhost") || strings.HasPrefix(r.Host, "127.0.0.1") {
@@ -68,7 +101,7 @@ func (h *Handler) Proxy(req api.Context) error {
 			r.URL.Scheme = u.Scheme
 			r.URL.Host = u.Host
 			r.URL.Path = u.Path
-			if rest := r.PathValue("rest"); allowDifferentPaths && rest != "" {
+			if rest := r.PathValue("rest"); server.allowDifferentPaths && rest != "" {
 				if strings.HasPrefix(rest, "/") {
 					r.URL.Path = rest
 				} else {
@@ -86,45 +119,103 @@ func (h *Handler) Proxy(req api.Context) error {
 				}
 			}
 			r.URL.RawQuery = upstreamQuery.Encode()
+
+			if rewriteToolList != nil && method == "tools/list" {
+				// The tool list is rewritten, so it must not be compressed.
+				r.Header.Del("Accept-Encoding")
+			}
 		},
-	}).ServeHTTP(req.ResponseWriter, req.Request)
+		ModifyResponse: func(resp *http.Response) error {
+			if rewriteToolList == nil || method != "tools/list" {
+				inspectResponseMessages(resp, recorder.inspect)
+				return nil
+			}
+			return rewriteResponseMessages(resp, func(message []byte) []byte {
+				recorder.inspect(message)
+				return rewriteToolList(message)
+			})
+		},
+	}).ServeHTTP(recorder, req.Request.WithContext(ctx))
 
+	mcp.EndSpan(span, recorder.status(), recorder.jsonRPCError)
+	metrics.ObserveMCPRequest(req.PathValue("mcp_id"), method, recorder.status(), recorder.jsonRPCError, time.Since(start))
 	return nil
 }
 
-func (h *Handler) ensureServerIsDeployed(req api.Context) (string, bool, error) {
+// deployedServer is a server that requests are proxied to.
+type deployedServer struct {
+	url string
+	// allowDifferentPaths is true if requests may use paths other than the server's own.
+	allowDifferentPaths bool
+	// pendingApprovalTools are the tools of the server that are waiting for an admin's approval.
+	pendingApprovalTools []string
+	// toolArguments are the argument overrides of a composite server's tools, keyed by the exposed tool names.
+	toolArguments map[string][]types.ToolArgumentOverride
+}
+
+// toolListRewriter returns a function that rewrites the tools/list results of the server, or nil if they are proxied
+// unchanged. Tools waiting for an admin's approval are removed, and hidden arguments are removed from the tool schemas.
+func (s deployedServer) toolListRewriter() func([]byte) []byte {
+	hiddenArguments := hiddenToolArguments(s.toolArguments)
+	if len(s.pendingApprovalTools) == 0 && len(hiddenArguments) == 0 {
+		return nil
+	}
+
+	return func(message []byte) []byte {
+		if len(s.pendingApprovalTools) > 0 {
+			message = removePendingTools(message, s.pendingApprovalTools)
+		}
+		if len(hiddenArguments) > 0 {
+			message = hideToolArguments(message, hiddenArguments)
+		}
+		return message
+	}
+}
+
+func (h *Handler) ensureServerIsDeployed(req api.Context) (deployedServer, error) {
 	mcpID := req.PathValue("mcp_id")
 
 	if system.IsSystemMCPServerID(mcpID) {
-		return h.ensureSystemServerIsDeployed(req, mcpID)
+		mcpURL, allowDifferentPaths, err := h.ensureSystemServerIsDeployed(req, mcpID)
+		return deployedServer{url: mcpURL, allowDifferentPaths: allowDifferentPaths}, err
 	}
 
 	mcpID, mcpServer, mcpServerConfig, err := handlers.ServerForActionWithConnectID(req, mcpID)
 	if err != nil {
-		return "", false, fmt.Errorf("failed to get mcp server config: %w", err)
+		return deployedServer{}, fmt.Errorf("failed to get mcp server config: %w", err)
 	}
 
 	if mcpServer.Spec.Template {
-		return "", false, apierrors.NewNotFound(schema.GroupResource{Group: "obot.obot.ai", Resource: "mcpserver"}, mcpID)
+		return deployedServer{}, apierrors.NewNotFound(schema.GroupResource{Group: "obot.obot.ai", Resource: "mcpserver"}, mcpID)
 	}
 
 	// Add-hoc authorization for nanobot agents
 	if h.nanobotIntegrationEnabled && mcpServerConfig.NanobotAgentName != "" {
 		var agent v1.NanobotAgent
 		if err = req.Get(&agent, mcpServerConfig.NanobotAgentName); err != nil {
-			return "", false, fmt.Errorf("failed to get nanobot agent %q: %w", mcpServerConfig.NanobotAgentName, err)
+			return deployedServer{}, fmt.Errorf("failed to get nanobot agent %q: %w", mcpServerConfig.NanobotAgentName, err)
 		}
 		if agent.Spec.UserID != req.User.GetUID() {
-			return "", false, types.NewErrForbidden("user is not authorized to access nanobot agent %q", mcpServerConfig.NanobotAgentName)
+			return deployedServer{}, types.NewErrForbidden("user is not authorized to access nanobot agent %q", mcpServerConfig.NanobotAgentName)
 		}
 	}
 
 	url, err := h.mcpSessionManager.LaunchServer(req.Context(), mcpServerConfig)
 	if err != nil {
-		return "", false, fmt.Errorf("failed to launch mcp server: %w", err)
+		return deployedServer{}, fmt.Errorf("failed to launch mcp server: %w", err)
+	}
+
+	toolArguments, err := mcp.CompositeToolArguments(mcpServerConfig)
+	if err != nil {
+		return deployedServer{}, err
 	}
 
-	return url, h.nanobotIntegrationEnabled && mcpServerConfig.NanobotAgentName != "", nil
+	return deployedServer{
+		url:                  url,
+		allowDifferentPaths:  h.nanobotIntegrationEnabled && mcpServerConfig.NanobotAgentName != "",
+		pendingApprovalTools: mcpServer.Status.PendingApprovalTools,
+		toolArguments:        toolArguments,
+	}, nil
 }
 
 func (h *Handler) ensureSystemServerIsDeployed(req api.Context, mcpID string) (string, bool, error) {
@@ -0,0 +1,173 @@
+package mcpgateway
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+)
+
+// rewriteResponseMessages replaces the JSON-RPC messages of a response with the result of rewrite. Responses are either
+// a single JSON message or an event stream with a message in each data field.
+func rewriteResponseMessages(resp *http.Response, rewrite func([]byte) []byte) error {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to read response body: %w", err)
+		}
+		_ = resp.Body.Close()
+
+		body = rewrite(body)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		resp.ContentLength = int64(len(body))
+		resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	case "text/event-stream":
+		resp.Body = &eventStreamRewriter{
+			body:    resp.Body,
+			reader:  bufio.NewReader(resp.Body),
+			rewrite: rewrite,
+		}
+	}
+
+	return nil
+}
+
+// maxInspectedResponseSize limits the JSON responses that are kept to inspect them. Errors are small, so larger
+// responses are results and aren't inspected.
+const maxInspectedResponseSize = 1024 * 1024
+
+// inspectResponseMessages calls inspect with the JSON-RPC messages of a response as the response is read, without
+// changing it.
+func inspectResponseMessages(resp *http.Response, inspect func([]byte)) {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		resp.Body = &jsonBodyInspector{
+			body:    resp.Body,
+			inspect: inspect,
+		}
+	case "text/event-stream":
+		resp.Body = &eventStreamRewriter{
+			body:    resp.Body,
+			reader:  bufio.NewReader(resp.Body),
+			inspect: inspect,
+		}
+	}
+}
+
+// jsonBodyInspector keeps a copy of a JSON response as it is read, and inspects it once it is read completely.
+type jsonBodyInspector struct {
+	body    io.ReadCloser
+	inspect func([]byte)
+	data    []byte
+	done    bool
+}
+
+func (j *jsonBodyInspector) Read(p []byte) (int, error) {
+	n, err := j.body.Read(p)
+	if !j.done {
+		if len(j.data)+n > maxInspectedResponseSize {
+			j.done, j.data = true, nil
+		} else {
+			j.data = append(j.data, p[:n]...)
+		}
+	}
+	if err == io.EOF && !j.done {
+		j.done = true
+		j.inspect(j.data)
+		j.data = nil
+	}
+	return n, err
+}
+
+func (j *jsonBodyInspector) Close() error {
+	return j.body.Close()
+}
+
+// eventStreamRewriter rewrites or inspects the data fields of an event stream as the events are read, so that the
+// events are still streamed to the client.
+type eventStreamRewriter struct {
+	body    io.Closer
+	reader  *bufio.Reader
+	rewrite func([]byte) []byte
+	inspect func([]byte)
+	pending []byte
+	err     error
+}
+
+func (e *eventStreamRewriter) Read(p []byte) (int, error) {
+	for len(e.pending) == 0 {
+		if e.err != nil {
+			return 0, e.err
+		}
+
+		var line []byte
+		line, e.err = e.reader.ReadBytes('\n')
+		if data, ok := bytes.CutPrefix(line, []byte("data:")); ok && len(bytes.TrimSpace(data)) > 0 {
+			if e.inspect != nil {
+				e.inspect(bytes.TrimSpace(data))
+			}
+			if e.rewrite != nil {
+				line = append(append([]byte("data: "), e.rewrite(bytes.TrimSpace(data))...), '\n')
+			}
+		}
+		e.pending = line
+	}
+
+	n := copy(p, e.pending)
+	e.pending = e.pending[n:]
+	return n, nil
+}
+
+func (e *eventStreamRewriter) Close() error {
+	return e.body.Close()
+}
+
+// rewriteToolList replaces the tools of a tools/list result with the result of rewrite, which returns false if it
+// didn't change the tools. The message is returned unchanged if it isn't a tools/list result.
+func rewriteToolList(message []byte, rewrite func([]map[string]json.RawMessage) ([]map[string]json.RawMessage, bool)) []byte {
+	var response map[string]json.RawMessage
+	if err := json.Unmarshal(message, &response); err != nil {
+		return message
+	}
+
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal(response["result"], &result); err != nil {
+		return message
+	}
+
+	var tools []map[string]json.RawMessage
+	if err := json.Unmarshal(result["tools"], &tools); err != nil {
+		return message
+	}
+
+	tools, changed := rewrite(tools)
+	if !changed {
+		return message
+	}
+
+	var err error
+	if result["tools"], err = json.Marshal(tools); err != nil {
+		return message
+	}
+	if response["result"], err = json.Marshal(result); err != nil {
+		return message
+	}
+	if rewritten, err := json.Marshal(response); err == nil {
+		return rewritten
+	}
+	return message
+}
+
+// toolName returns the name of a tool in a tools/list result.
+func toolName(tool map[string]json.RawMessage) string {
+	var name string
+	_ = json.Unmarshal(tool["name"], &name)
+	return name
+}
@@ -0,0 +1,114 @@
+package mcpgateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// mcpMethods are the JSON-RPC methods that MCP clients send to servers. Requests with other methods are labeled as
+// other, so that clients can't create arbitrary label values.
+var mcpMethods = map[string]bool{
+	"initialize":                       true,
+	"ping":                             true,
+	"tools/list":                       true,
+	"tools/call":                       true,
+	"resources/list":                   true,
+	"resources/templates/list":         true,
+	"resources/read":                   true,
+	"resources/subscribe":              true,
+	"resources/unsubscribe":            true,
+	"prompts/list":                     true,
+	"prompts/get":                      true,
+	"completion/complete":              true,
+	"logging/setLevel":                 true,
+	"notifications/initialized":        true,
+	"notifications/cancelled":          true,
+	"notifications/progress":           true,
+	"notifications/roots/list_changed": true,
+}
+
+// requestMethod returns the method that metrics and traces label a request with and, for tool calls, the name of the
+// tool. The method is the JSON-RPC method of a message, batch for batches, response for responses to requests of the
+// server, and the HTTP method for requests without a body, like event streams.
+func requestMethod(httpMethod string, request *mcpRequest) (method, tool string) {
+	switch {
+	case request.body == nil:
+		return httpMethod, ""
+	case request.batch:
+		return "batch", ""
+	case len(request.messages) == 0:
+		return "other", ""
+	}
+
+	switch message := request.messages[0]; {
+	case message.Method == "":
+		return "response", ""
+	case message.Method == "tools/call":
+		return message.Method, message.toolName()
+	case mcpMethods[message.Method]:
+		return message.Method, ""
+	default:
+		return "other", ""
+	}
+}
+
+// hasJSONRPCError returns true if a JSON-RPC message, or any message of a batch, is an error response.
+func hasJSONRPCError(message []byte) bool {
+	var responses []struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if message = bytes.TrimSpace(message); !bytes.HasPrefix(message, []byte("[")) {
+		message = append(append([]byte("["), message...), ']')
+	}
+	if err := json.Unmarshal(message, &responses); err != nil {
+		return false
+	}
+	for _, response := range responses {
+		if len(response.Error) > 0 && !bytes.Equal(response.Error, []byte("null")) {
+			return true
+		}
+	}
+	return false
+}
+
+// statusRecorder records the status code of a response and whether it returned a JSON-RPC error. It supports
+// flushing, which event streams need.
+type statusRecorder struct {
+	http.ResponseWriter
+	code         int
+	jsonRPCError bool
+}
+
+// inspect records whether a JSON-RPC message of the response is an error. Errors are returned with status 200, or in
+// event streams.
+func (s *statusRecorder) inspect(message []byte) {
+	if !s.jsonRPCError && hasJSONRPCError(message) {
+		s.jsonRPCError = true
+	}
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	if s.code == 0 {
+		s.code = code
+	}
+	s.ResponseWriter.WriteHeader(code)
+}
+
+func (s *statusRecorder) Write(b []byte) (int, error) {
+	if s.code == 0 {
+		s.code = http.StatusOK
+	}
+	return s.ResponseWriter.Write(b)
+}
+
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
+
+func (s *statusRecorder) status() int {
+	if s.code == 0 {
+		return http.StatusOK
+	}
+	return s.code
+}
@@ -0,0 +1,167 @@
+package mcpgateway
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+)
+
+func TestRequestMethod(t *testing.T) {
+	tests := []struct {
+		name         string
+		httpMethod   string
+		body         string
+		expectMethod string
+		expectTool   string
+	}{
+		{
+			name:         "event stream",
+			httpMethod:   http.MethodGet,
+			expectMethod: http.MethodGet,
+		},
+		{
+			name:         "tool call",
+			httpMethod:   http.MethodPost,
+			body:         `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search"}}`,
+			expectMethod: "tools/call",
+			expectTool:   "search",
+		},
+		{
+			name:         "known method",
+			httpMethod:   http.MethodPost,
+			body:         `{"jsonrpc":"2.0","id":1,"method":"tools/list"}`,
+			expectMethod: "tools/list",
+		},
+		{
+			name:         "unknown method",
+			httpMethod:   http.MethodPost,
+			body:         `{"jsonrpc":"2.0","id":1,"method":"custom/anything"}`,
+			expectMethod: "other",
+		},
+		{
+			name:         "response",
+			httpMethod:   http.MethodPost,
+			body:         `{"jsonrpc":"2.0","id":1,"result":{}}`,
+			expectMethod: "response",
+		},
+		{
+			name:         "batch",
+			httpMethod:   http.MethodPost,
+			body:         ` [{"jsonrpc":"2.0","id":1,"method":"tools/list"}]`,
+			expectMethod: "batch",
+		},
+		{
+			name:         "not JSON",
+			httpMethod:   http.MethodPost,
+			body:         `hello`,
+			expectMethod: "other",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := api.Context{
+				ResponseWriter: httptest.NewRecorder(),
+				Request:        httptest.NewRequest(tt.httpMethod, "/mcp-connect/ms1", strings.NewReader(tt.body)),
+			}
+			request, err := readMCPRequest(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			method, tool := requestMethod(tt.httpMethod, request)
+			if method != tt.expectMethod || tool != tt.expectTool {
+				t.Errorf("expected method %q and tool %q, got %q and %q", tt.expectMethod, tt.expectTool, method, tool)
+			}
+
+			if tt.httpMethod == http.MethodPost {
+				body, err := io.ReadAll(req.Request.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(body) != tt.body {
+					t.Errorf("expected the body to be restored, got %s", body)
+				}
+			}
+		})
+	}
+}
+
+func TestReadMCPRequestLimitsBody(t *testing.T) {
+	req := api.Context{
+		ResponseWriter: httptest.NewRecorder(),
+		Request:        httptest.NewRequest(http.MethodPost, "/mcp-connect/ms1", strings.NewReader(strings.Repeat(" ", maxRequestBodySize+1))),
+	}
+
+	var httpErr *types.ErrHTTP
+	if _, err := readMCPRequest(req); !errors.As(err, &httpErr) || httpErr.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected a request entity too large error, got %v", err)
+	}
+}
+
+func TestInspectResponseMessages(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		expectError bool
+	}{
+		{
+			name:        "result",
+			contentType: "application/json",
+			body:        `{"jsonrpc":"2.0","id":1,"result":{"content":[]}}`,
+		},
+		{
+			name:        "error",
+			contentType: "application/json",
+			body:        `{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"unknown tool"}}`,
+			expectError: true,
+		},
+		{
+			name:        "error in a batch",
+			contentType: "application/json",
+			body:        `[{"jsonrpc":"2.0","id":1,"result":{}},{"jsonrpc":"2.0","id":2,"error":{"code":-32603,"message":"failed"}}]`,
+			expectError: true,
+		},
+		{
+			name:        "error in an event stream",
+			contentType: "text/event-stream",
+			body:        "event: message\ndata: {\"jsonrpc\":\"2.0\",\"method\":\"notifications/progress\"}\n\nevent: message\ndata:{\"jsonrpc\":\"2.0\",\"id\":1,\"error\":{\"code\":-32603,\"message\":\"failed\"}}\n\n",
+			expectError: true,
+		},
+		{
+			name:        "null error",
+			contentType: "text/event-stream",
+			body:        "data: {\"jsonrpc\":\"2.0\",\"id\":1,\"result\":{},\"error\":null}\n\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{
+				Header: http.Header{"Content-Type": []string{tt.contentType}},
+				Body:   io.NopCloser(strings.NewReader(tt.body)),
+			}
+			recorder := &statusRecorder{ResponseWriter: httptest.NewRecorder()}
+
+			inspectResponseMessages(resp, recorder.inspect)
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(body) != tt.body {
+				t.Errorf("expected the response to be unchanged, got %q", body)
+			}
+			if recorder.jsonRPCError != tt.expectError {
+				t.Errorf("expected a JSON-RPC error to be %v, got %v", tt.expectError, recorder.jsonRPCError)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package mcpgateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/obot-platform/obot/pkg/api"
+)
+
+// maxRequestBodySize limits the bodies of requests to MCP servers, which the gateway reads to inspect them before they
+// are proxied.
+const maxRequestBodySize = 32 * 1024 * 1024
+
+// mcpRequest is the body of a request to an MCP server. It is read and parsed once, and shared by the checks of the
+// gateway.
+type mcpRequest struct {
+	body  []byte
+	batch bool
+	// messages are the JSON-RPC messages of the body. It is empty if the request has no body or the body isn't
+	// JSON-RPC.
+	messages []jsonRPCMessage
+}
+
+type jsonRPCMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// toolName returns the name of the tool that a tools/call message calls.
+func (m jsonRPCMessage) toolName() string {
+	if m.Method != "tools/call" {
+		return ""
+	}
+	var params struct {
+		Name string `json:"name"`
+	}
+	_ = json.Unmarshal(m.Params, &params)
+	return params.Name
+}
+
+// readMCPRequest reads and parses the body of a POST request, and restores it so that it can still be proxied.
+func readMCPRequest(req api.Context) (*mcpRequest, error) {
+	if req.Method != http.MethodPost || req.Request.Body == nil {
+		return &mcpRequest{}, nil
+	}
+
+	body, err := req.Body(api.BodyOptions{MaxBytes: maxRequestBodySize})
+	if err != nil {
+		return nil, err
+	}
+
+	request := &mcpRequest{}
+	request.setBody(req.Request, body)
+
+	if request.batch = bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")); request.batch {
+		err = json.Unmarshal(body, &request.messages)
+	} else {
+		request.messages = make([]jsonRPCMessage, 1)
+		err = json.Unmarshal(body, &request.messages[0])
+	}
+	if err != nil {
+		// Not JSON-RPC, let the server handle it.
+		request.messages = nil
+	}
+	return request, nil
+}
+
+// setBody replaces the body of the request that is proxied.
+func (m *mcpRequest) setBody(r *http.Request, body []byte) {
+	m.body = body
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	r.ContentLength = int64(len(body))
+	r.Header.Del("Content-Length")
+}
+
+// writeJSONRPCErrors responds to a request with an invalid params error for each of its messages. A batch is rejected
+// entirely, because its responses can't be mixed with the server's.
+func writeJSONRPCErrors(req api.Context, request *mcpRequest, message string) error {
+	responses := make([]map[string]any, 0, len(request.messages))
+	for _, m := range request.messages {
+		if request.batch && len(m.ID) == 0 {
+			// Notifications in a batch don't get responses.
+			continue
+		}
+		responses = append(responses, map[string]any{
+			"jsonrpc": "2.0",
+			"id":      m.ID,
+			"error": map[string]any{
+				"code":    -32602,
+				"message": message,
+			},
+		})
+	}
+
+	req.ResponseWriter.Header().Set("Content-Type", "application/json")
+	if !request.batch {
+		return json.NewEncoder(req.ResponseWriter).Encode(responses[0])
+	}
+	return json.NewEncoder(req.ResponseWriter).Encode(responses)
+}
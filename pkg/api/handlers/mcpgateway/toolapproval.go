@@ -0,0 +1,42 @@
+package mcpgateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/obot-platform/obot/pkg/api"
+)
+
+// rejectPendingToolCalls responds with a JSON-RPC error if the request calls a tool that is waiting for an admin's
+// approval. A batch that calls such a tool is rejected entirely, with an error for each of its requests. It returns
+// true if the request was rejected.
+func rejectPendingToolCalls(req api.Context, request *mcpRequest, pendingApprovalTools []string) (bool, error) {
+	if len(pendingApprovalTools) == 0 {
+		return false, nil
+	}
+
+	var pendingTool string
+	for _, message := range request.messages {
+		if name := message.toolName(); name != "" && slices.Contains(pendingApprovalTools, name) {
+			pendingTool = name
+			break
+		}
+	}
+	if pendingTool == "" {
+		return false, nil
+	}
+
+	return true, writeJSONRPCErrors(req, request, fmt.Sprintf("tool %s is disabled until an administrator approves it", pendingTool))
+}
+
+// removePendingTools removes the tools that are waiting for an admin's approval from a tools/list result.
+func removePendingTools(message []byte, pendingApprovalTools []string) []byte {
+	return rewriteToolList(message, func(tools []map[string]json.RawMessage) ([]map[string]json.RawMessage, bool) {
+		before := len(tools)
+		tools = slices.DeleteFunc(tools, func(tool map[string]json.RawMessage) bool {
+			return slices.Contains(pendingApprovalTools, toolName(tool))
+		})
+		return tools, len(tools) != before
+	})
+}
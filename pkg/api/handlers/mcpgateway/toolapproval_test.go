@@ -0,0 +1,134 @@
+package mcpgateway
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/pkg/api"
+)
+
+func TestRejectPendingToolCalls(t *testing.T) {
+	pending := []string{"delete_repo"}
+
+	tests := []struct {
+		name           string
+		body           string
+		expectRejected bool
+		expectBatch    bool
+		expectIDs      []int
+	}{
+		{
+			name:           "call to a pending tool",
+			body:           `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"delete_repo"}}`,
+			expectRejected: true,
+			expectIDs:      []int{1},
+		},
+		{
+			name: "call to an approved tool",
+			body: `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search"}}`,
+		},
+		{
+			name: "other methods",
+			body: `{"jsonrpc":"2.0","id":1,"method":"tools/list"}`,
+		},
+		{
+			name: "batch with a call to a pending tool",
+			body: `[{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search"}},` +
+				`{"jsonrpc":"2.0","method":"notifications/progress"},` +
+				`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"delete_repo"}}]`,
+			expectRejected: true,
+			expectBatch:    true,
+			expectIDs:      []int{1, 2},
+		},
+		{
+			name: "batch without calls to pending tools",
+			body: `[{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search"}},{"jsonrpc":"2.0","id":2,"method":"tools/list"}]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := api.Context{
+				ResponseWriter: rec,
+				Request:        httptest.NewRequest(http.MethodPost, "/mcp-connect/ms1", strings.NewReader(tt.body)),
+			}
+
+			request, err := readMCPRequest(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rejected, err := rejectPendingToolCalls(req, request, pending)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rejected != tt.expectRejected {
+				t.Fatalf("expected rejected to be %v, got %v", tt.expectRejected, rejected)
+			}
+
+			if !rejected {
+				body, err := io.ReadAll(req.Request.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(body) != tt.body {
+					t.Errorf("expected the body to be restored, got %s", body)
+				}
+				return
+			}
+
+			var responses []struct {
+				ID    int `json:"id"`
+				Error struct {
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+			data := rec.Body.Bytes()
+			if !tt.expectBatch {
+				data = append(append([]byte("["), data...), ']')
+			}
+			if err := json.Unmarshal(data, &responses); err != nil {
+				t.Fatalf("failed to unmarshal response %s: %v", rec.Body.String(), err)
+			}
+			if len(responses) != len(tt.expectIDs) {
+				t.Fatalf("expected responses for %v, got %s", tt.expectIDs, rec.Body.String())
+			}
+			for i, id := range tt.expectIDs {
+				if responses[i].ID != id || !strings.Contains(responses[i].Error.Message, "delete_repo") {
+					t.Errorf("expected an error for request %d, got %+v", id, responses[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRemovePendingTools(t *testing.T) {
+	message := []byte(`{"jsonrpc":"2.0","id":2,"result":{"tools":[{"name":"delete_repo"},{"name":"search"}],"nextCursor":"abc"}}`)
+
+	var result struct {
+		Result struct {
+			Tools []struct {
+				Name string `json:"name"`
+			} `json:"tools"`
+			NextCursor string `json:"nextCursor"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(removePendingTools(message, []string{"delete_repo"}), &result); err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Result.Tools) != 1 || result.Result.Tools[0].Name != "search" {
+		t.Errorf("expected only the search tool to be listed, got %+v", result.Result.Tools)
+	}
+	if result.Result.NextCursor != "abc" {
+		t.Errorf("expected the rest of the result to be preserved, got %+v", result.Result)
+	}
+
+	if unchanged := removePendingTools(message, []string{"other"}); string(unchanged) != string(message) {
+		t.Errorf("expected the message to be unchanged, got %s", unchanged)
+	}
+}
@@ -0,0 +1,159 @@
+package mcpgateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+)
+
+// applyToolArguments fills in the arguments of the tool calls in a request from the argument overrides of the composite
+// server's tools. Each call of a batch is filled in. Hidden arguments are always set to their default, or removed if
+// they have none, and other defaults are only used when the caller doesn't provide the argument. The request body is
+// replaced if a call changes. Calls whose arguments aren't an object are rejected with a JSON-RPC error, and it returns
+// true if the request was rejected.
+func applyToolArguments(req api.Context, request *mcpRequest, toolArguments map[string][]types.ToolArgumentOverride) (bool, error) {
+	if len(toolArguments) == 0 || !slices.ContainsFunc(request.messages, func(message jsonRPCMessage) bool {
+		return len(toolArguments[message.toolName()]) > 0
+	}) {
+		return false, nil
+	}
+
+	var (
+		messages []map[string]json.RawMessage
+		err      error
+	)
+	if request.batch {
+		err = json.Unmarshal(request.body, &messages)
+	} else {
+		messages = make([]map[string]json.RawMessage, 1)
+		err = json.Unmarshal(request.body, &messages[0])
+	}
+	if err != nil || len(messages) != len(request.messages) {
+		return true, writeJSONRPCErrors(req, request, "invalid tool call")
+	}
+
+	for i, message := range request.messages {
+		name := message.toolName()
+		overrides := toolArguments[name]
+		if len(overrides) == 0 {
+			continue
+		}
+
+		params, err := applyToolCallArguments(message.Params, overrides)
+		if err != nil {
+			return true, writeJSONRPCErrors(req, request, fmt.Sprintf("invalid call of tool %s: %v", name, err))
+		}
+		messages[i]["params"] = params
+	}
+
+	var body []byte
+	if request.batch {
+		body, err = json.Marshal(messages)
+	} else {
+		body, err = json.Marshal(messages[0])
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal tool call: %w", err)
+	}
+
+	request.setBody(req.Request, body)
+	return false, nil
+}
+
+// applyToolCallArguments returns the params of a tools/call message with the argument overrides applied.
+func applyToolCallArguments(rawParams json.RawMessage, overrides []types.ToolArgumentOverride) (json.RawMessage, error) {
+	var params map[string]json.RawMessage
+	if err := json.Unmarshal(rawParams, &params); err != nil {
+		return nil, fmt.Errorf("params must be an object")
+	}
+
+	var arguments map[string]json.RawMessage
+	if raw := params["arguments"]; len(raw) > 0 && !bytes.Equal(raw, []byte("null")) {
+		if err := json.Unmarshal(raw, &arguments); err != nil {
+			return nil, fmt.Errorf("arguments must be an object")
+		}
+	}
+	if arguments == nil {
+		arguments = make(map[string]json.RawMessage, len(overrides))
+	}
+
+	for _, override := range overrides {
+		if _, ok := arguments[override.Name]; ok && !override.Hidden {
+			continue
+		}
+		if !override.HasDefault() {
+			// Callers can't set hidden arguments, even if they have no default.
+			delete(arguments, override.Name)
+			continue
+		}
+		arguments[override.Name] = override.Default
+	}
+
+	var err error
+	if params["arguments"], err = json.Marshal(arguments); err != nil {
+		return nil, fmt.Errorf("failed to marshal tool arguments: %w", err)
+	}
+	return json.Marshal(params)
+}
+
+// hiddenToolArguments returns the names of the hidden arguments of each tool that has any.
+func hiddenToolArguments(toolArguments map[string][]types.ToolArgumentOverride) map[string][]string {
+	var hidden map[string][]string
+	for tool, overrides := range toolArguments {
+		for _, override := range overrides {
+			if !override.Hidden {
+				continue
+			}
+			if hidden == nil {
+				hidden = make(map[string][]string)
+			}
+			hidden[tool] = append(hidden[tool], override.Name)
+		}
+	}
+	return hidden
+}
+
+// hideToolArguments removes the hidden arguments from the input schemas of the tools in a tools/list result.
+func hideToolArguments(message []byte, hidden map[string][]string) []byte {
+	return rewriteToolList(message, func(tools []map[string]json.RawMessage) ([]map[string]json.RawMessage, bool) {
+		var changed bool
+		for _, tool := range tools {
+			arguments := hidden[toolName(tool)]
+			if len(arguments) == 0 {
+				continue
+			}
+
+			var schema map[string]json.RawMessage
+			if err := json.Unmarshal(tool["inputSchema"], &schema); err != nil {
+				continue
+			}
+
+			var (
+				properties map[string]json.RawMessage
+				required   []string
+			)
+			_ = json.Unmarshal(schema["properties"], &properties)
+			_ = json.Unmarshal(schema["required"], &required)
+			for _, argument := range arguments {
+				delete(properties, argument)
+			}
+			required = slices.DeleteFunc(required, func(argument string) bool {
+				return slices.Contains(arguments, argument)
+			})
+
+			if properties != nil {
+				schema["properties"], _ = json.Marshal(properties)
+			}
+			if schema["required"] != nil {
+				schema["required"], _ = json.Marshal(required)
+			}
+			tool["inputSchema"], _ = json.Marshal(schema)
+			changed = true
+		}
+		return tools, changed
+	})
+}
@@ -0,0 +1,203 @@
+package mcpgateway
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+)
+
+var testToolArguments = map[string][]types.ToolArgumentOverride{
+	"list_issues": {
+		{Name: "owner", Default: json.RawMessage(`"our-org"`), Hidden: true},
+		{Name: "state", Default: json.RawMessage(`"open"`)},
+		{Name: "limit", Default: json.RawMessage(`10`)},
+		{Name: "token", Hidden: true},
+	},
+}
+
+func TestApplyToolArguments(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		expectArg map[string]any
+		rejected  bool
+	}{
+		{
+			name:      "defaults fill in missing arguments",
+			body:      `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"list_issues"}}`,
+			expectArg: map[string]any{"owner": "our-org", "state": "open", "limit": float64(10)},
+		},
+		{
+			name:      "callers can't override hidden arguments",
+			body:      `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"list_issues","arguments":{"owner":"someone-else","state":"closed","limit":5}}}`,
+			expectArg: map[string]any{"owner": "our-org", "state": "closed", "limit": float64(5)},
+		},
+		{
+			name:      "calls in batches are filled in",
+			body:      `[{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"list_issues","arguments":{"owner":"someone-else"}}},{"jsonrpc":"2.0","method":"notifications/initialized"}]`,
+			expectArg: map[string]any{"owner": "our-org", "state": "open", "limit": float64(10)},
+		},
+		{
+			name:      "hidden arguments without a default are removed",
+			body:      `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"list_issues","arguments":{"token":"secret"}}}`,
+			expectArg: map[string]any{"owner": "our-org", "state": "open", "limit": float64(10)},
+		},
+		{
+			name:     "arguments that aren't an object are rejected",
+			body:     `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"list_issues","arguments":"owner=someone-else"}}`,
+			rejected: true,
+		},
+		{
+			name: "other tools are unchanged",
+			body: `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search","arguments":{"query":"bug"}}}`,
+		},
+		{
+			name: "other methods are unchanged",
+			body: `{"jsonrpc":"2.0","id":1,"method":"tools/list"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/mcp-connect/ms1", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			req := api.Context{ResponseWriter: rec, Request: r}
+			request, err := readMCPRequest(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rejected, err := applyToolArguments(req, request, testToolArguments)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rejected != tt.rejected {
+				t.Fatalf("expected rejected=%v, got %v", tt.rejected, rejected)
+			}
+			if rejected {
+				if !strings.Contains(rec.Body.String(), `"code":-32602`) {
+					t.Errorf("expected a JSON-RPC error, got %s", rec.Body.String())
+				}
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.expectArg == nil {
+				if string(body) != tt.body {
+					t.Errorf("expected the body to be unchanged, got %s", body)
+				}
+				return
+			}
+
+			type toolCall struct {
+				ID     int `json:"id"`
+				Params struct {
+					Name      string         `json:"name"`
+					Arguments map[string]any `json:"arguments"`
+				} `json:"params"`
+			}
+			var call toolCall
+			if strings.HasPrefix(tt.body, "[") {
+				var batch []toolCall
+				if err := json.Unmarshal(body, &batch); err != nil {
+					t.Fatal(err)
+				}
+				if len(batch) != 2 {
+					t.Fatalf("expected the batch to keep its messages, got %s", body)
+				}
+				call = batch[0]
+			} else if err := json.Unmarshal(body, &call); err != nil {
+				t.Fatal(err)
+			}
+			if call.ID != 1 || call.Params.Name != "list_issues" {
+				t.Errorf("expected the rest of the call to be unchanged, got %s", body)
+			}
+			if len(call.Params.Arguments) != len(tt.expectArg) {
+				t.Errorf("expected arguments %v, got %v", tt.expectArg, call.Params.Arguments)
+			}
+			for name, value := range tt.expectArg {
+				if call.Params.Arguments[name] != value {
+					t.Errorf("expected argument %s to be %v, got %v", name, value, call.Params.Arguments[name])
+				}
+			}
+			if r.ContentLength != int64(len(body)) {
+				t.Errorf("expected the content length to be %d, got %d", len(body), r.ContentLength)
+			}
+		})
+	}
+}
+
+const testToolList = `{"jsonrpc":"2.0","id":2,"result":{"tools":[` +
+	`{"name":"list_issues","inputSchema":{"type":"object","properties":{"owner":{"type":"string"},"state":{"type":"string"}},"required":["owner"]}},` +
+	`{"name":"search","inputSchema":{"type":"object","properties":{"owner":{"type":"string"}}}}]}}`
+
+func TestHideToolArguments(t *testing.T) {
+	hidden := hiddenToolArguments(testToolArguments)
+
+	for _, contentType := range []string{"application/json", "text/event-stream"} {
+		t.Run(contentType, func(t *testing.T) {
+			body := testToolList
+			if contentType == "text/event-stream" {
+				body = "event: message\ndata: " + testToolList + "\n\n"
+			}
+
+			resp := &http.Response{
+				Header: http.Header{"Content-Type": []string{contentType}},
+				Body:   io.NopCloser(strings.NewReader(body)),
+			}
+			if err := rewriteResponseMessages(resp, func(message []byte) []byte {
+				return hideToolArguments(message, hidden)
+			}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			data, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if contentType == "text/event-stream" {
+				if !strings.HasPrefix(string(data), "event: message\ndata: ") || !strings.HasSuffix(string(data), "\n\n") {
+					t.Fatalf("expected the event to be preserved, got %q", data)
+				}
+				data = []byte(strings.TrimSpace(strings.TrimPrefix(string(data), "event: message\ndata: ")))
+			}
+
+			var result struct {
+				Result struct {
+					Tools []struct {
+						Name        string `json:"name"`
+						InputSchema struct {
+							Properties map[string]any `json:"properties"`
+							Required   []string       `json:"required"`
+						} `json:"inputSchema"`
+					} `json:"tools"`
+				} `json:"result"`
+			}
+			if err := json.Unmarshal(data, &result); err != nil {
+				t.Fatalf("failed to unmarshal %s: %v", data, err)
+			}
+			if len(result.Result.Tools) != 2 {
+				t.Fatalf("expected 2 tools, got %s", data)
+			}
+
+			listIssues := result.Result.Tools[0].InputSchema
+			if _, ok := listIssues.Properties["owner"]; ok || len(listIssues.Required) != 0 {
+				t.Errorf("expected the owner argument of list_issues to be hidden, got %s", data)
+			}
+			if _, ok := listIssues.Properties["state"]; !ok {
+				t.Errorf("expected the state argument of list_issues to be exposed, got %s", data)
+			}
+			if _, ok := result.Result.Tools[1].InputSchema.Properties["owner"]; !ok {
+				t.Errorf("expected the owner argument of search to be exposed, got %s", data)
+			}
+		})
+	}
+}
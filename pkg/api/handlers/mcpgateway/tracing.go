@@ -0,0 +1,150 @@
+package mcpgateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+
+	"github.com/obot-platform/obot/pkg/mcp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// injectTraceMeta adds the trace of ctx to the _meta of the JSON-RPC requests and notifications of the request, so that
+// MCP servers behind the nanobot shim, which doesn't forward the traceparent header, can continue the trace. Existing
+// _meta fields are kept.
+func injectTraceMeta(ctx context.Context, r *http.Request, request *mcpRequest) error {
+	meta := mcp.TraceMeta(ctx)
+	if len(meta) == 0 || len(request.messages) == 0 {
+		return nil
+	}
+
+	var messages []map[string]json.RawMessage
+	if request.batch {
+		if err := json.Unmarshal(request.body, &messages); err != nil {
+			return nil
+		}
+	} else {
+		messages = make([]map[string]json.RawMessage, 1)
+		if err := json.Unmarshal(request.body, &messages[0]); err != nil {
+			return nil
+		}
+	}
+
+	var changed bool
+	for _, message := range messages {
+		if _, ok := message["method"]; !ok {
+			// Responses to requests of the server don't have _meta.
+			continue
+		}
+
+		var params map[string]json.RawMessage
+		if raw := message["params"]; len(raw) > 0 && !bytes.Equal(raw, []byte("null")) {
+			if err := json.Unmarshal(raw, &params); err != nil {
+				continue
+			}
+		}
+		if params == nil {
+			params = make(map[string]json.RawMessage, 1)
+		}
+
+		var messageMeta map[string]any
+		if raw := params["_meta"]; len(raw) > 0 && !bytes.Equal(raw, []byte("null")) {
+			if err := json.Unmarshal(raw, &messageMeta); err != nil {
+				continue
+			}
+		}
+		if messageMeta == nil {
+			messageMeta = make(map[string]any, len(meta))
+		}
+		maps.Copy(messageMeta, meta)
+
+		var err error
+		if params["_meta"], err = json.Marshal(messageMeta); err != nil {
+			return fmt.Errorf("failed to marshal _meta: %w", err)
+		}
+		if message["params"], err = json.Marshal(params); err != nil {
+			return fmt.Errorf("failed to marshal params: %w", err)
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	var (
+		body []byte
+		err  error
+	)
+	if request.batch {
+		body, err = json.Marshal(messages)
+	} else {
+		body, err = json.Marshal(messages[0])
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	request.setBody(r, body)
+	return nil
+}
+
+// traceID returns the ID of the trace that an MCP request is part of, from its traceparent header or, for requests
+// that Obot makes to run tools, from the _meta of the request. It is empty if the request has no valid trace.
+func traceID(requestHeaders, requestBody json.RawMessage) string {
+	traceparent := headerValue(requestHeaders, "traceparent")
+	if traceparent == "" && len(requestBody) > 0 {
+		var request struct {
+			Params struct {
+				Meta struct {
+					Traceparent string `json:"traceparent"`
+				} `json:"_meta"`
+			} `json:"params"`
+		}
+		if json.Unmarshal(requestBody, &request) == nil {
+			traceparent = request.Params.Meta.Traceparent
+		}
+	}
+	if traceparent == "" {
+		return ""
+	}
+
+	ctx := propagation.TraceContext{}.Extract(context.Background(), propagation.MapCarrier{"traceparent": traceparent})
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		return spanContext.TraceID().String()
+	}
+	return ""
+}
+
+// headerValue returns the value of a header from the headers that the nanobot shim records, which map header names to
+// a value or a list of values.
+func headerValue(headers json.RawMessage, name string) string {
+	if len(headers) == 0 {
+		return ""
+	}
+
+	var values map[string]any
+	if err := json.Unmarshal(headers, &values); err != nil {
+		return ""
+	}
+
+	for key, value := range values {
+		if !strings.EqualFold(key, name) {
+			continue
+		}
+		switch v := value.(type) {
+		case string:
+			return v
+		case []any:
+			if len(v) > 0 {
+				s, _ := v[0].(string)
+				return s
+			}
+		}
+	}
+	return ""
+}
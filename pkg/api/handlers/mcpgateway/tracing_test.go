@@ -0,0 +1,116 @@
+package mcpgateway
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/pkg/api"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestInjectTraceMeta(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "gateway")
+	defer span.End()
+
+	tests := []struct {
+		name        string
+		body        string
+		expectMetas []map[string]string
+	}{
+		{
+			name:        "request without params",
+			body:        `{"jsonrpc":"2.0","id":1,"method":"tools/list"}`,
+			expectMetas: []map[string]string{{}},
+		},
+		{
+			name:        "request with _meta",
+			body:        `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"search","_meta":{"progressToken":"abc","traceparent":"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"}}}`,
+			expectMetas: []map[string]string{{"progressToken": "abc"}},
+		},
+		{
+			name:        "batch with a response",
+			body:        `[{"jsonrpc":"2.0","id":1,"result":{}},{"jsonrpc":"2.0","method":"notifications/initialized"}]`,
+			expectMetas: []map[string]string{nil, {}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := api.Context{
+				ResponseWriter: httptest.NewRecorder(),
+				Request:        httptest.NewRequest(http.MethodPost, "/mcp-connect/ms1", strings.NewReader(tt.body)),
+			}
+			request, err := readMCPRequest(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := injectTraceMeta(ctx, req.Request, request); err != nil {
+				t.Fatal(err)
+			}
+
+			body, err := io.ReadAll(req.Request.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(tt.body, "[") {
+				body = append(append([]byte("["), body...), ']')
+			}
+
+			var messages []struct {
+				Params struct {
+					Meta map[string]string `json:"_meta"`
+				} `json:"params"`
+			}
+			if err := json.Unmarshal(body, &messages); err != nil {
+				t.Fatalf("failed to unmarshal %s: %v", body, err)
+			}
+			if len(messages) != len(tt.expectMetas) {
+				t.Fatalf("expected %d messages, got %s", len(tt.expectMetas), body)
+			}
+
+			for i, expected := range tt.expectMetas {
+				meta := messages[i].Params.Meta
+				if expected == nil {
+					if meta != nil {
+						t.Errorf("expected no _meta in message %d, got %v", i, meta)
+					}
+					continue
+				}
+				if traceID(nil, json.RawMessage(`{"params":{"_meta":{"traceparent":"`+meta["traceparent"]+`"}}}`)) != span.SpanContext().TraceID().String() {
+					t.Errorf("expected the trace of the gateway in message %d, got %v", i, meta)
+				}
+				for key, value := range expected {
+					if meta[key] != value {
+						t.Errorf("expected %s to be kept in message %d, got %v", key, i, meta)
+					}
+				}
+			}
+		})
+	}
+
+	// Without a trace, the body isn't rewritten.
+	req := api.Context{
+		ResponseWriter: httptest.NewRecorder(),
+		Request:        httptest.NewRequest(http.MethodPost, "/mcp-connect/ms1", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)),
+	}
+	request, err := readMCPRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := injectTraceMeta(context.Background(), req.Request, request); err != nil {
+		t.Fatal(err)
+	}
+	if body, _ := io.ReadAll(req.Request.Body); string(body) != `{"jsonrpc":"2.0","id":1,"method":"tools/list"}` {
+		t.Errorf("expected the body to be unchanged, got %s", body)
+	}
+}
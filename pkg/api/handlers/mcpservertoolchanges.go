@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// GetToolChanges returns the tool changes detected on a multi-user server and the tools waiting for approval.
+func (m *MCPHandler) GetToolChanges(req api.Context) error {
+	server, err := multiUserServerInScope(req)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(convertToolChanges(server))
+}
+
+// ApproveTools enables tools that were disabled after they newly appeared on a multi-user server.
+func (m *MCPHandler) ApproveTools(req api.Context) error {
+	server, err := multiUserServerInScope(req)
+	if err != nil {
+		return err
+	}
+
+	var approval types.MCPServerToolApprovalRequest
+	if err := req.Read(&approval); err != nil {
+		return types.NewErrBadRequest("failed to read tool approval: %v", err)
+	}
+	if len(approval.Tools) == 0 {
+		return types.NewErrBadRequest("at least one tool is required")
+	}
+
+	for _, tool := range approval.Tools {
+		if !slices.Contains(server.Status.PendingApprovalTools, tool) {
+			return types.NewErrBadRequest("tool %s is not waiting for approval", tool)
+		}
+	}
+
+	server.Status.PendingApprovalTools = slices.DeleteFunc(server.Status.PendingApprovalTools, func(tool string) bool {
+		return slices.Contains(approval.Tools, tool)
+	})
+	if err := req.Storage.Status().Update(req.Context(), &server); err != nil {
+		return fmt.Errorf("failed to approve tools: %w", err)
+	}
+
+	return req.Write(convertToolChanges(server))
+}
+
+// multiUserServerInScope returns the multi-user server from the request path, ensuring that it belongs to the catalog
+// or the workspace of the path.
+func multiUserServerInScope(req api.Context) (v1.MCPServer, error) {
+	var server v1.MCPServer
+	if err := req.Get(&server, req.PathValue("mcp_server_id")); err != nil {
+		return server, err
+	}
+
+	if server.Spec.MCPCatalogID != req.PathValue("catalog_id") || server.Spec.PowerUserWorkspaceID != req.PathValue("workspace_id") {
+		return server, types.NewErrNotFound("MCP server not found")
+	}
+
+	return server, nil
+}
+
+func convertToolChanges(server v1.MCPServer) types.MCPServerToolChanges {
+	changes := types.MCPServerToolChanges{
+		Changelog:       server.Status.ToolChangelog,
+		PendingApproval: server.Status.PendingApprovalTools,
+	}
+	if changes.Changelog == nil {
+		changes.Changelog = []types.MCPServerToolChange{}
+	}
+	if changes.PendingApproval == nil {
+		changes.PendingApproval = []string{}
+	}
+	if server.Status.ToolsLastChecked != nil {
+		changes.LastChecked = types.NewTime(server.Status.ToolsLastChecked.Time)
+	}
+
+	return changes
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApproveToolsInWorkspace(t *testing.T) {
+	storage := newTestStorage(t, &v1.MCPServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "ms1workspace", Namespace: system.DefaultNamespace},
+		Spec:       v1.MCPServerSpec{PowerUserWorkspaceID: "ws1"},
+		Status:     v1.MCPServerStatus{PendingApprovalTools: []string{"new_tool", "other_tool"}},
+	})
+	body := []byte(`{"tools": ["new_tool"]}`)
+
+	// The server isn't found through a catalog or another workspace.
+	for _, pathValues := range [][]string{
+		{"catalog_id", "ws1", "mcp_server_id", "ms1workspace"},
+		{"workspace_id", "ws2", "mcp_server_id", "ms1workspace"},
+	} {
+		req, _ := newTestContext(storage, http.MethodPost, "/api/tool-changes/approve", body, pathValues...)
+		var httpErr *types.ErrHTTP
+		if err := (&MCPHandler{}).ApproveTools(req); !errors.As(err, &httpErr) || httpErr.Code != http.StatusNotFound {
+			t.Fatalf("expected the server not to be found with %v, got %v", pathValues, err)
+		}
+	}
+
+	req, _ := newTestContext(storage, http.MethodPost, "/api/workspaces/ws1/servers/ms1workspace/tool-changes/approve", body, "workspace_id", "ws1", "mcp_server_id", "ms1workspace")
+	if err := (&MCPHandler{}).ApproveTools(req); err != nil {
+		t.Fatal(err)
+	}
+
+	var server v1.MCPServer
+	if err := req.Get(&server, "ms1workspace"); err != nil {
+		t.Fatal(err)
+	}
+	if len(server.Status.PendingApprovalTools) != 1 || server.Status.PendingApprovalTools[0] != "other_tool" {
+		t.Fatalf("expected only other_tool to be waiting for approval, got %v", server.Status.PendingApprovalTools)
+	}
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/obot-platform/obot/apiclient/openapi"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/version"
+)
+
+type OpenAPIHandler struct {
+	document func() openapi.Document
+}
+
+// NewOpenAPIHandler returns a handler that serves the OpenAPI document of the described operations and of the routes
+// that routes returns. The document is built on the first request, when all routes are registered.
+func NewOpenAPIHandler(serverURL string, routes func() []string) *OpenAPIHandler {
+	return &OpenAPIHandler{
+		document: sync.OnceValue(func() openapi.Document {
+			return openapi.NewDocument(version.Get().String(), strings.TrimSuffix(serverURL, "/")+"/api", openapi.RouteOperations(openapi.Operations, routes()))
+		}),
+	}
+}
+
+// Get returns the OpenAPI document of the API.
+func (h *OpenAPIHandler) Get(req api.Context) error {
+	return req.Write(h.document())
+}
@@ -151,6 +151,10 @@ func (h *ProjectsHandler) UpdateProject(req api.Context) error {
 	project.Tools = thread.Spec.Manifest.Tools
 	project.AllowedMCPTools = thread.Spec.Manifest.AllowedMCPTools
 
+	if err := validateTemperature(project.Temperature); err != nil {
+		return err
+	}
+
 	if !equality.Semantic.DeepEqual(thread.Spec.Manifest, project) {
 		// Make sure that the default model provider and model are also on the models map.
 		if project.DefaultModelProvider != "" {
@@ -613,28 +617,8 @@ func (h *ProjectsHandler) CreateProjectThread(req api.Context) error {
 			return fmt.Errorf("failed to unmarshal request body: %w", err)
 		}
 
-		// Make sure that this model and model provider are valid.
-		if bodyContents.Model != "" || bodyContents.ModelProvider != "" {
-			agent, err := getAssistant(req, projectThread.Spec.AgentName)
-			if err != nil {
-				return err
-			}
-
-			// Check if model is allowed by assistant OR project
-			allowedByAssistant := len(agent.Spec.Manifest.AllowedModels) == 0 || slices.Contains(agent.Spec.Manifest.AllowedModels, bodyContents.Model)
-			allowedByProject := false
-			if projectModels, ok := projectThread.Spec.Models[bodyContents.ModelProvider]; ok {
-				allowedByProject = slices.Contains(projectModels, bodyContents.Model)
-			}
-
-			// if bodyContents.ModelProvider is empty it means that it is set at global level so allowedByProject should be true
-			if bodyContents.ModelProvider == "" {
-				allowedByProject = true
-			}
-
-			if !allowedByAssistant && !allowedByProject {
-				return types.NewErrBadRequest("model %q is not allowed for assistant and project", bodyContents.Model)
-			}
+		if err := validateThreadModel(req, projectThread, bodyContents.Model, bodyContents.ModelProvider); err != nil {
+			return err
 		}
 
 		thread.Spec.Manifest.Model = bodyContents.Model
@@ -648,6 +632,44 @@ func (h *ProjectsHandler) CreateProjectThread(req api.Context) error {
 	return req.WriteCreated(convertThread(thread))
 }
 
+// validateTemperature makes sure that the temperature is in the range that model providers accept.
+func validateTemperature(temperature *float32) error {
+	if temperature != nil && (*temperature < 0 || *temperature > 2) {
+		return types.NewErrBadRequest("temperature must be between 0 and 2")
+	}
+	return nil
+}
+
+// validateThreadModel makes sure that the model and model provider are allowed for threads of the project.
+func validateThreadModel(req api.Context, projectThread *v1.Thread, model, modelProvider string) error {
+	if model == "" && modelProvider == "" {
+		return nil
+	}
+
+	agent, err := getAssistant(req, projectThread.Spec.AgentName)
+	if err != nil {
+		return err
+	}
+
+	// Check if model is allowed by assistant OR project
+	allowedByAssistant := len(agent.Spec.Manifest.AllowedModels) == 0 || slices.Contains(agent.Spec.Manifest.AllowedModels, model)
+	allowedByProject := false
+	if projectModels, ok := projectThread.Spec.Models[modelProvider]; ok {
+		allowedByProject = slices.Contains(projectModels, model)
+	}
+
+	// if modelProvider is empty it means that it is set at global level so allowedByProject should be true
+	if modelProvider == "" {
+		allowedByProject = true
+	}
+
+	if !allowedByAssistant && !allowedByProject {
+		return types.NewErrBadRequest("model %q is not allowed for assistant and project", model)
+	}
+
+	return nil
+}
+
 func (h *ProjectsHandler) GetProjectThread(req api.Context) error {
 	var (
 		id = req.PathValue("id")
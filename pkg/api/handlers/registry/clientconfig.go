@@ -0,0 +1,292 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+var clientConfigPaths = map[types.MCPClientConfigFormat]string{
+	types.MCPClientConfigFormatCursor:  "~/.cursor/mcp.json",
+	types.MCPClientConfigFormatVSCode:  ".vscode/mcp.json",
+	types.MCPClientConfigFormatCodex:   "~/.codex/config.toml",
+	types.MCPClientConfigFormatMCPJSON: ".mcp.json",
+}
+
+// clientConfigOSPaths are the configuration paths of clients whose path depends on the operating system.
+var clientConfigOSPaths = map[types.MCPClientConfigFormat]map[string]string{
+	types.MCPClientConfigFormatClaudeDesktop: {
+		"darwin":  "~/Library/Application Support/Claude/claude_desktop_config.json",
+		"windows": `%APPDATA%\Claude\claude_desktop_config.json`,
+	},
+}
+
+// apiKeyStore is the part of the gateway client that client configurations use to mint API keys.
+type apiKeyStore interface {
+	CreateAPIKey(ctx context.Context, userID uint, name, description string, expiresAt *time.Time, mcpServerIDs []string) (*gtypes.APIKeyCreateResponse, error)
+	ListAPIKeys(ctx context.Context, userID uint) ([]gtypes.APIKey, error)
+	DeleteAPIKey(ctx context.Context, userID uint, keyID uint) error
+}
+
+// ClientConfig handles POST /api/mcp-client-config. It renders the configuration of an MCP client for the servers of
+// the user's registry that can be connected to, optionally with an API key for the servers.
+func (h *Handler) ClientConfig(req api.Context) error {
+	var input types.MCPClientConfigRequest
+	if err := req.Read(&input); err != nil {
+		return types.NewErrBadRequest("invalid request body: %v", err)
+	}
+
+	if input.Format == "" {
+		input.Format = types.MCPClientConfigFormatMCPJSON
+	} else if !slices.Contains(types.MCPClientConfigFormats, input.Format) {
+		return types.NewErrBadRequest("unsupported format %q, must be one of %v", input.Format, types.MCPClientConfigFormats)
+	}
+	if input.APIKeyExpiresAt != nil && !input.APIKeyExpiresAt.Time.After(time.Now()) {
+		return types.NewErrBadRequest("apiKeyExpiresAt must be in the future")
+	}
+
+	reverseDNS, err := ReverseDNSFromURL(h.serverURL)
+	if err != nil {
+		return fmt.Errorf("failed to generate reverse DNS: %w", err)
+	}
+
+	servers, err := h.accessibleServers(req, reverseDNS)
+	if err != nil {
+		return err
+	}
+
+	if len(input.Servers) > 0 {
+		servers, err = selectServers(servers, input.Servers)
+		if err != nil {
+			return err
+		}
+	}
+
+	result, err := clientConfig(req.Context(), req.GatewayClient, req.UserID(), h.serverURL, input, servers)
+	if err != nil {
+		return err
+	}
+
+	return req.Write(result)
+}
+
+// clientConfig renders the configuration of the servers. When API keys are requested, it mints one key for all the
+// servers that have an MCP server and deletes the key minted for the user's previous configuration of the format. The
+// new key is deleted again if the configuration can't be completed.
+func clientConfig(ctx context.Context, keys apiKeyStore, userID uint, serverURL string, input types.MCPClientConfigRequest, servers []accessibleServer) (_ types.MCPClientConfig, retErr error) {
+	result := types.MCPClientConfig{
+		Format:  input.Format,
+		Path:    clientConfigPaths[input.Format],
+		Paths:   clientConfigOSPaths[input.Format],
+		Servers: []types.MCPClientConfigServer{},
+	}
+
+	var (
+		names     = make(map[string]bool, len(servers))
+		serverIDs []string
+	)
+	for _, server := range servers {
+		_, shortName, _ := strings.Cut(server.Server.Name, "/")
+		if len(server.Server.Remotes) == 0 {
+			reason := "the server has no connection URL"
+			if server.Meta.Obot != nil && server.Meta.Obot.ConfigurationMessage != "" {
+				reason = server.Meta.Obot.ConfigurationMessage
+			}
+			result.Skipped = append(result.Skipped, types.MCPClientConfigSkipped{
+				Name:   shortName,
+				Reason: reason,
+			})
+			continue
+		}
+
+		// Keys of the configuration have to be unique, the short names of servers and catalog entries may collide.
+		name := shortName
+		for i := 2; names[name]; i++ {
+			name = fmt.Sprintf("%s-%d", shortName, i)
+		}
+		names[name] = true
+
+		result.Servers = append(result.Servers, types.MCPClientConfigServer{
+			Name:        name,
+			Title:       server.Server.Title,
+			URL:         server.Server.Remotes[0].URL,
+			MCPServerID: server.MCPServerID,
+		})
+
+		// Keys are scoped to MCP servers. Catalog entries get a server when the user first connects, so clients of
+		// entries log in with OAuth instead.
+		if server.MCPServerID != "" {
+			serverIDs = append(serverIDs, server.MCPServerID)
+		}
+	}
+
+	apiKeys := make(map[string]string, len(result.Servers))
+	if input.MintAPIKeys && len(serverIDs) > 0 {
+		name := clientConfigAPIKeyName(input.Format)
+		previous, err := keys.ListAPIKeys(ctx, userID)
+		if err != nil {
+			return result, fmt.Errorf("failed to list API keys: %w", err)
+		}
+
+		expiresAt := time.Now().Add(types.DefaultMCPClientConfigAPIKeyTTL)
+		if input.APIKeyExpiresAt != nil {
+			expiresAt = input.APIKeyExpiresAt.Time
+		}
+
+		key, err := keys.CreateAPIKey(ctx, userID, name, fmt.Sprintf("Created for the %s configuration of %d MCP servers", input.Format, len(serverIDs)), &expiresAt, serverIDs)
+		if err != nil {
+			return result, types.NewErrHTTP(http.StatusInternalServerError, fmt.Sprintf("failed to create API key: %v", err))
+		}
+		defer func() {
+			if retErr != nil {
+				if err := keys.DeleteAPIKey(ctx, userID, key.ID); err != nil {
+					retErr = errors.Join(retErr, fmt.Errorf("failed to delete API key %d: %w", key.ID, err))
+				}
+			}
+		}()
+
+		for i, server := range result.Servers {
+			if server.MCPServerID != "" {
+				result.Servers[i].APIKeyID = key.ID
+				apiKeys[server.Name] = key.Key
+			}
+		}
+
+		for _, old := range previous {
+			if old.Name != name {
+				continue
+			}
+			if err := keys.DeleteAPIKey(ctx, userID, old.ID); err != nil {
+				return result, fmt.Errorf("failed to delete the API key of the previous configuration: %w", err)
+			}
+		}
+	}
+
+	var err error
+	if result.Content, err = renderClientConfig(input.Format, serverURL, result.Servers, apiKeys); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// clientConfigAPIKeyName is the name of the API key of the configurations of a client, which identifies the key that
+// the next configuration replaces.
+func clientConfigAPIKeyName(format types.MCPClientConfigFormat) string {
+	return fmt.Sprintf("%s MCP client configuration", format)
+}
+
+// selectServers returns the servers with the given registry names, short names or MCP server IDs.
+func selectServers(servers []accessibleServer, names []string) ([]accessibleServer, error) {
+	var (
+		result []accessibleServer
+		found  = make(map[string]bool, len(names))
+	)
+	for _, server := range servers {
+		_, shortName, _ := strings.Cut(server.Server.Name, "/")
+		for _, name := range names {
+			if name == server.Server.Name || name == shortName || name == server.MCPServerID {
+				result = append(result, server)
+				found[name] = true
+				break
+			}
+		}
+	}
+
+	for _, name := range names {
+		if !found[name] {
+			return nil, types.NewErrNotFound("MCP server %q not found", name)
+		}
+	}
+	return result, nil
+}
+
+func renderClientConfig(format types.MCPClientConfigFormat, serverURL string, servers []types.MCPClientConfigServer, apiKeys map[string]string) (string, error) {
+	if format == types.MCPClientConfigFormatCodex {
+		return renderCodexConfig(servers, apiKeys), nil
+	}
+
+	entries := make(map[string]any, len(servers))
+	for _, server := range servers {
+		var (
+			entry   map[string]any
+			headers map[string]string
+		)
+		if key := apiKeys[server.Name]; key != "" {
+			headers = map[string]string{
+				"Authorization": "Bearer " + key,
+			}
+		}
+
+		switch format {
+		case types.MCPClientConfigFormatClaudeDesktop:
+			env := map[string]string{
+				"OBOT_BASE_URL": serverURL + "/api",
+			}
+			if key := apiKeys[server.Name]; key != "" {
+				env["OBOT_API_KEY"] = key
+			}
+			entries[server.Name] = map[string]any{
+				"command": "obot",
+				"args":    []string{"mcp", "connect", path.Base(server.URL)},
+				"env":     env,
+			}
+			continue
+		case types.MCPClientConfigFormatCursor:
+			entry = map[string]any{
+				"url": server.URL,
+			}
+		default:
+			entry = map[string]any{
+				"type": "http",
+				"url":  server.URL,
+			}
+		}
+
+		if headers != nil {
+			entry["headers"] = headers
+		}
+		entries[server.Name] = entry
+	}
+
+	key := "mcpServers"
+	if format == types.MCPClientConfigFormatVSCode {
+		key = "servers"
+	}
+
+	data, err := json.MarshalIndent(map[string]any{key: entries}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
+
+func renderCodexConfig(servers []types.MCPClientConfigServer, apiKeys map[string]string) string {
+	var sb strings.Builder
+	for i, server := range servers {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		// JSON strings are valid TOML basic strings.
+		fmt.Fprintf(&sb, "[mcp_servers.%s]\n", tomlString(server.Name))
+		fmt.Fprintf(&sb, "url = %s\n", tomlString(server.URL))
+		if key := apiKeys[server.Name]; key != "" {
+			fmt.Fprintf(&sb, "http_headers = { Authorization = %s }\n", tomlString("Bearer "+key))
+		}
+	}
+	return sb.String()
+}
+
+func tomlString(s string) string {
+	data, _ := json.Marshal(s)
+	return string(data)
+}
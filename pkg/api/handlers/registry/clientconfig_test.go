@@ -0,0 +1,218 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func TestRenderClientConfig(t *testing.T) {
+	servers := []types.MCPClientConfigServer{
+		{Name: "github", URL: "https://obot.example.com/mcp-connect/ms1abc", MCPServerID: "ms1abc"},
+		{Name: "docs", URL: "https://obot.example.com/mcp-connect/docs"},
+	}
+	apiKeys := map[string]string{"github": "ok1-1-2-secret"}
+
+	tests := []struct {
+		format   types.MCPClientConfigFormat
+		expected string
+	}{
+		{
+			format: types.MCPClientConfigFormatClaudeDesktop,
+			expected: `{
+  "mcpServers": {
+    "docs": {
+      "args": [
+        "mcp",
+        "connect",
+        "docs"
+      ],
+      "command": "obot",
+      "env": {
+        "OBOT_BASE_URL": "https://obot.example.com/api"
+      }
+    },
+    "github": {
+      "args": [
+        "mcp",
+        "connect",
+        "ms1abc"
+      ],
+      "command": "obot",
+      "env": {
+        "OBOT_API_KEY": "ok1-1-2-secret",
+        "OBOT_BASE_URL": "https://obot.example.com/api"
+      }
+    }
+  }
+}
+`,
+		},
+		{
+			format: types.MCPClientConfigFormatVSCode,
+			expected: `{
+  "servers": {
+    "docs": {
+      "type": "http",
+      "url": "https://obot.example.com/mcp-connect/docs"
+    },
+    "github": {
+      "headers": {
+        "Authorization": "Bearer ok1-1-2-secret"
+      },
+      "type": "http",
+      "url": "https://obot.example.com/mcp-connect/ms1abc"
+    }
+  }
+}
+`,
+		},
+		{
+			format: types.MCPClientConfigFormatCodex,
+			expected: `[mcp_servers."github"]
+url = "https://obot.example.com/mcp-connect/ms1abc"
+http_headers = { Authorization = "Bearer ok1-1-2-secret" }
+
+[mcp_servers."docs"]
+url = "https://obot.example.com/mcp-connect/docs"
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			got, err := renderClientConfig(tt.format, "https://obot.example.com", servers, apiKeys)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", tt.expected, got)
+			}
+		})
+	}
+}
+
+type fakeAPIKeyStore struct {
+	keys      []gtypes.APIKey
+	next      uint
+	failNames []string
+}
+
+func (f *fakeAPIKeyStore) CreateAPIKey(_ context.Context, userID uint, name, description string, expiresAt *time.Time, mcpServerIDs []string) (*gtypes.APIKeyCreateResponse, error) {
+	f.next++
+	key := gtypes.APIKey{ID: f.next, UserID: userID, Name: name, Description: description, ExpiresAt: expiresAt, MCPServerIDs: mcpServerIDs}
+	f.keys = append(f.keys, key)
+	return &gtypes.APIKeyCreateResponse{APIKey: key, Key: fmt.Sprintf("ok1-%d-secret", key.ID)}, nil
+}
+
+func (f *fakeAPIKeyStore) ListAPIKeys(_ context.Context, userID uint) ([]gtypes.APIKey, error) {
+	var result []gtypes.APIKey
+	for _, key := range f.keys {
+		if key.UserID == userID {
+			result = append(result, key)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeAPIKeyStore) DeleteAPIKey(_ context.Context, userID uint, keyID uint) error {
+	for i, key := range f.keys {
+		if key.ID == keyID && key.UserID == userID {
+			if slices.Contains(f.failNames, key.Name) && key.ID != f.next {
+				return errors.New("database is locked")
+			}
+			f.keys = slices.Delete(f.keys, i, i+1)
+			return nil
+		}
+	}
+	return errors.New("not found")
+}
+
+func registryServer(name, url, mcpServerID string) accessibleServer {
+	server := accessibleServer{MCPServerID: mcpServerID}
+	server.Server.Name = "com.example/" + name
+	if url != "" {
+		server.Server.Remotes = []types.RegistryServerRemote{{URL: url}}
+	}
+	return server
+}
+
+func TestClientConfigRotatesAPIKey(t *testing.T) {
+	var (
+		keys    = &fakeAPIKeyStore{}
+		servers = []accessibleServer{
+			registryServer("github", "https://obot.example.com/mcp-connect/ms1github", "ms1github"),
+			registryServer("slack", "https://obot.example.com/mcp-connect/ms1slack", "ms1slack"),
+			registryServer("docs", "https://obot.example.com/mcp-connect/docs", ""),
+			registryServer("unconfigured", "", ""),
+		}
+		input = types.MCPClientConfigRequest{Format: types.MCPClientConfigFormatCursor, MintAPIKeys: true}
+	)
+	// Keys of other clients and other users are kept.
+	_, _ = keys.CreateAPIKey(t.Context(), 1, clientConfigAPIKeyName(types.MCPClientConfigFormatVSCode), "", nil, []string{"ms1github"})
+	_, _ = keys.CreateAPIKey(t.Context(), 2, clientConfigAPIKeyName(types.MCPClientConfigFormatCursor), "", nil, []string{"ms1github"})
+
+	first, err := clientConfig(t.Context(), keys, 1, "https://obot.example.com", input, servers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Servers) != 3 || len(first.Skipped) != 1 {
+		t.Fatalf("expected 3 servers and 1 skipped, got %+v and %+v", first.Servers, first.Skipped)
+	}
+	if first.Servers[0].APIKeyID == 0 || first.Servers[0].APIKeyID != first.Servers[1].APIKeyID || first.Servers[2].APIKeyID != 0 {
+		t.Errorf("expected the servers to share one key and the catalog entry to have none, got %+v", first.Servers)
+	}
+	if strings.Count(first.Content, fmt.Sprintf("ok1-%d-secret", first.Servers[0].APIKeyID)) != 2 {
+		t.Errorf("expected the key in the configuration of both servers:\n%s", first.Content)
+	}
+
+	minted := keys.keys[len(keys.keys)-1]
+	if !slices.Equal(minted.MCPServerIDs, []string{"ms1github", "ms1slack"}) {
+		t.Errorf("expected the key to be scoped to the servers, got %v", minted.MCPServerIDs)
+	}
+	if minted.ExpiresAt == nil || time.Until(*minted.ExpiresAt) < types.DefaultMCPClientConfigAPIKeyTTL-time.Minute {
+		t.Errorf("expected the key to expire after the default TTL, got %v", minted.ExpiresAt)
+	}
+
+	second, err := clientConfig(t.Context(), keys, 1, "https://obot.example.com", input, servers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []uint
+	for _, key := range keys.keys {
+		ids = append(ids, key.ID)
+	}
+	if expected := []uint{1, 2, second.Servers[0].APIKeyID}; !slices.Equal(ids, expected) {
+		t.Errorf("expected the key of the first configuration to be replaced, got keys %v, want %v", ids, expected)
+	}
+
+	// When the previous key can't be deleted, the new key is deleted and the previous one keeps working.
+	keys.failNames = []string{clientConfigAPIKeyName(types.MCPClientConfigFormatCursor)}
+	if _, err := clientConfig(t.Context(), keys, 1, "https://obot.example.com", input, servers); err == nil {
+		t.Fatal("expected an error")
+	}
+	var remaining []uint
+	for _, key := range keys.keys {
+		remaining = append(remaining, key.ID)
+	}
+	if !slices.Equal(remaining, ids) {
+		t.Errorf("expected only the previous keys to remain, got %v, want %v", remaining, ids)
+	}
+}
+
+func TestClientConfigPaths(t *testing.T) {
+	config, err := clientConfig(t.Context(), &fakeAPIKeyStore{}, 1, "https://obot.example.com", types.MCPClientConfigRequest{Format: types.MCPClientConfigFormatClaudeDesktop}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Path != "" || config.Paths["darwin"] == "" || config.Paths["windows"] == "" {
+		t.Errorf("expected Claude Desktop paths for macOS and Windows, got %q and %v", config.Path, config.Paths)
+	}
+}
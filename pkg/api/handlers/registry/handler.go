@@ -68,7 +68,26 @@ func (h *Handler) ListServers(req api.Context) error {
 }
 
 func (h *Handler) collectAccessibleServers(req api.Context, reverseDNS string) ([]types.RegistryServerResponse, error) {
+	servers, err := h.accessibleServers(req, reverseDNS)
+	if err != nil {
+		return nil, err
+	}
+
 	var result []types.RegistryServerResponse
+	for _, server := range servers {
+		result = append(result, server.RegistryServerResponse)
+	}
+	return result, nil
+}
+
+// accessibleServer is a server in the registry of a user, with the ID of the MCPServer it was converted from, if any.
+type accessibleServer struct {
+	types.RegistryServerResponse
+	MCPServerID string
+}
+
+func (h *Handler) accessibleServers(req api.Context, reverseDNS string) ([]accessibleServer, error) {
+	var result []accessibleServer
 	userID := req.User.GetUID()
 
 	// Track what we've already added for deduplication
@@ -93,7 +112,7 @@ func (h *Handler) collectAccessibleServers(req api.Context, reverseDNS string) (
 			// Skip servers that can't be converted
 			continue
 		}
-		result = append(result, converted)
+		result = append(result, accessibleServer{RegistryServerResponse: converted, MCPServerID: server.Name})
 
 		// Track catalog entry for deduplication
 		if server.Spec.MCPServerCatalogEntryName != "" {
@@ -113,7 +132,7 @@ func (h *Handler) collectAccessibleServers(req api.Context, reverseDNS string) (
 			// If conversion fails, just skip the entry
 			continue
 		}
-		result = append(result, converted)
+		result = append(result, accessibleServer{RegistryServerResponse: converted})
 	}
 
 	// Step 3: List servers in default catalog with access
@@ -135,7 +154,7 @@ func (h *Handler) collectAccessibleServers(req api.Context, reverseDNS string) (
 			// If conversion fails, just skip the server
 			continue
 		}
-		result = append(result, converted)
+		result = append(result, accessibleServer{RegistryServerResponse: converted, MCPServerID: server.Name})
 	}
 
 	// Step 4: List catalog entries in PowerUserWorkspaces with access
@@ -150,7 +169,7 @@ func (h *Handler) collectAccessibleServers(req api.Context, reverseDNS string) (
 			// If conversion fails, just skip the entry
 			continue
 		}
-		result = append(result, converted)
+		result = append(result, accessibleServer{RegistryServerResponse: converted})
 	}
 
 	// Step 5: List servers in PowerUserWorkspaces with access
@@ -172,7 +191,7 @@ func (h *Handler) collectAccessibleServers(req api.Context, reverseDNS string) (
 			// If conversion fails, just skip the server
 			continue
 		}
-		result = append(result, converted)
+		result = append(result, accessibleServer{RegistryServerResponse: converted, MCPServerID: server.Name})
 	}
 
 	return result, nil
@@ -402,8 +421,8 @@ func (h *Handler) listCatalogEntriesInWorkspaces(
 		}
 
 		for _, entry := range entryList.Items {
-			// Skip if already added
-			if exclude[entry.Name] {
+			// Skip if already added, or if the entry has not been approved yet
+			if exclude[entry.Name] || !entry.IsApproved() {
 				continue
 			}
 
@@ -809,6 +828,10 @@ func (h *Handler) findMCPServerCatalogEntry(req api.Context, entryName string, r
 			return types.RegistryServerResponse{}, fmt.Errorf("catalog entry not found")
 		}
 	} else if entry.Spec.PowerUserWorkspaceID != "" {
+		if !entry.IsApproved() {
+			return types.RegistryServerResponse{}, fmt.Errorf("catalog entry not found")
+		}
+
 		// Workspace entry - check ACR
 		hasAccess, err := h.acrHelper.UserHasAccessToMCPServerCatalogEntryInWorkspace(
 			req.Context(),
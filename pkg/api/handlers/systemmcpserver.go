@@ -365,6 +365,8 @@ func (h *SystemMCPServerHandler) GetDetails(req api.Context) error {
 		return fmt.Errorf("failed to get server details: %w", err)
 	}
 
+	details.Health = systemServer.Status.Health
+
 	return req.Write(details)
 }
 
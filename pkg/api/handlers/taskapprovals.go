@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (t *TaskHandler) ApproveRun(req api.Context) error {
+	var workflow v1.Workflow
+	if err := req.Get(&workflow, req.PathValue("id")); err != nil {
+		return err
+	}
+
+	return t.decide(req, &workflow, true)
+}
+
+func (t *TaskHandler) ApproveRunFromScope(req api.Context) error {
+	workflow, _, err := t.getTask(req)
+	if err != nil {
+		return err
+	}
+
+	return t.decide(req, workflow, true)
+}
+
+// Approve approves an approval step on behalf of one of its approvers, without access to the task itself.
+func (t *TaskHandler) Approve(req api.Context) error {
+	return t.decide(req, nil, true)
+}
+
+func (t *TaskHandler) RejectRun(req api.Context) error {
+	var workflow v1.Workflow
+	if err := req.Get(&workflow, req.PathValue("id")); err != nil {
+		return err
+	}
+
+	return t.decide(req, &workflow, false)
+}
+
+func (t *TaskHandler) RejectRunFromScope(req api.Context) error {
+	workflow, _, err := t.getTask(req)
+	if err != nil {
+		return err
+	}
+
+	return t.decide(req, workflow, false)
+}
+
+// Reject rejects an approval step on behalf of one of its approvers, without access to the task itself.
+func (t *TaskHandler) Reject(req api.Context) error {
+	return t.decide(req, nil, false)
+}
+
+// decide records the decision on an approval step. When workflow is nil, the task is looked up from the run, and the
+// run is reported as not found to users that are not approvers of the step.
+func (t *TaskHandler) decide(req api.Context, workflow *v1.Workflow, approved bool) error {
+	var (
+		wfe    v1.WorkflowExecution
+		runID  = req.PathValue("run_id")
+		stepID = req.PathValue("step_id")
+		body   types.TaskRunApprovalDecision
+	)
+
+	if err := req.Read(&body); err != nil && !errors.Is(err, io.EOF) {
+		return types.NewErrBadRequest("failed to read decision: %v", err)
+	}
+
+	if err := req.Get(&wfe, runID); err != nil {
+		return err
+	}
+
+	scoped := workflow != nil
+	if !scoped {
+		workflow = new(v1.Workflow)
+		if err := req.Get(workflow, wfe.Spec.WorkflowName); err != nil {
+			return err
+		}
+	} else if wfe.Spec.WorkflowName != workflow.Name {
+		return types.NewErrNotFound("task run not found")
+	}
+
+	var pending *v1.PendingApproval
+	for i, p := range wfe.Status.PendingApprovals {
+		if p.StepID == stepID && p.WorkflowGeneration == wfe.Spec.WorkflowGeneration {
+			pending = &wfe.Status.PendingApprovals[i]
+		}
+	}
+	if pending == nil {
+		return types.NewErrNotFound("step %s of task run %s is not waiting for approval", stepID, runID)
+	}
+
+	if ok, err := canDecide(req, workflow, pending.Approval); err != nil {
+		return err
+	} else if !ok && !scoped {
+		return types.NewErrNotFound("step %s of task run %s is not waiting for approval", stepID, runID)
+	} else if !ok {
+		return types.NewErrHTTP(http.StatusForbidden, "you are not an approver of this step")
+	}
+
+	for _, decision := range wfe.Spec.ApprovalDecisions {
+		if decision.StepID == stepID && decision.WorkflowGeneration == wfe.Spec.WorkflowGeneration {
+			return types.NewErrHTTP(http.StatusConflict, "a decision was already made for this step")
+		}
+	}
+
+	wfe.Spec.ApprovalDecisions = append(wfe.Spec.ApprovalDecisions, v1.ApprovalDecision{
+		StepID:             stepID,
+		WorkflowGeneration: wfe.Spec.WorkflowGeneration,
+		Approved:           approved,
+		Comment:            body.Comment,
+		UserID:             req.User.GetUID(),
+		DecidedAt:          metav1.Now(),
+	})
+	if err := req.Update(&wfe); err != nil {
+		return err
+	}
+
+	if !scoped {
+		return req.Write(convertTaskRunApproval(&wfe, *pending))
+	}
+	return req.Write(convertTaskRun(workflow, &wfe))
+}
+
+// ListApprovals returns the approval steps that are waiting for a decision from the current user.
+func (t *TaskHandler) ListApprovals(req api.Context) error {
+	var wfes v1.WorkflowExecutionList
+	if err := req.List(&wfes, kclient.MatchingFields{
+		"status.awaitingApproval": "true",
+	}); err != nil {
+		return err
+	}
+
+	workflows := map[string]*v1.Workflow{}
+	result := types.TaskRunApprovalList{Items: []types.TaskRunApproval{}}
+	for _, wfe := range wfes.Items {
+		for _, pending := range wfe.Status.PendingApprovals {
+			if pending.WorkflowGeneration != wfe.Spec.WorkflowGeneration {
+				continue
+			}
+
+			workflow, ok := workflows[wfe.Spec.WorkflowName]
+			if !ok {
+				workflow = new(v1.Workflow)
+				if err := req.Get(workflow, wfe.Spec.WorkflowName); kclient.IgnoreNotFound(err) != nil {
+					return err
+				} else if err != nil {
+					workflow = nil
+				}
+				workflows[wfe.Spec.WorkflowName] = workflow
+			}
+			if workflow == nil {
+				continue
+			}
+
+			if ok, err := canDecide(req, workflow, pending.Approval); err != nil {
+				return err
+			} else if ok {
+				result.Items = append(result.Items, convertTaskRunApproval(&wfe, pending))
+			}
+		}
+	}
+
+	return req.Write(result)
+}
+
+// canDecide returns true if the user is an approver of the step. Admins and owners can decide on any step.
+func canDecide(req api.Context, workflow *v1.Workflow, approval types.Approval) (bool, error) {
+	if req.UserIsAdmin() || req.UserIsOwner() {
+		return true, nil
+	}
+
+	userID := req.User.GetUID()
+	if slices.Contains(approval.Approvers, userID) {
+		return true, nil
+	}
+	for _, group := range req.User.GetExtra()["auth_provider_groups"] {
+		if slices.Contains(approval.Groups, group) {
+			return true, nil
+		}
+	}
+
+	if len(approval.Approvers) > 0 || len(approval.Groups) > 0 {
+		return false, nil
+	}
+
+	// Without designated approvers, any member of the project can decide.
+	var projectThread v1.Thread
+	if err := req.Get(&projectThread, workflow.Spec.ThreadName); err != nil {
+		return false, kclient.IgnoreNotFound(err)
+	}
+	if projectThread.Spec.UserID == userID {
+		return true, nil
+	}
+
+	var threadAuths v1.ThreadAuthorizationList
+	if err := req.List(&threadAuths, kclient.MatchingFields{
+		"spec.threadID": projectThread.Name,
+		"spec.userID":   userID,
+	}); err != nil {
+		return false, err
+	}
+
+	return len(threadAuths.Items) > 0, nil
+}
+
+func convertTaskRunApproval(wfe *v1.WorkflowExecution, pending v1.PendingApproval) types.TaskRunApproval {
+	return types.TaskRunApproval{
+		TaskID:      wfe.Spec.WorkflowName,
+		RunID:       wfe.Name,
+		StepID:      pending.StepID,
+		Message:     pending.Approval.Message,
+		Approvers:   pending.Approval.Approvers,
+		Groups:      pending.Approval.Groups,
+		RequestedAt: *types.NewTime(pending.RequestedAt.Time),
+		ExpiresAt:   *types.NewTime(pending.ExpiresAt.Time),
+	}
+}
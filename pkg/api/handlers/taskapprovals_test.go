@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func taskApprovalTestObjects() []client.Object {
+	pending := func(stepID string, generation int64, approvers ...string) v1.PendingApproval {
+		return v1.PendingApproval{StepID: stepID, WorkflowGeneration: generation, Approval: types.Approval{Approvers: approvers}}
+	}
+
+	return []client.Object{
+		&v1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "w1release", Namespace: system.DefaultNamespace},
+			Spec:       v1.WorkflowSpec{ThreadName: "t1project"},
+		},
+		&v1.WorkflowExecution{
+			ObjectMeta: metav1.ObjectMeta{Name: "we1pending", Namespace: system.DefaultNamespace},
+			Spec:       v1.WorkflowExecutionSpec{WorkflowName: "w1release", WorkflowGeneration: 2},
+			Status: v1.WorkflowExecutionStatus{PendingApprovals: []v1.PendingApproval{
+				pending("review", 2, "2"),
+				pending("publish", 2, "3"),
+			}},
+		},
+		&v1.WorkflowExecution{
+			// Approvals requested by an earlier generation are no longer pending.
+			ObjectMeta: metav1.ObjectMeta{Name: "we1stale", Namespace: system.DefaultNamespace},
+			Spec:       v1.WorkflowExecutionSpec{WorkflowName: "w1release", WorkflowGeneration: 2},
+			Status:     v1.WorkflowExecutionStatus{PendingApprovals: []v1.PendingApproval{pending("review", 1, "2")}},
+		},
+	}
+}
+
+func asUser(id string) user.Info {
+	return &user.DefaultInfo{Name: "user-" + id, UID: id, Groups: []string{types.GroupAuthenticated}}
+}
+
+func TestListApprovals(t *testing.T) {
+	storage := newTestStorage(t, taskApprovalTestObjects()...)
+
+	req, rec := newTestContext(storage, http.MethodGet, "/api/task-approvals", nil)
+	req.User = asUser("2")
+	if err := (&TaskHandler{}).ListApprovals(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var approvals types.TaskRunApprovalList
+	if err := json.Unmarshal(rec.Body.Bytes(), &approvals); err != nil {
+		t.Fatal(err)
+	}
+	if len(approvals.Items) != 1 || approvals.Items[0].RunID != "we1pending" || approvals.Items[0].StepID != "review" {
+		t.Errorf("expected only the review step to wait for the user, got %+v", approvals.Items)
+	}
+}
+
+func TestDecideApproval(t *testing.T) {
+	storage := newTestStorage(t, taskApprovalTestObjects()...)
+	h := &TaskHandler{}
+
+	// Users that aren't approvers of the step don't learn that it exists.
+	req, _ := newTestContext(storage, http.MethodPost, "/api/task-approvals/we1pending/steps/publish/approve", nil, "run_id", "we1pending", "step_id", "publish")
+	req.User = asUser("2")
+	var httpErr *types.ErrHTTP
+	if err := h.Approve(req); !errors.As(err, &httpErr) || httpErr.Code != http.StatusNotFound {
+		t.Fatalf("expected a user that isn't an approver to get not found, got %v", err)
+	}
+
+	req, _ = newTestContext(storage, http.MethodPost, "/api/task-approvals/we1pending/steps/review/reject", []byte(`{"comment":"Not yet."}`), "run_id", "we1pending", "step_id", "review")
+	req.User = asUser("2")
+	if err := h.Reject(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var wfe v1.WorkflowExecution
+	if err := storage.Get(t.Context(), client.ObjectKey{Namespace: system.DefaultNamespace, Name: "we1pending"}, &wfe); err != nil {
+		t.Fatal(err)
+	}
+	if len(wfe.Spec.ApprovalDecisions) != 1 {
+		t.Fatalf("expected 1 decision, got %+v", wfe.Spec.ApprovalDecisions)
+	}
+	if decision := wfe.Spec.ApprovalDecisions[0]; decision.StepID != "review" || decision.Approved || decision.Comment != "Not yet." || decision.UserID != "2" || decision.WorkflowGeneration != 2 {
+		t.Errorf("unexpected decision: %+v", decision)
+	}
+
+	req, _ = newTestContext(storage, http.MethodPost, "/api/task-approvals/we1pending/steps/review/approve", nil, "run_id", "we1pending", "step_id", "review")
+	req.User = asUser("2")
+	if err := h.Approve(req); !errors.As(err, &httpErr) || httpErr.Code != http.StatusConflict {
+		t.Errorf("expected a second decision to conflict, got %v", err)
+	}
+}
@@ -1,20 +1,28 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/mail"
+	"net/url"
+	"slices"
 	"strings"
+	"text/template"
 	"time"
 	"unicode/utf8"
 
+	"github.com/adhocore/gronx"
 	"github.com/obot-platform/nah/pkg/name"
 	"github.com/obot-platform/nah/pkg/randomtoken"
 	"github.com/obot-platform/obot/apiclient"
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/controller/handlers/workflow"
 	"github.com/obot-platform/obot/pkg/events"
 	"github.com/obot-platform/obot/pkg/invoke"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/structuredoutput"
 	"github.com/obot-platform/obot/pkg/system"
 	"github.com/obot-platform/obot/pkg/wait"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -24,14 +32,16 @@ import (
 )
 
 type TaskHandler struct {
-	invoker *invoke.Invoker
-	events  *events.Emitter
+	invoker   *invoke.Invoker
+	events    *events.Emitter
+	serverURL string
 }
 
-func NewTaskHandler(invoker *invoke.Invoker, events *events.Emitter) *TaskHandler {
+func NewTaskHandler(invoker *invoke.Invoker, events *events.Emitter, serverURL string) *TaskHandler {
 	return &TaskHandler{
-		invoker: invoker,
-		events:  events,
+		invoker:   invoker,
+		events:    events,
+		serverURL: serverURL,
 	}
 }
 
@@ -369,17 +379,54 @@ func convertTaskRun(workflow *v1.Workflow, wfe *v1.WorkflowExecution) types.Task
 	if wfe.Status.EndTime != nil {
 		endTime = types.NewTime(wfe.Status.EndTime.Time)
 	}
+	subRuns := make([]types.TaskSubRun, 0, len(wfe.Status.SubWorkflowExecutions))
+	for _, sub := range wfe.Status.SubWorkflowExecutions {
+		subRuns = append(subRuns, types.TaskSubRun{
+			StepID: sub.StepID,
+			TaskID: sub.WorkflowName,
+			RunID:  sub.WorkflowExecutionName,
+		})
+	}
+	var pendingApprovals []types.TaskRunApproval
+	for _, pending := range wfe.Status.PendingApprovals {
+		if pending.WorkflowGeneration == wfe.Spec.WorkflowGeneration {
+			pendingApprovals = append(pendingApprovals, convertTaskRunApproval(wfe, pending))
+		}
+	}
+	var structuredOutput json.RawMessage
+	if wfe.Status.StructuredOutput != "" {
+		structuredOutput = json.RawMessage(wfe.Status.StructuredOutput)
+	}
+	var previousAttempts []types.TaskRunAttempt
+	for _, attempt := range wfe.Spec.PreviousAttempts {
+		previousAttempts = append(previousAttempts, types.TaskRunAttempt{
+			RunID:     attempt.WorkflowExecutionName,
+			Error:     attempt.Error,
+			StartTime: types.NewTime(attempt.StartTime.Time),
+			EndTime:   v1.NewTime(attempt.EndTime),
+		})
+	}
 	return types.TaskRun{
-		Metadata:  MetadataFrom(wfe),
-		TaskID:    workflow.Name,
-		Input:     wfe.Spec.Input,
-		Output:    wfe.Status.Output,
-		ThreadID:  wfe.Status.ThreadName,
-		Task:      ConvertTaskManifest(wfe.Status.WorkflowManifest),
-		StartTime: types.NewTime(wfe.CreationTimestamp.Time),
-		EndTime:   endTime,
-		Error:     wfe.Status.Error,
-		Warning:   wfe.Status.Warning,
+		Metadata:    MetadataFrom(wfe),
+		TaskID:      workflow.Name,
+		Input:       wfe.Spec.Input,
+		Output:      wfe.Status.Output,
+		ThreadID:    wfe.Status.ThreadName,
+		Task:        ConvertTaskManifest(wfe.Status.WorkflowManifest),
+		StartTime:   types.NewTime(wfe.CreationTimestamp.Time),
+		EndTime:     endTime,
+		Error:       wfe.Status.Error,
+		Warning:     wfe.Status.Warning,
+		ParentRunID: wfe.Spec.ParentWorkflowExecutionName,
+		SubRuns:     subRuns,
+
+		PendingApprovals: pendingApprovals,
+		WebhookDelivery:  convertTaskWebhookDelivery(wfe),
+		Attempt:          max(wfe.Spec.Attempt, 1),
+		PreviousAttempts: previousAttempts,
+		RetryRunID:       wfe.Status.RetryWorkflowExecutionName,
+		RetryAt:          v1.NewTime(wfe.Status.RetryAt),
+		StructuredOutput: structuredOutput,
 	}
 }
 
@@ -440,7 +487,7 @@ func (t *TaskHandler) Update(req api.Context) error {
 		return err
 	}
 
-	return req.Write(convertTask(workflow, trigger))
+	return req.Write(convertTask(workflow, trigger, t.serverURL))
 }
 
 func (t *TaskHandler) UpdateFromScope(req api.Context) error {
@@ -472,31 +519,180 @@ func (t *TaskHandler) UpdateFromScope(req api.Context) error {
 		return err
 	}
 
-	return req.Write(convertTask(*workflow, trigger))
+	return req.Write(convertTask(*workflow, trigger, t.serverURL))
 }
 
 type triggers struct {
 	CronJob *v1.CronJob
+	Webhook *v1.Webhook
 }
 
-func validate(task types.TaskManifest) error {
+func validate(req api.Context, task types.TaskManifest) error {
 	if task.Schedule != nil && task.OnDemand != nil {
 		return types.NewErrBadRequest("only one trigger is allowed, schedule or onDemand")
 	}
+	if task.Schedule != nil {
+		if err := validateSchedule(*task.Schedule); err != nil {
+			return err
+		}
+	}
+	if task.RetryPolicy != nil {
+		if err := validateRetryPolicy(*task.RetryPolicy); err != nil {
+			return err
+		}
+	}
+	for _, notification := range task.Notifications {
+		if err := validateNotification(req, notification); err != nil {
+			return err
+		}
+	}
+	if len(task.OutputSchema) > 0 {
+		if err := validateOutputSchema(task.OutputSchema); err != nil {
+			return err
+		}
+	}
+	if task.OutputRetries != nil && (*task.OutputRetries < 0 || *task.OutputRetries > types.MaxOutputRetries) {
+		return types.NewErrBadRequest("output retries must be between 0 and %d", types.MaxOutputRetries)
+	}
 	return nil
 }
 
-func (t *TaskHandler) updateTrigger(req api.Context, workflow *v1.Workflow, task types.TaskManifest) (*triggers, error) {
-	if err := validate(task); err != nil {
-		return nil, err
+func validateOutputSchema(schema json.RawMessage) error {
+	if _, err := structuredoutput.ResolveSchema(schema); err != nil {
+		return types.NewErrBadRequest("invalid output schema: %v", err)
 	}
+	return nil
+}
 
+func validateRetryPolicy(policy types.TaskRetryPolicy) error {
+	if policy.MaxAttempts < 1 || policy.MaxAttempts > types.MaxTaskRetryAttempts {
+		return types.NewErrBadRequest("max attempts must be between 1 and %d", types.MaxTaskRetryAttempts)
+	}
+	if policy.InitialBackoffSeconds < 0 || policy.MaxBackoffSeconds < 0 {
+		return types.NewErrBadRequest("backoff cannot be negative")
+	}
+	for _, condition := range policy.RetryOn {
+		switch condition {
+		case types.TaskRetryConditionRateLimit, types.TaskRetryConditionMCPServerLaunch, types.TaskRetryConditionAny:
+		default:
+			return types.NewErrBadRequest("invalid retry condition %q", condition)
+		}
+	}
+	return nil
+}
+
+// validateNotification checks the notification's destination. Only admins can send emails to other addresses than
+// their own, since they're sent from the server's SMTP account.
+func validateNotification(req api.Context, notification types.TaskNotification) error {
+	var destinations int
+	if len(notification.Email) > 0 {
+		destinations++
+		userEmails := req.User.GetExtra()["email"]
+		for _, addr := range notification.Email {
+			parsed, err := mail.ParseAddress(addr)
+			if err != nil {
+				return types.NewErrBadRequest("invalid notification email address %q", addr)
+			}
+			if !req.UserIsAdmin() && !slices.ContainsFunc(userEmails, func(email string) bool {
+				return strings.EqualFold(email, parsed.Address)
+			}) {
+				return types.NewErrForbidden("only admins can send notifications to email addresses other than their own")
+			}
+		}
+	}
+	for _, u := range []string{notification.WebhookURL, notification.SlackWebhookURL} {
+		if u == "" {
+			continue
+		}
+		destinations++
+		if parsed, err := url.Parse(u); err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+			return types.NewErrBadRequest("invalid notification URL %q", u)
+		}
+	}
+	if destinations != 1 {
+		return types.NewErrBadRequest("a notification must have exactly one of email, webhookURL, or slackWebhookURL")
+	}
+
+	for _, event := range notification.On {
+		if event != types.TaskNotificationEventFailure && event != types.TaskNotificationEventSuccess {
+			return types.NewErrBadRequest("invalid notification event %q", event)
+		}
+	}
+
+	if notification.Template != "" {
+		if _, err := template.New("notification").Parse(notification.Template); err != nil {
+			return types.NewErrBadRequest("invalid notification template: %v", err)
+		}
+	}
+	return nil
+}
+
+func validateSchedule(schedule types.Schedule) error {
+	switch schedule.Interval {
+	case "hourly", "daily", "weekly", "monthly":
+	case "cron":
+		if !gronx.IsValid(schedule.Expression) {
+			return types.NewErrBadRequest("invalid cron expression %q", schedule.Expression)
+		}
+	default:
+		return types.NewErrBadRequest("invalid schedule interval %q", schedule.Interval)
+	}
+
+	if schedule.TimeZone != "" {
+		if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+			return types.NewErrBadRequest("invalid timezone %q", schedule.TimeZone)
+		}
+	}
+
+	switch schedule.ConcurrencyPolicy {
+	case "", types.ConcurrencyPolicyAllow, types.ConcurrencyPolicyForbid, types.ConcurrencyPolicyReplace:
+	default:
+		return types.NewErrBadRequest("invalid concurrency policy %q", schedule.ConcurrencyPolicy)
+	}
+
+	switch schedule.CatchUpPolicy {
+	case "", types.CatchUpPolicyOnce, types.CatchUpPolicySkip, types.CatchUpPolicyAll:
+	default:
+		return types.NewErrBadRequest("invalid catch-up policy %q", schedule.CatchUpPolicy)
+	}
+
+	if schedule.JitterSeconds < 0 || schedule.JitterSeconds > types.MaxScheduleJitterSeconds {
+		return types.NewErrBadRequest("jitter must be between 0 and %d seconds", types.MaxScheduleJitterSeconds)
+	}
+
+	if window := schedule.RunWindow; window != nil {
+		start, err := time.Parse("15:04", window.Start)
+		if err != nil {
+			return types.NewErrBadRequest("invalid run window start %q, expected HH:MM", window.Start)
+		}
+		end, err := time.Parse("15:04", window.End)
+		if err != nil {
+			return types.NewErrBadRequest("invalid run window end %q, expected HH:MM", window.End)
+		}
+		if start.Equal(end) {
+			return types.NewErrBadRequest("run window must not start and end at the same time")
+		}
+		for _, weekday := range window.Weekdays {
+			if weekday < 0 || weekday > 6 {
+				return types.NewErrBadRequest("invalid run window weekday %d, expected 0 to 6", weekday)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (t *TaskHandler) updateTrigger(req api.Context, workflow *v1.Workflow, task types.TaskManifest) (*triggers, error) {
 	var trigger triggers
 
 	if err := t.updateCron(req, workflow, task, &trigger); err != nil {
 		return nil, err
 	}
 
+	if err := t.updateWebhook(req, workflow, task, &trigger); err != nil {
+		return nil, err
+	}
+
 	return &trigger, nil
 }
 
@@ -561,7 +757,15 @@ func (t *TaskHandler) getManifestFromRequest(req api.Context) (types.WorkflowMan
 		return types.WorkflowManifest{}, types.TaskManifest{}, err
 	}
 
+	// Validate before anything is stored, so that a rejected task doesn't leave its settings behind.
+	if err := validate(req, manifest); err != nil {
+		return types.WorkflowManifest{}, types.TaskManifest{}, err
+	}
+
 	wfManifest := ToWorkflowManifest(manifest)
+	if err := workflow.ValidateSteps(wfManifest.Steps); err != nil {
+		return types.WorkflowManifest{}, types.TaskManifest{}, types.NewErrBadRequest("invalid task: %v", err)
+	}
 	return wfManifest, manifest, nil
 }
 
@@ -616,7 +820,7 @@ func (t *TaskHandler) CreateFromScope(req api.Context) error {
 		return err
 	}
 
-	return req.WriteCreated(convertTask(workflow, trigger))
+	return req.WriteCreated(convertTask(workflow, trigger, t.serverURL))
 }
 
 func ToWorkflowManifest(manifest types.TaskManifest) types.WorkflowManifest {
@@ -625,6 +829,11 @@ func ToWorkflowManifest(manifest types.TaskManifest) types.WorkflowManifest {
 		Description: manifest.Description,
 		Steps:       toWorkflowSteps(manifest.Steps),
 		Params:      toParams(manifest),
+
+		RetryPolicy:   manifest.RetryPolicy,
+		Notifications: manifest.Notifications,
+		OutputSchema:  manifest.OutputSchema,
+		OutputRetries: manifest.OutputRetries,
 	}
 }
 
@@ -667,9 +876,15 @@ func (t *TaskHandler) get(req api.Context, workflow *v1.Workflow) error {
 		return err
 	}
 
+	webhook, err := getTaskWebhook(req, workflow)
+	if err != nil {
+		return err
+	}
+
 	return req.Write(convertTask(*workflow, &triggers{
 		CronJob: &cron,
-	}))
+		Webhook: webhook,
+	}, t.serverURL))
 }
 
 func (t *TaskHandler) getTask(req api.Context) (*v1.Workflow, *v1.Thread, error) {
@@ -795,6 +1010,16 @@ func (t *TaskHandler) list(req api.Context, thread *v1.Thread) error {
 		cronMap[crons.Items[i].Name] = &crons.Items[i]
 	}
 
+	var webhooks v1.WebhookList
+	if err := req.List(&webhooks, selector); err != nil {
+		return err
+	}
+
+	webhookMap := make(map[string]*v1.Webhook, len(webhooks.Items))
+	for i := range webhooks.Items {
+		webhookMap[webhooks.Items[i].Spec.WorkflowName] = &webhooks.Items[i]
+	}
+
 	var workflows v1.WorkflowList
 	if err := req.List(&workflows, selector); err != nil {
 		return err
@@ -810,7 +1035,8 @@ func (t *TaskHandler) list(req api.Context, thread *v1.Thread) error {
 
 		taskList.Items = append(taskList.Items, convertTask(workflow, &triggers{
 			CronJob: cronMap[name.SafeHashConcatName(system.CronJobPrefix, workflow.Name)],
-		}))
+			Webhook: webhookMap[workflow.Name],
+		}, t.serverURL))
 	}
 
 	return req.Write(taskList)
@@ -824,10 +1050,15 @@ func ConvertTaskManifest(manifest *types.WorkflowManifest) types.TaskManifest {
 		Name:        manifest.Name,
 		Description: manifest.Description,
 		Steps:       toTaskSteps(manifest.Steps),
+
+		RetryPolicy:   manifest.RetryPolicy,
+		Notifications: manifest.Notifications,
+		OutputSchema:  manifest.OutputSchema,
+		OutputRetries: manifest.OutputRetries,
 	}
 }
 
-func convertTask(workflow v1.Workflow, trigger *triggers) types.Task {
+func convertTask(workflow v1.Workflow, trigger *triggers, serverURL string) types.Task {
 	task := types.Task{
 		Metadata:     MetadataFrom(&workflow),
 		TaskManifest: ConvertTaskManifest(&workflow.Spec.Manifest),
@@ -838,6 +1069,9 @@ func convertTask(workflow v1.Workflow, trigger *triggers) types.Task {
 	if trigger != nil && trigger.CronJob != nil && trigger.CronJob.Name != "" {
 		task.Schedule = trigger.CronJob.Spec.TaskSchedule
 	}
+	if trigger != nil && trigger.Webhook != nil && trigger.Webhook.Name != "" {
+		task.Webhook = convertTaskWebhook(trigger.Webhook, serverURL)
+	}
 	if len(workflow.Spec.Manifest.Params) > 0 {
 		task.OnDemand = &types.TaskOnDemand{
 			Params: workflow.Spec.Manifest.Params,
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+)
+
+func TestValidateNotification(t *testing.T) {
+	req, _ := newTestContext(newTestStorage(t), http.MethodPost, "/api/tasks", nil)
+	if err := validateNotification(req, types.TaskNotification{Email: []string{"team@example.com"}}); err != nil {
+		t.Errorf("expected admins to notify any address, got %v", err)
+	}
+
+	req.User = &user.DefaultInfo{
+		Name:   "test-user",
+		UID:    "2",
+		Groups: []string{types.GroupAuthenticated},
+		Extra:  map[string][]string{"email": {"User@example.com"}},
+	}
+	if err := validateNotification(req, types.TaskNotification{Email: []string{"Test User <user@example.com>"}}); err != nil {
+		t.Errorf("expected users to notify their own address, got %v", err)
+	}
+
+	err := validateNotification(req, types.TaskNotification{Email: []string{"user@example.com", "team@example.com"}})
+	var httpErr *types.ErrHTTP
+	if !errors.As(err, &httpErr) || httpErr.Code != http.StatusForbidden {
+		t.Errorf("expected users to be forbidden from notifying other addresses, got %v", err)
+	}
+
+	if err := validateNotification(req, types.TaskNotification{WebhookURL: "ftp://example.com"}); err == nil {
+		t.Error("expected non-http webhook URLs to be rejected")
+	}
+}
+
+func TestUpdateRejectedTaskIsNotStored(t *testing.T) {
+	storage := newTestStorage(t,
+		&v1.Thread{ObjectMeta: metav1.ObjectMeta{Name: "t1task", Namespace: system.DefaultNamespace}},
+		&v1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "w1task", Namespace: system.DefaultNamespace},
+			Spec: v1.WorkflowSpec{
+				ThreadName: "t1task",
+				Manifest:   types.WorkflowManifest{Name: "original", Alias: "alias"},
+			},
+		},
+	)
+
+	for _, body := range []string{
+		`{"name": "changed", "retryPolicy": {"maxAttempts": 0}}`,
+		`{"name": "changed", "outputRetries": -1}`,
+	} {
+		req, _ := newTestContext(storage, http.MethodPut, "/api/tasks/w1task", []byte(body), "id", "w1task")
+		err := (&TaskHandler{}).Update(req)
+		var httpErr *types.ErrHTTP
+		if !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest {
+			t.Fatalf("expected %s to be rejected, got %v", body, err)
+		}
+
+		var workflow v1.Workflow
+		if err := req.Get(&workflow, "w1task"); err != nil {
+			t.Fatal(err)
+		}
+		if workflow.Spec.Manifest.Name != "original" || workflow.Spec.Manifest.RetryPolicy != nil {
+			t.Fatalf("expected the rejected update of %s not to be stored, got %+v", body, workflow.Spec.Manifest)
+		}
+	}
+}
@@ -0,0 +1,478 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/randomtoken"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/hash"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultWebhookSignatureHeader = "X-Signature"
+	// stripeSignatureTolerance is how old a Stripe-style signature can be before the request is rejected as a replay.
+	stripeSignatureTolerance = 5 * time.Minute
+	// webhookReplayWindow is how long a delivery is remembered, so that redeliveries of it don't start another run.
+	webhookReplayWindow = 24 * time.Hour
+	// maskedWebhookSecret is returned in place of stored secrets.
+	maskedWebhookSecret = "********"
+)
+
+// deliveryIDHeaders are checked in order for the sender's ID of the delivery, which is recorded on the run and used to
+// detect redeliveries.
+var deliveryIDHeaders = []string{"X-GitHub-Delivery", "X-Delivery-ID", "Idempotency-Key", "X-Request-ID"}
+
+// Webhook handles a request posted to a task's webhook URL by starting a run of the task with the payload as input.
+func (t *TaskHandler) Webhook(req api.Context) error {
+	var webhook v1.Webhook
+	if err := req.Storage.Get(req.Context(), kclient.ObjectKey{Namespace: req.PathValue("namespace"), Name: req.PathValue("id")}, &webhook); apierrors.IsNotFound(err) {
+		return types.NewErrNotFound("webhook not found")
+	} else if err != nil {
+		return err
+	}
+	if !webhook.DeletionTimestamp.IsZero() {
+		return types.NewErrNotFound("webhook not found")
+	}
+
+	body, err := req.Body()
+	if err != nil {
+		return err
+	}
+
+	if webhook.Spec.SignatureScheme != "" {
+		secret, err := revealWebhookSecret(req, &webhook)
+		if err != nil {
+			return err
+		}
+		if err := verifyWebhookSignature(webhook.Spec.SignatureScheme, webhook.Spec.ValidationHeader, secret, req.Request.Header, body, time.Now()); err != nil {
+			return types.NewErrHTTP(http.StatusUnauthorized, err.Error())
+		}
+	}
+
+	var payload any
+	if len(body) > 0 {
+		// Payloads don't have to be JSON, but filters can only match JSON payloads.
+		_ = json.Unmarshal(body, &payload)
+	}
+
+	now := time.Now()
+	delivery := types.TaskWebhookDelivery{
+		DeliveryID: webhookDeliveryID(req.Request.Header, payload),
+		Event:      webhookEvent(req.Request.Header, payload),
+		ReceivedAt: *types.NewTime(now),
+	}
+
+	if !matchWebhookFilters(webhook.Spec.Filters, payload) {
+		// Respond with success so that the sender doesn't retry events that are intentionally ignored.
+		return req.Write(delivery)
+	}
+
+	// Only deliveries that the sender identifies are deduplicated, because senders can post the same body for separate
+	// events. Runs of identified deliveries are named after the delivery ID, the body and the window the delivery was
+	// received in, and a delivery that was received in the previous window is still a redelivery if it is recent enough.
+	wfe := v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.WorkflowExecutionPrefix,
+			Namespace:    webhook.Namespace,
+		},
+		Spec: v1.WorkflowExecutionSpec{
+			Input:        string(body),
+			ThreadName:   webhook.Spec.ThreadName,
+			WorkflowName: webhook.Spec.WorkflowName,
+			WebhookName:  webhook.Name,
+			WebhookDelivery: &v1.WebhookDelivery{
+				DeliveryID: delivery.DeliveryID,
+				Event:      delivery.Event,
+				ReceivedAt: metav1.NewTime(now),
+			},
+		},
+	}
+	if delivery.DeliveryID != "" {
+		key := hash.String([]string{delivery.DeliveryID, string(body)})
+		window := now.Unix() / int64(webhookReplayWindow.Seconds())
+		if previous, err := getWebhookRun(req, &webhook, key, window-1); err != nil {
+			return err
+		} else if previous != nil && previous.Spec.WebhookDelivery != nil && now.Sub(previous.Spec.WebhookDelivery.ReceivedAt.Time) < webhookReplayWindow {
+			delivery.RunID = previous.Name
+			return req.Write(delivery)
+		}
+		wfe.Name = webhookRunName(&webhook, key, window)
+	}
+
+	if err := req.Storage.Create(req.Context(), &wfe); apierrors.IsAlreadyExists(err) {
+		delivery.RunID = wfe.Name
+		return req.Write(delivery)
+	} else if err != nil {
+		return err
+	}
+
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := req.Storage.Get(req.Context(), kclient.ObjectKeyFromObject(&webhook), &webhook); err != nil {
+			return err
+		}
+		webhook.Status.LastDeliveryAt = &wfe.Spec.WebhookDelivery.ReceivedAt
+		return req.Storage.Status().Update(req.Context(), &webhook)
+	}); err != nil {
+		log.Warnf("failed to record delivery time of webhook %s: %v", webhook.Name, err)
+	}
+
+	delivery.RunID = wfe.Name
+	return req.WriteCreated(delivery)
+}
+
+func webhookRunName(webhook *v1.Webhook, key string, window int64) string {
+	return system.WorkflowExecutionPrefix + hash.String([]string{webhook.Name, key, strconv.FormatInt(window, 10)})[:12]
+}
+
+// getWebhookRun returns the run started by the delivery with the given key in the given window, or nil if there is none.
+func getWebhookRun(req api.Context, webhook *v1.Webhook, key string, window int64) (*v1.WorkflowExecution, error) {
+	var wfe v1.WorkflowExecution
+	if err := req.Storage.Get(req.Context(), kclient.ObjectKey{Namespace: webhook.Namespace, Name: webhookRunName(webhook, key, window)}, &wfe); apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &wfe, nil
+}
+
+// updateWebhook creates, updates, or deletes the webhook of the task to match the manifest.
+func (t *TaskHandler) updateWebhook(req api.Context, workflow *v1.Workflow, task types.TaskManifest, trigger *triggers) error {
+	webhook, err := getTaskWebhook(req, workflow)
+	if err != nil {
+		return err
+	}
+
+	if task.Webhook == nil {
+		if webhook != nil {
+			return req.Delete(webhook)
+		}
+		return nil
+	}
+
+	secret := task.Webhook.Secret
+	if secret == maskedWebhookSecret {
+		// The masked secret is returned by the API, so sending it back keeps the stored secret.
+		secret = ""
+	}
+
+	spec := v1.WebhookSpec{
+		WebhookManifest: task.Webhook.WebhookManifest,
+		ThreadName:      workflow.Spec.ThreadName,
+	}
+	spec.WorkflowName = workflow.Name
+	spec.Secret = ""
+	if err := validateWebhook(spec.WebhookManifest); err != nil {
+		return err
+	}
+	if spec.SignatureScheme == types.WebhookSignatureSchemeGeneric && spec.ValidationHeader == "" {
+		spec.ValidationHeader = defaultWebhookSignatureHeader
+	}
+
+	if webhook == nil {
+		if spec.SignatureScheme != "" && secret == "" {
+			return types.NewErrBadRequest("a secret is required to verify %s signatures", spec.SignatureScheme)
+		}
+
+		// The name is part of the URL, which is what protects webhooks that don't verify signatures.
+		token, err := randomtoken.Generate()
+		if err != nil {
+			return fmt.Errorf("failed to generate webhook name: %w", err)
+		}
+		webhook = &v1.Webhook{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       system.WebhookPrefix + token,
+				Namespace:  req.Namespace(),
+				Finalizers: []string{v1.WebhookFinalizer},
+			},
+			Spec: spec,
+		}
+		if err := req.Create(webhook); err != nil {
+			return err
+		}
+	} else {
+		spec.HasSecret = webhook.Spec.HasSecret
+		if spec.SignatureScheme != "" && secret == "" && !spec.HasSecret {
+			return types.NewErrBadRequest("a secret is required to verify %s signatures", spec.SignatureScheme)
+		}
+	}
+
+	switch {
+	case spec.SignatureScheme == "":
+		if err := req.GPTClient.DeleteCredential(req.Context(), webhook.Name, system.WebhookCredential); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+			return fmt.Errorf("failed to remove webhook secret: %w", err)
+		}
+		spec.HasSecret = false
+	case secret != "":
+		// Credentials can't be updated, so remove the existing secret first.
+		if err := req.GPTClient.DeleteCredential(req.Context(), webhook.Name, system.WebhookCredential); err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+			return fmt.Errorf("failed to remove webhook secret: %w", err)
+		}
+		if err := req.GPTClient.CreateCredential(req.Context(), gptscript.Credential{
+			Context:  webhook.Name,
+			ToolName: system.WebhookCredential,
+			Type:     gptscript.CredentialTypeTool,
+			Env: map[string]string{
+				"secret": secret,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to store webhook secret: %w", err)
+		}
+		spec.HasSecret = true
+	}
+
+	trigger.Webhook = webhook
+	if !equality.Semantic.DeepEqual(webhook.Spec, spec) {
+		webhook.Spec = spec
+		return req.Update(webhook)
+	}
+
+	return nil
+}
+
+func validateWebhook(manifest types.WebhookManifest) error {
+	switch manifest.SignatureScheme {
+	case "", types.WebhookSignatureSchemeGitHub, types.WebhookSignatureSchemeStripe, types.WebhookSignatureSchemeGeneric:
+	default:
+		return types.NewErrBadRequest("invalid webhook signature scheme %q", manifest.SignatureScheme)
+	}
+	if manifest.ValidationHeader != "" && manifest.SignatureScheme != types.WebhookSignatureSchemeGeneric {
+		return types.NewErrBadRequest("a validation header can only be set for the generic signature scheme")
+	}
+	for _, filter := range manifest.Filters {
+		if filter.Path == "" {
+			return types.NewErrBadRequest("webhook filters must have a path")
+		}
+	}
+	return nil
+}
+
+func getTaskWebhook(req api.Context, workflow *v1.Workflow) (*v1.Webhook, error) {
+	var webhooks v1.WebhookList
+	if err := req.List(&webhooks, kclient.MatchingFields{
+		"spec.workflowName": workflow.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, webhook := range webhooks.Items {
+		if webhook.DeletionTimestamp.IsZero() {
+			return &webhook, nil
+		}
+	}
+	return nil, nil
+}
+
+func revealWebhookSecret(req api.Context, webhook *v1.Webhook) (string, error) {
+	cred, err := req.GPTClient.RevealCredential(req.Context(), []string{webhook.Name}, system.WebhookCredential)
+	if errors.As(err, &gptscript.ErrNotFound{}) {
+		return "", types.NewErrHTTP(http.StatusUnauthorized, "webhook has no secret to verify the signature")
+	} else if err != nil {
+		return "", fmt.Errorf("failed to reveal webhook secret: %w", err)
+	}
+	return cred.Env["secret"], nil
+}
+
+// verifyWebhookSignature checks that the body was signed with the secret using the given scheme.
+func verifyWebhookSignature(scheme types.WebhookSignatureScheme, header, secret string, headers http.Header, body []byte, now time.Time) error {
+	if secret == "" {
+		return errors.New("webhook has no secret to verify the signature")
+	}
+
+	switch scheme {
+	case types.WebhookSignatureSchemeGitHub:
+		signature, ok := strings.CutPrefix(headers.Get("X-Hub-Signature-256"), "sha256=")
+		if !ok {
+			return errors.New("missing X-Hub-Signature-256 header")
+		}
+		if !validHMAC(secret, body, signature) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	case types.WebhookSignatureSchemeStripe:
+		return verifyStripeSignature(secret, headers.Get("Stripe-Signature"), body, now)
+	case types.WebhookSignatureSchemeGeneric:
+		if header == "" {
+			header = defaultWebhookSignatureHeader
+		}
+		signature := headers.Get(header)
+		if signature == "" {
+			return fmt.Errorf("missing %s header", header)
+		}
+		if !validHMAC(secret, body, strings.TrimPrefix(signature, "sha256=")) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unknown signature scheme %q", scheme)
+}
+
+// verifyStripeSignature checks a header of the form "t=<unix time>,v1=<signature>", where the signature is of
+// "<unix time>.<body>". The timestamp must be recent to prevent replays.
+func verifyStripeSignature(secret, header string, body []byte, now time.Time) error {
+	if header == "" {
+		return errors.New("missing Stripe-Signature header")
+	}
+
+	var (
+		timestamp  string
+		signatures []string
+	)
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.New("invalid timestamp in Stripe-Signature header")
+	}
+	if age := now.Sub(time.Unix(seconds, 0)); age > stripeSignatureTolerance || age < -stripeSignatureTolerance {
+		return errors.New("timestamp in Stripe-Signature header is outside of the tolerance")
+	}
+
+	signed := append([]byte(timestamp+"."), body...)
+	for _, signature := range signatures {
+		if validHMAC(secret, signed, signature) {
+			return nil
+		}
+	}
+	return errors.New("invalid signature")
+}
+
+func validHMAC(secret string, data []byte, signature string) bool {
+	expected, err := hex.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(data)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// matchWebhookFilters returns true if every filter matches the payload.
+func matchWebhookFilters(filters []types.WebhookFilter, payload any) bool {
+	for _, filter := range filters {
+		value, ok := lookupJSONPath(payload, filter.Path)
+		if !ok {
+			return false
+		}
+		if len(filter.Values) == 0 {
+			continue
+		}
+
+		actual, ok := value.(string)
+		if !ok {
+			data, err := json.Marshal(value)
+			if err != nil {
+				return false
+			}
+			actual = string(data)
+		}
+
+		if !slices.Contains(filter.Values, actual) {
+			return false
+		}
+	}
+	return true
+}
+
+// lookupJSONPath follows a dot separated path through objects and, using numeric segments, arrays.
+func lookupJSONPath(payload any, path string) (any, bool) {
+	current := payload
+	for _, segment := range strings.Split(path, ".") {
+		switch v := current.(type) {
+		case map[string]any:
+			next, ok := v[segment]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []any:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			current = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func webhookDeliveryID(headers http.Header, payload any) string {
+	for _, header := range deliveryIDHeaders {
+		if id := headers.Get(header); id != "" {
+			return id
+		}
+	}
+	if headers.Get("Stripe-Signature") != "" {
+		// Stripe identifies events by the ID in the payload.
+		if id, ok := lookupJSONPath(payload, "id"); ok {
+			if id, ok := id.(string); ok {
+				return id
+			}
+		}
+	}
+	return ""
+}
+
+func webhookEvent(headers http.Header, payload any) string {
+	if event := headers.Get("X-GitHub-Event"); event != "" {
+		return event
+	}
+	if event, ok := lookupJSONPath(payload, "type"); ok {
+		if event, ok := event.(string); ok {
+			return event
+		}
+	}
+	return ""
+}
+
+func convertTaskWebhook(webhook *v1.Webhook, serverURL string) *types.OnWebhook {
+	result := &types.OnWebhook{
+		WebhookManifest: webhook.Spec.WebhookManifest,
+		URL:             fmt.Sprintf("%s/api/webhooks/%s/%s", serverURL, webhook.Namespace, webhook.Name),
+	}
+	if webhook.Spec.HasSecret {
+		result.Secret = maskedWebhookSecret
+	}
+	return result
+}
+
+func convertTaskWebhookDelivery(wfe *v1.WorkflowExecution) *types.TaskWebhookDelivery {
+	if wfe.Spec.WebhookDelivery == nil {
+		return nil
+	}
+	return &types.TaskWebhookDelivery{
+		RunID:      wfe.Name,
+		DeliveryID: wfe.Spec.WebhookDelivery.DeliveryID,
+		Event:      wfe.Spec.WebhookDelivery.Event,
+		ReceivedAt: *types.NewTime(wfe.Spec.WebhookDelivery.ReceivedAt.Time),
+	}
+}
@@ -0,0 +1,260 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/hash"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func sign(secret, data string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(data))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	var (
+		secret = "shh"
+		body   = `{"action":"opened"}`
+		now    = time.Unix(1700000000, 0)
+		stamp  = strconv.FormatInt(now.Unix(), 10)
+	)
+
+	tests := []struct {
+		name    string
+		scheme  types.WebhookSignatureScheme
+		header  string
+		headers map[string]string
+		wantErr bool
+	}{
+		{
+			name:    "github",
+			scheme:  types.WebhookSignatureSchemeGitHub,
+			headers: map[string]string{"X-Hub-Signature-256": "sha256=" + sign(secret, body)},
+		},
+		{
+			name:    "github wrong secret",
+			scheme:  types.WebhookSignatureSchemeGitHub,
+			headers: map[string]string{"X-Hub-Signature-256": "sha256=" + sign("other", body)},
+			wantErr: true,
+		},
+		{
+			name:    "github missing header",
+			scheme:  types.WebhookSignatureSchemeGitHub,
+			wantErr: true,
+		},
+		{
+			name:    "stripe",
+			scheme:  types.WebhookSignatureSchemeStripe,
+			headers: map[string]string{"Stripe-Signature": "t=" + stamp + ",v1=bad,v1=" + sign(secret, stamp+"."+body)},
+		},
+		{
+			name:    "stripe expired",
+			scheme:  types.WebhookSignatureSchemeStripe,
+			headers: map[string]string{"Stripe-Signature": "t=1600000000,v1=" + sign(secret, "1600000000."+body)},
+			wantErr: true,
+		},
+		{
+			name:    "generic default header",
+			scheme:  types.WebhookSignatureSchemeGeneric,
+			headers: map[string]string{"X-Signature": sign(secret, body)},
+		},
+		{
+			name:    "generic custom header with prefix",
+			scheme:  types.WebhookSignatureSchemeGeneric,
+			header:  "X-Custom-Signature",
+			headers: map[string]string{"X-Custom-Signature": "sha256=" + sign(secret, body)},
+		},
+		{
+			name:    "generic wrong header",
+			scheme:  types.WebhookSignatureSchemeGeneric,
+			header:  "X-Custom-Signature",
+			headers: map[string]string{"X-Signature": sign(secret, body)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers := http.Header{}
+			for k, v := range tt.headers {
+				headers.Set(k, v)
+			}
+			err := verifyWebhookSignature(tt.scheme, tt.header, secret, headers, []byte(body), now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("verifyWebhookSignature() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMatchWebhookFilters(t *testing.T) {
+	var payload any
+	if err := json.Unmarshal([]byte(`{"action":"opened","pull_request":{"draft":false,"base":{"ref":"main"}},"labels":[{"name":"bug"}]}`), &payload); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		filters []types.WebhookFilter
+		want    bool
+	}{
+		{
+			name: "no filters",
+			want: true,
+		},
+		{
+			name:    "matching values",
+			filters: []types.WebhookFilter{{Path: "action", Values: []string{"opened", "reopened"}}, {Path: "pull_request.base.ref", Values: []string{"main"}}},
+			want:    true,
+		},
+		{
+			name:    "non-string value",
+			filters: []types.WebhookFilter{{Path: "pull_request.draft", Values: []string{"false"}}},
+			want:    true,
+		},
+		{
+			name:    "array index",
+			filters: []types.WebhookFilter{{Path: "labels.0.name", Values: []string{"bug"}}},
+			want:    true,
+		},
+		{
+			name:    "path exists",
+			filters: []types.WebhookFilter{{Path: "pull_request.base"}},
+			want:    true,
+		},
+		{
+			name:    "value does not match",
+			filters: []types.WebhookFilter{{Path: "action", Values: []string{"closed"}}},
+		},
+		{
+			name:    "missing path",
+			filters: []types.WebhookFilter{{Path: "issue.number"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchWebhookFilters(tt.filters, payload); got != tt.want {
+				t.Errorf("matchWebhookFilters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func postWebhook(t *testing.T, storage client.WithWatch, body string, headers map[string]string) (types.TaskWebhookDelivery, int) {
+	t.Helper()
+
+	req, rec := newTestContext(storage, http.MethodPost, "/api/webhooks/default/wh1test", []byte(body), "namespace", system.DefaultNamespace, "id", "wh1test")
+	for k, v := range headers {
+		req.Request.Header.Set(k, v)
+	}
+	if err := (&TaskHandler{}).Webhook(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var delivery types.TaskWebhookDelivery
+	if err := json.Unmarshal(rec.Body.Bytes(), &delivery); err != nil {
+		t.Fatal(err)
+	}
+	return delivery, rec.Code
+}
+
+func TestWebhook(t *testing.T) {
+	webhook := &v1.Webhook{
+		ObjectMeta: metav1.ObjectMeta{Name: "wh1test", Namespace: system.DefaultNamespace},
+		Spec: v1.WebhookSpec{
+			WebhookManifest: types.WebhookManifest{
+				WorkflowName: "w1triage",
+				Filters:      []types.WebhookFilter{{Path: "action", Values: []string{"opened"}}},
+			},
+			ThreadName: "t1project",
+		},
+	}
+
+	now := time.Now()
+	window := now.Unix() / int64(webhookReplayWindow.Seconds())
+	deliveryKey := func(id, body string) string {
+		return hash.String([]string{id, body})
+	}
+	previousRun := func(id, body string, receivedAt time.Time) *v1.WorkflowExecution {
+		return &v1.WorkflowExecution{
+			ObjectMeta: metav1.ObjectMeta{Name: webhookRunName(webhook, deliveryKey(id, body), window-1), Namespace: system.DefaultNamespace},
+			Spec: v1.WorkflowExecutionSpec{
+				WorkflowName:    "w1triage",
+				WebhookName:     webhook.Name,
+				WebhookDelivery: &v1.WebhookDelivery{DeliveryID: id, ReceivedAt: metav1.NewTime(receivedAt)},
+			},
+		}
+	}
+
+	storage := newTestStorage(t, webhook,
+		previousRun("recent", `{"action":"opened","number":2}`, now.Add(-time.Hour)),
+		previousRun("expired", `{"action":"opened","number":3}`, now.Add(-webhookReplayWindow-time.Hour)),
+	)
+
+	first, code := postWebhook(t, storage, `{"action":"opened","number":1}`, map[string]string{"X-GitHub-Delivery": "a", "X-GitHub-Event": "issues"})
+	if code != http.StatusCreated || first.RunID == "" || first.DeliveryID != "a" || first.Event != "issues" {
+		t.Fatalf("expected a run to be started, got %d: %+v", code, first)
+	}
+
+	var wfe v1.WorkflowExecution
+	if err := storage.Get(t.Context(), client.ObjectKey{Namespace: system.DefaultNamespace, Name: first.RunID}, &wfe); err != nil {
+		t.Fatal(err)
+	}
+	if wfe.Spec.Input != `{"action":"opened","number":1}` || wfe.Spec.WorkflowName != "w1triage" || wfe.Spec.WebhookName != webhook.Name {
+		t.Errorf("unexpected run: %+v", wfe.Spec)
+	}
+
+	redelivery, code := postWebhook(t, storage, `{"action":"opened","number":1}`, map[string]string{"X-GitHub-Delivery": "a"})
+	if code != http.StatusOK || redelivery.RunID != first.RunID {
+		t.Errorf("expected the redelivery to return the first run, got %d: %+v", code, redelivery)
+	}
+
+	second, code := postWebhook(t, storage, `{"action":"opened","number":1}`, map[string]string{"X-GitHub-Delivery": "b"})
+	if code != http.StatusCreated || second.RunID == first.RunID {
+		t.Errorf("expected a new delivery of the same body to start a run, got %d: %+v", code, second)
+	}
+
+	// Senders that don't identify their deliveries can post the same body for every event.
+	for range 2 {
+		if unidentified, code := postWebhook(t, storage, `{"action":"opened"}`, nil); code != http.StatusCreated || unidentified.RunID == "" {
+			t.Errorf("expected each unidentified delivery to start a run, got %d: %+v", code, unidentified)
+		}
+	}
+
+	recent, code := postWebhook(t, storage, `{"action":"opened","number":2}`, map[string]string{"X-Delivery-ID": "recent"})
+	if code != http.StatusOK || recent.RunID != webhookRunName(webhook, deliveryKey("recent", `{"action":"opened","number":2}`), window-1) {
+		t.Errorf("expected a delivery received in the previous window to be a redelivery, got %d: %+v", code, recent)
+	}
+
+	expired, code := postWebhook(t, storage, `{"action":"opened","number":3}`, map[string]string{"X-Delivery-ID": "expired"})
+	if code != http.StatusCreated || expired.RunID != webhookRunName(webhook, deliveryKey("expired", `{"action":"opened","number":3}`), window) {
+		t.Errorf("expected a delivery received before the replay window to start a run, got %d: %+v", code, expired)
+	}
+
+	ignored, code := postWebhook(t, storage, `{"action":"closed","number":1}`, nil)
+	if code != http.StatusOK || ignored.RunID != "" {
+		t.Errorf("expected a payload that doesn't match the filters to be ignored, got %d: %+v", code, ignored)
+	}
+
+	var runs v1.WorkflowExecutionList
+	if err := storage.List(t.Context(), &runs); err != nil {
+		t.Fatal(err)
+	}
+	if len(runs.Items) != 7 {
+		t.Errorf("expected 5 runs to be started, got %d runs", len(runs.Items)-2)
+	}
+}
@@ -80,22 +80,24 @@ func convertThread(thread v1.Thread) types.Thread {
 	}
 
 	return types.Thread{
-		Metadata:       MetadataFrom(&thread),
-		ThreadManifest: thread.Spec.Manifest,
-		AssistantID:    thread.Spec.AgentName,
-		TaskID:         thread.Spec.WorkflowName,
-		TaskRunID:      thread.Spec.WorkflowExecutionName,
-		LastRunID:      thread.Status.LastRunName,
-		CurrentRunID:   thread.Status.CurrentRunName,
-		State:          state,
-		ProjectID:      strings.Replace(thread.Spec.ParentThreadName, system.ThreadPrefix, system.ProjectPrefix, 1),
-		UserID:         thread.Spec.UserID,
-		Abort:          thread.Spec.Abort,
-		SystemTask:     thread.Spec.SystemTask,
-		Ephemeral:      thread.Spec.Ephemeral,
-		Project:        thread.Spec.Project,
-		Env:            env,
-		Ready:          thread.Status.Created,
+		Metadata:           MetadataFrom(&thread),
+		ThreadManifest:     thread.Spec.Manifest,
+		AssistantID:        thread.Spec.AgentName,
+		TaskID:             thread.Spec.WorkflowName,
+		TaskRunID:          thread.Spec.WorkflowExecutionName,
+		LastRunID:          thread.Status.LastRunName,
+		CurrentRunID:       thread.Status.CurrentRunName,
+		State:              state,
+		ProjectID:          strings.Replace(thread.Spec.ParentThreadName, system.ThreadPrefix, system.ProjectPrefix, 1),
+		UserID:             thread.Spec.UserID,
+		Abort:              thread.Spec.Abort,
+		SystemTask:         thread.Spec.SystemTask,
+		Ephemeral:          thread.Spec.Ephemeral,
+		Project:            thread.Spec.Project,
+		Env:                env,
+		Ready:              thread.Status.Created,
+		ForkedFromThreadID: thread.Spec.ForkedFromThreadName,
+		ForkedFromRunID:    thread.Spec.ForkedFromRunName,
 	}
 }
 
@@ -198,6 +200,10 @@ func (a *ThreadHandler) Update(req api.Context) error {
 		return err
 	}
 
+	if err := validateTemperature(newThread.Temperature); err != nil {
+		return err
+	}
+
 	// Don't allow update of tools here, do it with the /tools endpoint
 	newThread.Tools = existing.Spec.Manifest.Tools
 	// Don't allow update of allowed MCP tools here, do it with the mcpservers/{mcp_server_id}/tools endpoint
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ToolApprovalPolicyHandler struct{}
+
+func NewToolApprovalPolicyHandler() *ToolApprovalPolicyHandler {
+	return &ToolApprovalPolicyHandler{}
+}
+
+// List returns all tool approval policies.
+func (*ToolApprovalPolicyHandler) List(req api.Context) error {
+	var list v1.ToolApprovalPolicyList
+	if err := req.List(&list); err != nil {
+		return fmt.Errorf("failed to list tool approval policies: %w", err)
+	}
+
+	items := make([]types.ToolApprovalPolicy, 0, len(list.Items))
+	for _, item := range list.Items {
+		items = append(items, convertToolApprovalPolicy(item))
+	}
+
+	return req.Write(types.ToolApprovalPolicyList{
+		Items: items,
+	})
+}
+
+// Get returns a specific tool approval policy by ID.
+func (*ToolApprovalPolicyHandler) Get(req api.Context) error {
+	policyID := req.PathValue("id")
+
+	var policy v1.ToolApprovalPolicy
+	if err := req.Get(&policy, policyID); err != nil {
+		return fmt.Errorf("failed to get tool approval policy: %w", err)
+	}
+
+	return req.Write(convertToolApprovalPolicy(policy))
+}
+
+// Create creates a new tool approval policy.
+func (h *ToolApprovalPolicyHandler) Create(req api.Context) error {
+	var manifest types.ToolApprovalPolicyManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read tool approval policy manifest: %v", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return types.NewErrBadRequest("invalid tool approval policy manifest: %v", err)
+	}
+
+	policy := v1.ToolApprovalPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.ToolApprovalPolicyPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.ToolApprovalPolicySpec{
+			Manifest: manifest,
+		},
+	}
+
+	if err := req.Create(&policy); err != nil {
+		return fmt.Errorf("failed to create tool approval policy: %w", err)
+	}
+
+	return req.Write(convertToolApprovalPolicy(policy))
+}
+
+// Update updates an existing tool approval policy.
+func (h *ToolApprovalPolicyHandler) Update(req api.Context) error {
+	policyID := req.PathValue("id")
+
+	var manifest types.ToolApprovalPolicyManifest
+	if err := req.Read(&manifest); err != nil {
+		return types.NewErrBadRequest("failed to read tool approval policy manifest: %v", err)
+	}
+
+	if err := manifest.Validate(); err != nil {
+		return types.NewErrBadRequest("invalid tool approval policy manifest: %v", err)
+	}
+
+	var existing v1.ToolApprovalPolicy
+	if err := req.Get(&existing, policyID); err != nil {
+		return types.NewErrBadRequest("failed to get tool approval policy: %v", err)
+	}
+
+	existing.Spec.Manifest = manifest
+	if err := req.Update(&existing); err != nil {
+		return fmt.Errorf("failed to update tool approval policy: %w", err)
+	}
+
+	return req.Write(convertToolApprovalPolicy(existing))
+}
+
+// Delete deletes a tool approval policy.
+func (*ToolApprovalPolicyHandler) Delete(req api.Context) error {
+	policyID := req.PathValue("id")
+
+	return req.Delete(&v1.ToolApprovalPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      policyID,
+			Namespace: req.Namespace(),
+		},
+	})
+}
+
+func convertToolApprovalPolicy(policy v1.ToolApprovalPolicy) types.ToolApprovalPolicy {
+	return types.ToolApprovalPolicy{
+		Metadata:                   MetadataFrom(&policy),
+		ToolApprovalPolicyManifest: policy.Spec.Manifest,
+	}
+}
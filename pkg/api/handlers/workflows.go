@@ -28,6 +28,10 @@ func (a *WorkflowHandler) Update(req api.Context) error {
 		return err
 	}
 
+	if err := workflow.ValidateSteps(manifest.Steps); err != nil {
+		return types.NewErrBadRequest("invalid workflow: %v", err)
+	}
+
 	manifest = workflow.PopulateIDs(manifest)
 
 	if err := req.Get(&wf, id); err != nil {
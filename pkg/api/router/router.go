@@ -10,8 +10,10 @@ import (
 	"github.com/obot-platform/obot/pkg/api/handlers/registry"
 	"github.com/obot-platform/obot/pkg/api/handlers/setup"
 	"github.com/obot-platform/obot/pkg/api/handlers/wellknown"
+	"github.com/obot-platform/obot/pkg/metrics"
 	"github.com/obot-platform/obot/pkg/services"
 	"github.com/obot-platform/obot/ui"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"k8s.io/component-base/metrics/legacyregistry"
 )
@@ -38,20 +40,23 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	agents := handlers.NewAgentHandler(services.ProviderDispatcher, services.MCPLoader, services.Invoker, services.ServerURL, services.InternalServerURL)
 	assistants := handlers.NewAssistantHandler(services.ProviderDispatcher, services.MCPLoader, services.Invoker, services.Events, services.Router.Backend())
 	tools := handlers.NewToolHandler(services.Invoker)
-	tasks := handlers.NewTaskHandler(services.Invoker, services.Events)
+	tasks := handlers.NewTaskHandler(services.Invoker, services.Events, services.ServerURL)
+	evals := handlers.NewEvalHandler()
 	invoker := handlers.NewInvokeHandler(services.Invoker, services.MCPLoader)
 	threads := handlers.NewThreadHandler(services.ProviderDispatcher, services.Events, services.ModelAccessPolicyHelper)
 	runs := handlers.NewRunHandler(services.Events)
 	toolRefs := handlers.NewToolReferenceHandler()
 	cronJobs := handlers.NewCronJobHandler()
 	models := handlers.NewModelHandler(services.ModelAccessPolicyHelper)
-	mcpCatalogs := handlers.NewMCPCatalogHandler(services.DefaultMCPCatalogPath, services.ServerURL, services.MCPLoader, oauthChecker, services.GatewayClient, services.AccessControlRuleHelper)
+	mcpCatalogs := handlers.NewMCPCatalogHandler(services.DefaultMCPCatalogPath, services.ServerURL, services.MCPLoader, oauthChecker, services.GatewayClient, services.AccessControlRuleHelper, services.WorkspaceEntryApprovalEnabled)
 	accessControlRules := handlers.NewAccessControlRuleHandler()
 	powerUserWorkspaces := handlers.NewPowerUserWorkspaceHandler(services.ServerURL, services.AccessControlRuleHelper)
 	mcpWebhookValidations := handlers.NewMCPWebhookValidationHandler()
+	configBundles := handlers.NewConfigBundleHandler()
 	availableModels := handlers.NewAvailableModelsHandler(services.ProviderDispatcher)
 	modelProviders := handlers.NewModelProviderHandler(services.ProviderDispatcher, services.Invoker)
 	modelAccessPolicies := handlers.NewModelAccessPolicyHandler()
+	toolApprovalPolicies := handlers.NewToolApprovalPolicyHandler()
 	authProviders := handlers.NewAuthProviderHandler(services.ProviderDispatcher, services.PostgresDSN)
 	fileScannerProviders := handlers.NewFileScannerProviderHandler(services.ProviderDispatcher, services.Invoker)
 	prompt := handlers.NewPromptHandler()
@@ -76,10 +81,14 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	setupHandler := setup.NewHandler(services.ServerURL)
 	registryHandler := registry.NewHandler(services.AccessControlRuleHelper, services.ServerURL, services.RegistryNoAuth)
 	oauthClients := handlers.NewOAuthClientsHandler(services.OAuthServerConfig, services.ServerURL)
+	openAPI := handlers.NewOpenAPIHandler(services.ServerURL, mux.Routes)
 
 	// Version
 	mux.HandleFunc("GET /api/version", version.GetVersion)
 
+	// OpenAPI document
+	mux.HandleFunc("GET /api/openapi.json", openAPI.Get)
+
 	// Agents
 	mux.HandleFunc("POST /api/agents", agents.Create)
 	mux.HandleFunc("GET /api/agents", agents.List)
@@ -129,6 +138,7 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("POST /api/assistants/{id}/projects/{project_id}/threads/{thread_id}/abort", assistants.Abort)
 	mux.HandleFunc("GET /api/assistants/{id}/projects/{project_id}/threads/{thread_id}/events", assistants.Events)
 	mux.HandleFunc("POST /api/assistants/{id}/projects/{project_id}/threads/{thread_id}/invoke", assistants.Invoke)
+	mux.HandleFunc("POST /api/assistants/{id}/projects/{project_id}/threads/{thread_id}/fork", assistants.Fork)
 	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/threads/{thread_id}/confirm", confirm.Confirm)
 
 	// Project tools
@@ -175,8 +185,26 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("POST /api/tasks/{id}/runs/{run_id}/abort", tasks.AbortRun)
 	mux.HandleFunc("POST /api/tasks/{id}/runs/{run_id}/events", tasks.Abort)
 	mux.HandleFunc("GET /api/tasks/{id}/runs/{run_id}/events", tasks.Events)
+	mux.HandleFunc("POST /api/tasks/{id}/runs/{run_id}/steps/{step_id}/approve", tasks.ApproveRun)
+	mux.HandleFunc("POST /api/tasks/{id}/runs/{run_id}/steps/{step_id}/reject", tasks.RejectRun)
+	mux.HandleFunc("GET /api/task-approvals", tasks.ListApprovals)
+	mux.HandleFunc("POST /api/task-approvals/{run_id}/steps/{step_id}/approve", tasks.Approve)
+	mux.HandleFunc("POST /api/task-approvals/{run_id}/steps/{step_id}/reject", tasks.Reject)
+	mux.HandleFunc("POST /api/webhooks/{namespace}/{id}", tasks.Webhook)
 	//
 
+	// Evals
+	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets", evals.ListDatasets)
+	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets", evals.CreateDataset)
+	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}", evals.GetDataset)
+	mux.HandleFunc("PUT /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}", evals.UpdateDataset)
+	mux.HandleFunc("DELETE /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}", evals.DeleteDataset)
+	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}/compare", evals.Compare)
+	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}/runs", evals.ListRuns)
+	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}/runs", evals.CreateRun)
+	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}/runs/{eval_run_id}", evals.GetRun)
+	mux.HandleFunc("DELETE /api/assistants/{assistant_id}/projects/{project_id}/eval-datasets/{eval_dataset_id}/runs/{eval_run_id}", evals.DeleteRun)
+
 	// Project Tasks
 	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/tasks", tasks.CreateFromScope)
 	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/tasks", tasks.ListFromScope)
@@ -191,6 +219,8 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}/abort", tasks.AbortRunFromScope)
 	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}/events", tasks.AbortFromScope)
 	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}/events", tasks.EventsFromScope)
+	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}/steps/{step_id}/approve", tasks.ApproveRunFromScope)
+	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}/steps/{step_id}/reject", tasks.RejectRunFromScope)
 	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/file/{file...}", files.GetFile)
 	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/files/{file...}", files.GetFile)
 	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/file/{file...}", files.UploadFile)
@@ -436,6 +466,7 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}", mcpCatalogs.Get)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/categories", mcpCatalogs.ListCategoriesForCatalog)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/refresh", mcpCatalogs.Refresh)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/refresh-preview", mcpCatalogs.PreviewRefresh)
 	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}", mcpCatalogs.Update)
 
 	// MCPServerCatalogEntries (admin only, for single-user and remote MCP servers)
@@ -466,6 +497,8 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("PUT /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}", mcp.UpdateServer)
 	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}", mcp.DeleteServer)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/launch", mcp.LaunchServer)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/logs", mcp.StreamServerLogs)
+	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/restart", mcp.RestartServerDeployment)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/check-oauth", mcp.CheckOAuth)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/oauth-url", mcp.GetOAuthURL)
 	mux.HandleFunc("DELETE /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/oauth", mcp.ClearOAuthCredentials)
@@ -475,6 +508,8 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/instances", serverInstances.ListServerInstancesForServer)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/k8s-settings-status", mcp.CheckK8sSettingsStatus)
 	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/redeploy-with-k8s-settings", mcp.RedeployWithK8sSettings)
+	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/tool-changes", mcp.GetToolChanges)
+	mux.HandleFunc("POST /api/mcp-catalogs/{catalog_id}/servers/{mcp_server_id}/tool-changes/approve", mcp.ApproveTools)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers-needing-k8s-update", mcp.ListServersNeedingK8sUpdateInCatalog)
 	mux.HandleFunc("GET /api/mcp-catalogs/{catalog_id}/servers/all-instances", mcp.ListServerInstances)
 
@@ -494,6 +529,7 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/workspaces/all-entries/all-servers", powerUserWorkspaces.ListAllServersForAllEntries)
 	mux.HandleFunc("GET /api/workspaces/all-access-control-rules", powerUserWorkspaces.ListAllAccessControlRules)
 	mux.HandleFunc("GET /api/workspaces/all-servers/all-instances", powerUserWorkspaces.ListAllServerInstances)
+	mux.HandleFunc("GET /api/workspaces/pending-entries", mcpCatalogs.ListPendingEntries)
 
 	// Workspace-scoped Access Control Rules (PowerUserPlus only)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/access-control-rules", accessControlRules.List)
@@ -519,6 +555,10 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/generate-tool-previews", mcpCatalogs.GenerateToolPreviews)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/generate-tool-previews/oauth-url", mcpCatalogs.GenerateToolPreviewsOAuthURL)
 
+	// Workspace-scoped MCP Server Catalog Entry Approvals (review is admin only)
+	mux.HandleFunc("GET /api/workspaces/{workspace_id}/entries/{entry_id}/approval", mcpCatalogs.GetEntryApproval)
+	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/approval", mcpCatalogs.ReviewEntry)
+
 	// Workspace-scoped MCP Server Catalog Entry OAuth Credentials (PowerUser and higher only)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/entries/{entry_id}/oauth-credentials", mcpCatalogs.GetOAuthCredentials)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/entries/{entry_id}/oauth-credentials", mcpCatalogs.SetOAuthCredentials)
@@ -543,6 +583,8 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/k8s-settings-status", mcp.CheckK8sSettingsStatus)
 	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/redeploy-with-k8s-settings", mcp.RedeployWithK8sSettings)
 	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/instances", serverInstances.ListServerInstancesForServer)
+	mux.HandleFunc("GET /api/workspaces/{workspace_id}/servers/{mcp_server_id}/tool-changes", mcp.GetToolChanges)
+	mux.HandleFunc("POST /api/workspaces/{workspace_id}/servers/{mcp_server_id}/tool-changes/approve", mcp.ApproveTools)
 	mux.HandleFunc("GET /api/workspaces/servers-needing-k8s-update", mcp.ListServersNeedingK8sUpdateAcrossWorkspaces)
 
 	// MCP Webhook Validations (admin only)
@@ -553,6 +595,10 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("DELETE /api/mcp-webhook-validations/{mcp_webhook_validation_id}", mcpWebhookValidations.Delete)
 	mux.HandleFunc("DELETE /api/mcp-webhook-validations/{mcp_webhook_validation_id}/secret", mcpWebhookValidations.RemoveSecret)
 
+	// MCP configuration export and import (admin only)
+	mux.HandleFunc("GET /api/config-export", configBundles.Export)
+	mux.HandleFunc("POST /api/config-import", configBundles.Import)
+
 	// System MCP Servers (admin only)
 	mux.HandleFunc("GET /api/system-mcp-servers", systemMCPServers.List)
 	mux.HandleFunc("GET /api/system-mcp-servers/{id}", systemMCPServers.Get)
@@ -577,6 +623,9 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("GET /v0.1/servers/{serverName}/versions", registryHandler.ListServerVersions)
 	mux.HandleFunc("GET /v0.1/servers/{serverName}/versions/{version}", registryHandler.GetServerVersion)
 
+	// MCP client configuration for the servers of the user's registry
+	mux.HandleFunc("POST /api/mcp-client-config", registryHandler.ClientConfig)
+
 	// MCP Audit Logs
 	mux.HandleFunc("GET /api/mcp-audit-logs", mcpAuditLogs.ListAuditLogs)
 	mux.HandleFunc("POST /api/mcp-audit-logs", mcpAuditLogs.SubmitAuditLogs)
@@ -665,7 +714,7 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	}))
 
 	// Metrics
-	mux.HTTPHandle("GET /debug/metrics", promhttp.HandlerFor(legacyregistry.DefaultGatherer, promhttp.HandlerOpts{
+	mux.HTTPHandle("GET /debug/metrics", promhttp.HandlerFor(prometheus.Gatherers{legacyregistry.DefaultGatherer, metrics.Registry}, promhttp.HandlerOpts{
 		ErrorHandling: promhttp.HTTPErrorOnError,
 	}))
 
@@ -720,6 +769,13 @@ func Router(ctx context.Context, services *services.Services) (http.Handler, err
 	mux.HandleFunc("PUT /api/model-access-policies/{id}", modelAccessPolicies.Update)
 	mux.HandleFunc("DELETE /api/model-access-policies/{id}", modelAccessPolicies.Delete)
 
+	// Tool Approval Policies
+	mux.HandleFunc("GET /api/tool-approval-policies", toolApprovalPolicies.List)
+	mux.HandleFunc("GET /api/tool-approval-policies/{id}", toolApprovalPolicies.Get)
+	mux.HandleFunc("POST /api/tool-approval-policies", toolApprovalPolicies.Create)
+	mux.HandleFunc("PUT /api/tool-approval-policies/{id}", toolApprovalPolicies.Update)
+	mux.HandleFunc("DELETE /api/tool-approval-policies/{id}", toolApprovalPolicies.Delete)
+
 	// Available Models
 	mux.HandleFunc("GET /api/available-models", availableModels.List)
 	mux.HandleFunc("GET /api/available-models/{model_provider_id}", availableModels.ListForModelProvider)
@@ -0,0 +1,65 @@
+package router
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/openapi"
+)
+
+var route = regexp.MustCompile(`"((?:GET|POST|PUT|PATCH|DELETE) /api/[^"]*)"`)
+
+// TestOperationsAreRouted checks that the operations that the OpenAPI document and the API client describe match routes
+// of the API server or the gateway, so that the descriptions don't drift from the server.
+func TestOperationsAreRouted(t *testing.T) {
+	routes := map[string]bool{}
+	for _, r := range apiRoutes(t) {
+		routes[r] = true
+	}
+
+	for _, o := range openapi.Operations {
+		if r := o.Method + " /api" + o.Path; !routes[r] {
+			t.Errorf("operation %s has no route %s", o.ID, r)
+		}
+	}
+}
+
+// TestRoutesAreDocumented checks the reverse: every route of the API server and the gateway is an operation of the
+// OpenAPI document, either described or derived from the route.
+func TestRoutesAreDocumented(t *testing.T) {
+	routes := apiRoutes(t)
+
+	documented := map[string]bool{}
+	ids := map[string]bool{}
+	for _, o := range openapi.RouteOperations(openapi.Operations, routes) {
+		documented[o.Method+" /api"+o.Path] = true
+		if ids[o.ID] {
+			t.Errorf("operation ID %s is used more than once", o.ID)
+		}
+		ids[o.ID] = true
+	}
+
+	for _, r := range routes {
+		if r = strings.ReplaceAll(r, "...}", "}"); !documented[r] {
+			t.Errorf("route %s isn't in the OpenAPI document", r)
+		}
+	}
+}
+
+func apiRoutes(t *testing.T) []string {
+	t.Helper()
+
+	var routes []string
+	for _, file := range []string{"router.go", "../../gateway/server/router.go"} {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, match := range route.FindAllStringSubmatch(string(data), -1) {
+			routes = append(routes, match[1])
+		}
+	}
+	return routes
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/obot-platform/obot/pkg/proxy"
 	"github.com/obot-platform/obot/pkg/storage"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
@@ -43,6 +45,8 @@ type Server struct {
 	registryNoAuth bool
 
 	mux *http.ServeMux
+	// routes are the patterns of the registered handlers, in the order they were registered.
+	routes []string
 }
 
 func NewServer(storageClient storage.Client, gatewayClient *gclient.Client, gptClient *gptscript.GPTScript, authn *authn.Authenticator, authz *authz.Authorizer, proxyManager *proxy.Manager, auditLogger audit.Logger, rateLimiter *ratelimiter.RateLimiter, baseURL string, registryNoAuth bool) *Server {
@@ -63,6 +67,13 @@ func NewServer(storageClient storage.Client, gatewayClient *gclient.Client, gptC
 
 func (s *Server) HandleFunc(pattern string, f api.HandlerFunc) {
 	s.mux.Handle(pattern, s.Wrap(f))
+	s.routes = append(s.routes, pattern)
+}
+
+// Routes returns the patterns of the registered handlers. Handlers are registered when the server starts, so it must
+// only be called while requests are served.
+func (s *Server) Routes() []string {
+	return slices.Clone(s.routes)
 }
 
 func (s *Server) HTTPHandle(pattern string, f http.Handler) {
@@ -73,7 +84,13 @@ func (s *Server) HTTPHandle(pattern string, f http.Handler) {
 }
 
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	ctx, span := tracer.Start(r.Context(), "server")
+	// Clients must not choose the trace IDs and sampling decisions of Obot, so requests start a new trace that links to
+	// the trace of the client, if it sent one.
+	opts := []trace.SpanStartOption{trace.WithNewRoot()}
+	if remote := trace.SpanContextFromContext(otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))); remote.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: remote}))
+	}
+	ctx, span := tracer.Start(r.Context(), "server", opts...)
 	defer span.End()
 	s.mux.ServeHTTP(w, r.WithContext(ctx))
 }
@@ -0,0 +1,208 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// FormatVersion is the version of the archive layout. Restores refuse archives with a newer version.
+	FormatVersion = 1
+
+	manifestName = "manifest.json"
+)
+
+// Manifest describes the contents of an archive. It is the last entry of the archive, so that it can hold the checksums
+// of all other entries.
+type Manifest struct {
+	FormatVersion int       `json:"formatVersion"`
+	ObotVersion   string    `json:"obotVersion"`
+	CreatedAt     time.Time `json:"createdAt"`
+	// Database is the type of the backed up database, sqlite or postgres.
+	Database string          `json:"database"`
+	Files    []ManifestEntry `json:"files"`
+}
+
+type ManifestEntry struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// archiveWriter writes an encrypted, compressed tar archive and records the checksums of its entries.
+type archiveWriter struct {
+	encrypt  io.WriteCloser
+	gzip     *gzip.Writer
+	tar      *tar.Writer
+	manifest Manifest
+}
+
+func newArchiveWriter(out io.Writer, passphrase string, manifest Manifest) (*archiveWriter, error) {
+	encrypt, err := newEncryptWriter(out, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(encrypt)
+	return &archiveWriter{
+		encrypt:  encrypt,
+		gzip:     gz,
+		tar:      tar.NewWriter(gz),
+		manifest: manifest,
+	}, nil
+}
+
+// addFile adds an entry with the content of r, which must have size bytes.
+func (a *archiveWriter) addFile(name string, size int64, mode int64, modTime time.Time, r io.Reader) error {
+	if err := a.tar.WriteHeader(&tar.Header{
+		Name:     name,
+		Size:     size,
+		Mode:     mode,
+		ModTime:  modTime,
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(a.tar, h), r)
+	if err != nil {
+		return fmt.Errorf("failed to add %s to backup: %w", name, err)
+	}
+	if n != size {
+		return fmt.Errorf("failed to add %s to backup: the file changed while it was read", name)
+	}
+
+	a.manifest.Files = append(a.manifest.Files, ManifestEntry{
+		Name:   name,
+		Size:   size,
+		SHA256: hex.EncodeToString(h.Sum(nil)),
+	})
+	return nil
+}
+
+// Close writes the manifest and completes the archive.
+func (a *archiveWriter) Close() error {
+	data, err := json.MarshalIndent(a.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := a.tar.WriteHeader(&tar.Header{
+		Name:     manifestName,
+		Size:     int64(len(data)),
+		Mode:     0o600,
+		ModTime:  a.manifest.CreatedAt,
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+	if _, err := a.tar.Write(data); err != nil {
+		return err
+	}
+
+	return errors.Join(a.tar.Close(), a.gzip.Close(), a.encrypt.Close())
+}
+
+// readArchive calls handle for each entry of an archive but the manifest, then checks the entries against the manifest
+// and returns it. Entries must be read completely by handle, or skipped by returning without reading.
+func readArchive(in io.Reader, passphrase string, handle func(header *tar.Header, r io.Reader) error) (*Manifest, error) {
+	decrypt, err := newDecryptReader(in, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	gz, err := gzip.NewReader(decrypt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+	}
+	defer gz.Close()
+
+	var (
+		tr       = tar.NewReader(gz)
+		sums     = map[string]ManifestEntry{}
+		manifest *Manifest
+	)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+		}
+
+		if manifest != nil {
+			return nil, fmt.Errorf("%w: unexpected entry %s after the manifest", ErrInvalidArchive, header.Name)
+		}
+
+		if header.Name == manifestName {
+			manifest = new(Manifest)
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, fmt.Errorf("%w: invalid manifest: %v", ErrInvalidArchive, err)
+			}
+			continue
+		}
+
+		if !validEntryName(header.Name) || header.Typeflag != tar.TypeReg {
+			return nil, fmt.Errorf("%w: invalid entry %s", ErrInvalidArchive, header.Name)
+		}
+
+		h := &countingHash{Hash: sha256.New()}
+		if err := handle(header, io.TeeReader(tr, h)); err != nil {
+			return nil, err
+		}
+		// Hash whatever handle didn't read.
+		if _, err := io.Copy(h, tr); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidArchive, err)
+		}
+
+		sums[header.Name] = ManifestEntry{
+			Name:   header.Name,
+			Size:   h.size,
+			SHA256: hex.EncodeToString(h.Sum(nil)),
+		}
+	}
+
+	if manifest == nil {
+		return nil, fmt.Errorf("%w: the manifest is missing", ErrInvalidArchive)
+	}
+	if manifest.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("the backup has format version %d, this version of Obot supports version %d and older", manifest.FormatVersion, FormatVersion)
+	}
+
+	if len(sums) != len(manifest.Files) {
+		return nil, fmt.Errorf("%w: the archive has %d files, the manifest lists %d", ErrInvalidArchive, len(sums), len(manifest.Files))
+	}
+	for _, file := range manifest.Files {
+		if sums[file.Name] != file {
+			return nil, fmt.Errorf("%w: checksum mismatch for %s", ErrInvalidArchive, file.Name)
+		}
+	}
+
+	return manifest, nil
+}
+
+// validEntryName rejects names that would be extracted outside of the target directory.
+func validEntryName(name string) bool {
+	return name != "" && !strings.HasPrefix(name, "/") && path.Clean(name) == name && name != ".." && !strings.HasPrefix(name, "../")
+}
+
+type countingHash struct {
+	hash.Hash
+	size int64
+}
+
+func (c *countingHash) Write(p []byte) (int, error) {
+	c.size += int64(len(p))
+	return c.Hash.Write(p)
+}
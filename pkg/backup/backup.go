@@ -0,0 +1,161 @@
+// Package backup creates and restores encrypted archives of an Obot installation: the database of the storage and the
+// gateway, the database of the credential store and the files of workspaces.
+package backup
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/adrg/xdg"
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/credstores"
+	"github.com/obot-platform/obot/pkg/version"
+)
+
+var log = logger.Package()
+
+const workspacesPrefix = "workspaces/"
+
+type Options struct {
+	// DSN is the database of the installation, as in the server configuration.
+	DSN string
+	// WorkspaceDir holds the files of workspaces of the directory workspace provider. Workspace files are skipped if it
+	// is empty.
+	WorkspaceDir string
+	Passphrase   string
+}
+
+// DefaultWorkspaceDir returns the directory that the directory workspace provider uses by default.
+func DefaultWorkspaceDir() string {
+	if dir := os.Getenv("WORKSPACE_PROVIDER_DATA_HOME"); dir != "" {
+		return dir
+	}
+	return filepath.Join(xdg.DataHome, "obot", "workspace-provider")
+}
+
+// Create writes an archive of the installation to out.
+func Create(ctx context.Context, opts Options, out io.Writer) (*Manifest, error) {
+	dbType, dsn, err := databaseType(opts.DSN)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "obot-backup-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Snapshot the databases first, so that the archive isn't started if they can't be read.
+	snapshots := map[string]string{}
+	switch dbType {
+	case databaseSQLite:
+		dbFile, credentialsFile, err := credstores.SQLiteFiles(dsn)
+		if err != nil {
+			return nil, err
+		}
+		for name, file := range map[string]string{sqliteDatabaseName: dbFile, sqliteCredentialsName: credentialsFile} {
+			if _, err := os.Stat(file); err != nil {
+				if name == sqliteCredentialsName && os.IsNotExist(err) {
+					// The credential store creates its database when it first stores a credential.
+					continue
+				}
+				return nil, err
+			}
+			snapshot := filepath.Join(tmpDir, filepath.Base(name))
+			if err := snapshotSQLite(ctx, file, snapshot); err != nil {
+				return nil, err
+			}
+			snapshots[name] = snapshot
+		}
+	case databasePostgres:
+		snapshot := filepath.Join(tmpDir, "obot.pgdump")
+		if err := dumpPostgres(ctx, dsn, snapshot); err != nil {
+			return nil, err
+		}
+		snapshots[postgresDumpName] = snapshot
+	}
+
+	archive, err := newArchiveWriter(out, opts.Passphrase, Manifest{
+		FormatVersion: FormatVersion,
+		ObotVersion:   version.Get().String(),
+		CreatedAt:     time.Now().UTC(),
+		Database:      dbType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(snapshots))
+	for name := range snapshots {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		if err := addFile(archive, name, snapshots[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.WorkspaceDir != "" {
+		if err := addWorkspaces(ctx, archive, opts.WorkspaceDir); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return &archive.manifest, nil
+}
+
+func addFile(archive *archiveWriter, name, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	return archive.addFile(name, info.Size(), int64(info.Mode().Perm()), info.ModTime(), f)
+}
+
+func addWorkspaces(ctx context.Context, archive *archiveWriter, dir string) error {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		log.Infof("Workspace directory %s doesn't exist, skipping workspace files", dir)
+		return nil
+	}
+
+	return filepath.WalkDir(dir, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 {
+			log.Infof("Skipping symlink %s", file)
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		return addFile(archive, workspacesPrefix+filepath.ToSlash(rel), file)
+	})
+}
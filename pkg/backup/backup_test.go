@@ -0,0 +1,191 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEncryption(t *testing.T) {
+	for _, size := range []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3 * chunkSize} {
+		data := make([]byte, size)
+		_, _ = rand.Read(data)
+
+		var archive bytes.Buffer
+		w, err := newEncryptWriter(&archive, "secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write(data); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		encrypted := archive.Bytes()
+
+		decrypt := func(data []byte, passphrase string) ([]byte, error) {
+			r, err := newDecryptReader(bytes.NewReader(data), passphrase)
+			if err != nil {
+				return nil, err
+			}
+			return io.ReadAll(r)
+		}
+
+		got, err := decrypt(encrypted, "secret")
+		if err != nil {
+			t.Fatalf("size %d: %v", size, err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Fatalf("size %d: decrypted data doesn't match", size)
+		}
+
+		if _, err := decrypt(encrypted, "wrong"); !errors.Is(err, ErrInvalidArchive) {
+			t.Errorf("size %d: expected an error for the wrong passphrase, got %v", size, err)
+		}
+
+		// Truncating at a chunk boundary must be detected too.
+		for _, cut := range []int{1, 16, chunkSize + 16} {
+			if cut >= len(encrypted)-len(magic)-saltSize-noncePrefixSize {
+				continue
+			}
+			if _, err := decrypt(encrypted[:len(encrypted)-cut], "secret"); !errors.Is(err, ErrInvalidArchive) {
+				t.Errorf("size %d: expected an error when truncating %d bytes, got %v", size, cut, err)
+			}
+		}
+
+		flipped := bytes.Clone(encrypted)
+		flipped[len(flipped)-1] ^= 1
+		if _, err := decrypt(flipped, "secret"); !errors.Is(err, ErrInvalidArchive) {
+			t.Errorf("size %d: expected an error for modified data, got %v", size, err)
+		}
+	}
+}
+
+func TestBackupAndRestoreSQLite(t *testing.T) {
+	ctx := context.Background()
+
+	source := t.TempDir()
+	createSQLite(t, filepath.Join(source, "obot.db"), "thread")
+	createSQLite(t, filepath.Join(source, "obot-credentials.db"), "credential")
+	if err := os.MkdirAll(filepath.Join(source, "workspaces", "ws1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(source, "workspaces", "ws1", "notes.txt"), []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var archive bytes.Buffer
+	manifest, err := Create(ctx, Options{
+		DSN:          "sqlite://file:" + filepath.Join(source, "obot.db") + "?_journal=WAL",
+		WorkspaceDir: filepath.Join(source, "workspaces"),
+		Passphrase:   "secret",
+	}, &archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(manifest.Files) != 3 {
+		t.Fatalf("expected 3 files in the backup, got %v", manifest.Files)
+	}
+
+	archiveFile := filepath.Join(t.TempDir(), "backup.obk")
+	if err := os.WriteFile(archiveFile, archive.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	target := t.TempDir()
+	opts := RestoreOptions{
+		Options: Options{
+			DSN:          "sqlite://file:" + filepath.Join(target, "data.db"),
+			WorkspaceDir: filepath.Join(target, "workspaces"),
+			Passphrase:   "secret",
+		},
+	}
+	if _, err := Restore(ctx, opts, archiveFile); err != nil {
+		t.Fatal(err)
+	}
+
+	expectRow(t, filepath.Join(target, "data.db"), "thread")
+	expectRow(t, filepath.Join(target, "data-credentials.db"), "credential")
+	if data, err := os.ReadFile(filepath.Join(target, "workspaces", "ws1", "notes.txt")); err != nil || string(data) != "hello" {
+		t.Fatalf("expected the workspace file to be restored, got %q, %v", data, err)
+	}
+
+	if _, err := Restore(ctx, opts, archiveFile); err == nil {
+		t.Fatal("expected restoring over an existing database to fail without force")
+	}
+	opts.Force = true
+	if err := os.WriteFile(filepath.Join(target, "workspaces", "stale.txt"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Restore(ctx, opts, archiveFile); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(target, "workspaces", "stale.txt")); !os.IsNotExist(err) {
+		t.Fatalf("expected the workspace directory to be replaced, got %v", err)
+	}
+
+	// A restore that fails after the database is staged leaves the installation as it was.
+	createSQLite(t, filepath.Join(target, "other.db"), "other")
+	failing := opts
+	failing.DSN = "sqlite://file:" + filepath.Join(target, "other.db")
+	failing.WorkspaceDir = filepath.Join(target, "workspaces", "ws1", "notes.txt")
+	if _, err := Restore(ctx, failing, archiveFile); err == nil {
+		t.Fatal("expected restoring into a file as the workspace directory to fail")
+	}
+	expectRow(t, filepath.Join(target, "other.db"), "other")
+	if _, err := os.Stat(filepath.Join(target, "other.db.restore")); !os.IsNotExist(err) {
+		t.Fatalf("expected the staged database to be removed, got %v", err)
+	}
+
+	// Nothing is restored from a modified archive.
+	modified := bytes.Clone(archive.Bytes())
+	modified[len(modified)/2] ^= 1
+	if err := os.WriteFile(archiveFile, modified, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Verify(archiveFile, "secret"); !errors.Is(err, ErrInvalidArchive) {
+		t.Fatalf("expected verifying a modified archive to fail, got %v", err)
+	}
+}
+
+func createSQLite(t *testing.T, file, value string) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", "file:"+file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("CREATE TABLE data (value TEXT)"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT INTO data VALUES (?)", value); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expectRow(t *testing.T, file, expected string) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", "file:"+file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var value string
+	if err := db.QueryRow("SELECT value FROM data").Scan(&value); err != nil {
+		t.Fatal(err)
+	}
+	if value != expected {
+		t.Fatalf("expected %q in %s, got %q", expected, file, value)
+	}
+}
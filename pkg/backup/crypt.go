@@ -0,0 +1,211 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// Archives are encrypted with AES-256-GCM in chunks, so that they can be streamed. The key is derived from a
+// passphrase with scrypt. Each chunk's nonce holds the chunk's index and whether it is the last chunk, so reordered,
+// dropped or truncated chunks fail to decrypt.
+//
+// Layout: magic | salt | nonce prefix | chunk... where each chunk is a sealed block of at most chunkSize bytes.
+
+var magic = []byte("OBOTBAK1")
+
+const (
+	saltSize        = 16
+	noncePrefixSize = 7
+	chunkSize       = 64 * 1024
+)
+
+var ErrInvalidArchive = errors.New("not an Obot backup archive or the passphrase is wrong")
+
+func newAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	if passphrase == "" {
+		return nil, errors.New("a passphrase is required")
+	}
+
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func nonce(prefix []byte, index uint32, last bool) []byte {
+	n := make([]byte, 12)
+	copy(n, prefix)
+	binary.BigEndian.PutUint32(n[noncePrefixSize:], index)
+	if last {
+		n[11] = 1
+	}
+	return n
+}
+
+type encryptWriter struct {
+	out    io.Writer
+	aead   cipher.AEAD
+	prefix []byte
+	index  uint32
+	buf    []byte
+}
+
+// newEncryptWriter returns a writer that encrypts to out. It must be closed to write the last chunk.
+func newEncryptWriter(out io.Writer, passphrase string) (io.WriteCloser, error) {
+	header := make([]byte, len(magic)+saltSize+noncePrefixSize)
+	copy(header, magic)
+	if _, err := rand.Read(header[len(magic):]); err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(passphrase, header[len(magic):len(magic)+saltSize])
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := out.Write(header); err != nil {
+		return nil, err
+	}
+
+	return &encryptWriter{
+		out:    out,
+		aead:   aead,
+		prefix: header[len(magic)+saltSize:],
+		buf:    make([]byte, 0, chunkSize),
+	}, nil
+}
+
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	var written int
+	for len(p) > 0 {
+		// A full buffer is only flushed when more data follows, so that Close always has a last chunk to seal.
+		if len(e.buf) == chunkSize {
+			if err := e.flush(false); err != nil {
+				return written, err
+			}
+		}
+
+		n := min(chunkSize-len(e.buf), len(p))
+		e.buf = append(e.buf, p[:n]...)
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (e *encryptWriter) flush(last bool) error {
+	sealed := e.aead.Seal(nil, nonce(e.prefix, e.index, last), e.buf, nil)
+	if _, err := e.out.Write(sealed); err != nil {
+		return err
+	}
+	e.index++
+	e.buf = e.buf[:0]
+	return nil
+}
+
+func (e *encryptWriter) Close() error {
+	return e.flush(true)
+}
+
+type decryptReader struct {
+	in     io.Reader
+	aead   cipher.AEAD
+	prefix []byte
+	index  uint32
+	buf    []byte
+	plain  []byte
+	done   bool
+}
+
+// newDecryptReader returns a reader that decrypts in. Reading fails if the archive was modified or truncated.
+func newDecryptReader(in io.Reader, passphrase string) (io.Reader, error) {
+	header := make([]byte, len(magic)+saltSize+noncePrefixSize)
+	if _, err := io.ReadFull(in, header); err != nil || !bytes.Equal(header[:len(magic)], magic) {
+		return nil, ErrInvalidArchive
+	}
+
+	aead, err := newAEAD(passphrase, header[len(magic):len(magic)+saltSize])
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptReader{
+		in:     in,
+		aead:   aead,
+		prefix: header[len(magic)+saltSize:],
+		// One extra byte shows whether another chunk follows this one.
+		buf: make([]byte, chunkSize+aead.Overhead()+1),
+	}, nil
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.plain) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.plain)
+	d.plain = d.plain[n:]
+	return n, nil
+}
+
+func (d *decryptReader) next() error {
+	sealedSize := chunkSize + d.aead.Overhead()
+
+	// The first byte of the buffer may already hold the first byte of this chunk, read ahead with the previous one.
+	start := 0
+	if d.index > 0 {
+		start = 1
+	}
+
+	n, err := io.ReadFull(d.in, d.buf[start:sealedSize+1])
+	n += start
+	switch {
+	case errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF):
+		d.done = true
+	case err != nil:
+		return err
+	}
+
+	if d.done {
+		if n < d.aead.Overhead() {
+			return fmt.Errorf("%w: the archive is truncated", ErrInvalidArchive)
+		}
+	} else {
+		n = sealedSize
+	}
+
+	plain, err := d.aead.Open(nil, nonce(d.prefix, d.index, d.done), d.buf[:n], nil)
+	if err != nil {
+		if d.index == 0 {
+			return ErrInvalidArchive
+		}
+		return fmt.Errorf("%w: the archive is corrupted or truncated", ErrInvalidArchive)
+	}
+
+	if !d.done {
+		// Keep the byte of the next chunk that was read ahead.
+		d.buf[0] = d.buf[sealedSize]
+	}
+	d.index++
+	d.plain = plain
+	return nil
+}
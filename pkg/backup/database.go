@@ -0,0 +1,137 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	_ "github.com/glebarez/go-sqlite"
+)
+
+const (
+	databaseSQLite   = "sqlite"
+	databasePostgres = "postgres"
+
+	// Archives use the same names for databases, whatever the names of the files of the installation.
+	sqliteDatabaseName    = "database/obot.db"
+	sqliteCredentialsName = "database/obot-credentials.db"
+	postgresDumpName      = "database/obot.pgdump"
+)
+
+// databaseType returns the type of the database of dsn, normalizing postgresql:// like the server does.
+func databaseType(dsn string) (string, string, error) {
+	switch {
+	case strings.HasPrefix(dsn, "sqlite://"):
+		return databaseSQLite, dsn, nil
+	case strings.HasPrefix(dsn, "postgresql://"):
+		dsn = strings.Replace(dsn, "postgresql://", "postgres://", 1)
+		fallthrough
+	case strings.HasPrefix(dsn, "postgres://"):
+		return databasePostgres, dsn, nil
+	default:
+		return "", "", fmt.Errorf("unsupported database %s", strings.Split(dsn, "://")[0])
+	}
+}
+
+// snapshotSQLite writes a consistent copy of a sqlite database to target, while the database may be in use.
+func snapshotSQLite(ctx context.Context, file, target string) error {
+	db, err := sql.Open("sqlite", "file:"+file+"?_pragma=busy_timeout(30000)")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if _, err := db.ExecContext(ctx, "VACUUM INTO '"+strings.ReplaceAll(target, "'", "''")+"'"); err != nil {
+		return fmt.Errorf("failed to snapshot %s: %w", file, err)
+	}
+	return nil
+}
+
+// dumpPostgres writes a dump of the database to target with pg_dump. The dump is taken in a single transaction, so it
+// is consistent while the database is in use.
+func dumpPostgres(ctx context.Context, dsn, target string) error {
+	dbname, env, err := postgresConnection(dsn)
+	if err != nil {
+		return err
+	}
+	return runPostgresTool(ctx, env, "pg_dump", "--format=custom", "--no-owner", "--no-privileges", "--file", target, "--dbname", dbname)
+}
+
+// restorePostgres replaces the objects in the database with those of a dump, in a single transaction.
+func restorePostgres(ctx context.Context, dsn, dump string) error {
+	dbname, env, err := postgresConnection(dsn)
+	if err != nil {
+		return err
+	}
+	return runPostgresTool(ctx, env, "pg_restore", "--clean", "--if-exists", "--no-owner", "--no-privileges", "--single-transaction",
+		"--exit-on-error", "--dbname", dbname, dump)
+}
+
+// postgresConnection moves the password of dsn to the environment, so that it doesn't show up in the process list.
+func postgresConnection(dsn string) (string, []string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid postgres dsn: %w", err)
+	}
+
+	env := os.Environ()
+	if password, ok := u.User.Password(); ok {
+		env = append(env, "PGPASSWORD="+password)
+		u.User = url.User(u.User.Username())
+	}
+	return u.String(), env, nil
+}
+
+func runPostgresTool(ctx context.Context, env []string, name string, args ...string) error {
+	if _, err := exec.LookPath(name); err != nil {
+		return fmt.Errorf("%s is required to back up and restore postgres databases: %w", name, err)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Env = env
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s failed: %w: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// replaceSQLite atomically replaces the sqlite database target with staged, and removes the journal files of the
+// database that target held.
+func replaceSQLite(staged, target string) error {
+	for _, suffix := range []string{"-wal", "-shm", "-journal"} {
+		if err := os.Remove(target + suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(staged, target)
+}
+
+// removeSQLite removes a sqlite database and its journal files.
+func removeSQLite(file string) error {
+	for _, suffix := range []string{"", "-wal", "-shm", "-journal"} {
+		if err := os.Remove(file + suffix); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeFile(r io.Reader, file string, mode os.FileMode) error {
+	f, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
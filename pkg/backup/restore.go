@@ -0,0 +1,273 @@
+package backup
+
+import (
+	"archive/tar"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/obot-platform/obot/pkg/credstores"
+)
+
+type RestoreOptions struct {
+	Options
+	// Force replaces existing data. Without it, restores fail if the database or the workspace directory has data.
+	Force bool
+}
+
+// Verify checks that an archive can be decrypted and that its files match its manifest.
+func Verify(archive, passphrase string) (*Manifest, error) {
+	f, err := os.Open(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return readArchive(f, passphrase, func(*tar.Header, io.Reader) error {
+		return nil
+	})
+}
+
+// Restore verifies an archive and then replaces the data of the installation with its content. Obot must not be
+// running while it is restored.
+func Restore(ctx context.Context, opts RestoreOptions, archive string) (*Manifest, error) {
+	manifest, err := Verify(archive, opts.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	dbType, dsn, err := databaseType(opts.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Database != dbType {
+		return nil, fmt.Errorf("the backup is of a %s database and can't be restored to a %s database", manifest.Database, dbType)
+	}
+
+	var dbFile, credentialsFile string
+	switch dbType {
+	case databaseSQLite:
+		if dbFile, credentialsFile, err = credstores.SQLiteFiles(dsn); err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(dbFile); err == nil && !opts.Force {
+			return nil, fmt.Errorf("database %s exists, use force to replace it", dbFile)
+		}
+	case databasePostgres:
+		if !opts.Force {
+			if err := checkPostgresEmpty(ctx, dsn); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	hasWorkspaces := slices.ContainsFunc(manifest.Files, func(file ManifestEntry) bool {
+		return strings.HasPrefix(file.Name, workspacesPrefix)
+	})
+	if opts.WorkspaceDir != "" && hasWorkspaces && !opts.Force {
+		if entries, err := os.ReadDir(opts.WorkspaceDir); err == nil && len(entries) > 0 {
+			return nil, fmt.Errorf("workspace directory %s isn't empty, use force to restore into it", opts.WorkspaceDir)
+		}
+	}
+
+	tmpDir, err := os.MkdirTemp("", "obot-restore-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	f, err := os.Open(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// Stage the content of the archive next to the data it replaces, and only swap it in once everything is staged, so
+	// that a failed restore leaves the installation as it was.
+	staged := &staging{}
+	defer staged.cleanup()
+
+	dump := filepath.Join(tmpDir, "obot.pgdump")
+	if _, err := readArchive(f, opts.Passphrase, func(header *tar.Header, r io.Reader) error {
+		switch name := header.Name; {
+		case name == sqliteDatabaseName && dbType == databaseSQLite:
+			return staged.stageFile(r, dbFile)
+		case name == sqliteCredentialsName && dbType == databaseSQLite:
+			return staged.stageFile(r, credentialsFile)
+		case name == postgresDumpName && dbType == databasePostgres:
+			return writeFile(r, dump, 0o600)
+		case strings.HasPrefix(name, workspacesPrefix):
+			if opts.WorkspaceDir == "" {
+				return nil
+			}
+			return staged.stageWorkspaceFile(r, opts.WorkspaceDir, strings.TrimPrefix(name, workspacesPrefix), os.FileMode(header.Mode).Perm())
+		default:
+			log.Infof("Skipping unknown file %s in backup", name)
+			return nil
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	// Swap in the steps that can fail first: the postgres restore runs in a single transaction and the workspace files
+	// are moved back if they can't be swapped.
+	if dbType == databasePostgres {
+		if err := restorePostgres(ctx, dsn, dump); err != nil {
+			return nil, err
+		}
+	}
+	if err := staged.swapWorkspaces(opts.WorkspaceDir); err != nil {
+		return nil, err
+	}
+	if dbType == databaseSQLite {
+		if !slices.ContainsFunc(manifest.Files, func(file ManifestEntry) bool { return file.Name == sqliteCredentialsName }) {
+			// The backed up installation had no credentials, so neither does the restored one.
+			if err := removeSQLite(credentialsFile); err != nil {
+				return nil, err
+			}
+		}
+		if err := staged.swapFiles(); err != nil {
+			return nil, err
+		}
+	}
+
+	return manifest, nil
+}
+
+// staging tracks the content of an archive that is written next to the data it replaces.
+type staging struct {
+	// files maps staged database files to the files they replace.
+	files map[string]string
+	// workspaceDir is a directory in the workspace directory that holds the staged workspace files.
+	workspaceDir string
+}
+
+func (s *staging) stageFile(r io.Reader, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+
+	staged := target + ".restore"
+	if s.files == nil {
+		s.files = map[string]string{}
+	}
+	s.files[staged] = target
+	return writeFile(r, staged, 0o600)
+}
+
+func (s *staging) stageWorkspaceFile(r io.Reader, workspaceDir, name string, mode os.FileMode) error {
+	if s.workspaceDir == "" {
+		// Stage in the workspace directory itself, so that files can be renamed into place even if it is a mount point.
+		if err := os.MkdirAll(workspaceDir, 0o755); err != nil {
+			return err
+		}
+		dir, err := os.MkdirTemp(workspaceDir, ".restore-")
+		if err != nil {
+			return err
+		}
+		s.workspaceDir = dir
+	}
+
+	target := filepath.Join(s.workspaceDir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	return writeFile(r, target, mode)
+}
+
+// swapWorkspaces replaces the entries of the workspace directory with the staged ones. The replaced entries are moved
+// aside first, and moved back if any staged entry can't be moved into place.
+func (s *staging) swapWorkspaces(workspaceDir string) error {
+	if s.workspaceDir == "" {
+		return nil
+	}
+
+	old, err := os.MkdirTemp(workspaceDir, ".restore-old-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(old)
+
+	existing, err := os.ReadDir(workspaceDir)
+	if err != nil {
+		return err
+	}
+	restored, err := os.ReadDir(s.workspaceDir)
+	if err != nil {
+		return err
+	}
+
+	var movedAside, movedIn []string
+	rollback := func() {
+		for _, name := range movedIn {
+			_ = os.Rename(filepath.Join(workspaceDir, name), filepath.Join(s.workspaceDir, name))
+		}
+		for _, name := range movedAside {
+			_ = os.Rename(filepath.Join(old, name), filepath.Join(workspaceDir, name))
+		}
+	}
+
+	for _, entry := range existing {
+		if path := filepath.Join(workspaceDir, entry.Name()); path == s.workspaceDir || path == old {
+			continue
+		}
+		if err := os.Rename(filepath.Join(workspaceDir, entry.Name()), filepath.Join(old, entry.Name())); err != nil {
+			rollback()
+			return err
+		}
+		movedAside = append(movedAside, entry.Name())
+	}
+	for _, entry := range restored {
+		if err := os.Rename(filepath.Join(s.workspaceDir, entry.Name()), filepath.Join(workspaceDir, entry.Name())); err != nil {
+			rollback()
+			return err
+		}
+		movedIn = append(movedIn, entry.Name())
+	}
+	return nil
+}
+
+// swapFiles renames the staged database files over the files they replace.
+func (s *staging) swapFiles() error {
+	for staged, target := range s.files {
+		if err := replaceSQLite(staged, target); err != nil {
+			return err
+		}
+		delete(s.files, staged)
+	}
+	return nil
+}
+
+// cleanup removes whatever is still staged.
+func (s *staging) cleanup() {
+	for staged := range s.files {
+		_ = os.Remove(staged)
+	}
+	if s.workspaceDir != "" {
+		_ = os.RemoveAll(s.workspaceDir)
+	}
+}
+
+// checkPostgresEmpty fails if the database has tables, to protect existing installations from accidental restores.
+func checkPostgresEmpty(ctx context.Context, dsn string) error {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var tables int
+	if err := db.QueryRowContext(ctx, "SELECT count(*) FROM information_schema.tables WHERE table_schema = current_schema()").Scan(&tables); err != nil {
+		return fmt.Errorf("failed to check the database: %w", err)
+	}
+	if tables > 0 {
+		return fmt.Errorf("the database has %d tables, use force to replace them", tables)
+	}
+	return nil
+}
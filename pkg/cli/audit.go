@@ -0,0 +1,394 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gptscript-ai/cmd"
+	"github.com/obot-platform/obot/apiclient"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+)
+
+type Audit struct{}
+
+func (a *Audit) Customize(cmd *cobra.Command) {
+	cmd.Use = "audit"
+	cmd.Short = "Query, follow and export MCP audit logs"
+}
+
+func (a *Audit) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func newAudit(root *Obot) *cobra.Command {
+	return cmd.Command(&Audit{},
+		&AuditLogs{root: root},
+		&AuditStats{root: root},
+		&AuditExport{root: root},
+	)
+}
+
+// AuditFilters adds the audit log filters of audit log exports as flags.
+type AuditFilters struct {
+	User          []string `usage:"Filter by user ID"`
+	MCPID         []string `usage:"Filter by MCP server ID" name:"mcp-id"`
+	Server        []string `usage:"Filter by MCP server display name"`
+	CatalogEntry  []string `usage:"Filter by catalog entry ID"`
+	CallType      []string `usage:"Filter by call type, such as tools/call"`
+	Identifier    []string `usage:"Filter by call identifier, such as the tool name"`
+	Session       []string `usage:"Filter by MCP session ID"`
+	Client        []string `usage:"Filter by client name"`
+	ClientVersion []string `usage:"Filter by client version"`
+	Status        []string `usage:"Filter by HTTP response status"`
+	ClientIP      []string `usage:"Filter by client IP" name:"client-ip"`
+	Query         string   `usage:"Free-text search" short:"q"`
+}
+
+func (f AuditFilters) filters() types.AuditLogExportFilters {
+	return types.AuditLogExportFilters{
+		UserIDs:                    f.User,
+		MCPIDs:                     f.MCPID,
+		MCPServerDisplayNames:      f.Server,
+		MCPServerCatalogEntryNames: f.CatalogEntry,
+		CallTypes:                  f.CallType,
+		CallIdentifiers:            f.Identifier,
+		SessionIDs:                 f.Session,
+		ClientNames:                f.Client,
+		ClientVersions:             f.ClientVersion,
+		ResponseStatuses:           f.Status,
+		ClientIPs:                  f.ClientIP,
+		Query:                      f.Query,
+	}
+}
+
+// AuditTimeRange adds the --since, --start and --end flags.
+type AuditTimeRange struct {
+	Since string `usage:"Only include entries newer than a duration, such as 1h"`
+	Start string `usage:"Only include entries at or after an RFC3339 time"`
+	End   string `usage:"Only include entries before an RFC3339 time"`
+}
+
+func (r AuditTimeRange) timeRange() (start, end time.Time, err error) {
+	if r.Since != "" && r.Start != "" {
+		return start, end, fmt.Errorf("--since and --start cannot be used together")
+	}
+	if r.Since != "" {
+		d, err := time.ParseDuration(r.Since)
+		if err != nil {
+			return start, end, fmt.Errorf("invalid --since: %w", err)
+		}
+		start = time.Now().Add(-d)
+	}
+	if r.Start != "" {
+		if start, err = time.Parse(time.RFC3339, r.Start); err != nil {
+			return start, end, fmt.Errorf("invalid --start: %w", err)
+		}
+	}
+	if r.End != "" {
+		if end, err = time.Parse(time.RFC3339, r.End); err != nil {
+			return start, end, fmt.Errorf("invalid --end: %w", err)
+		}
+	}
+	return start, end, nil
+}
+
+type AuditLogs struct {
+	OutputFlags
+	AuditFilters
+	AuditTimeRange
+	Limit    int    `usage:"Maximum number of entries to list" default:"100"`
+	Follow   bool   `usage:"Keep polling for new entries" short:"f"`
+	Interval string `usage:"Polling interval for --follow" default:"5s"`
+	Overlap  string `usage:"How far before the newest entry --follow polls, to catch entries that are stored late" default:"1m"`
+	root     *Obot
+}
+
+func (a *AuditLogs) Customize(cmd *cobra.Command) {
+	cmd.Use = "logs"
+	cmd.Short = "List MCP audit logs, newest first, or follow new entries"
+	cmd.Args = cobra.NoArgs
+}
+
+func (a *AuditLogs) Run(cmd *cobra.Command, _ []string) error {
+	start, end, err := a.timeRange()
+	if err != nil {
+		return err
+	}
+
+	opts := apiclient.ListMCPAuditLogsOptions{
+		AuditLogExportFilters: a.filters(),
+		StartTime:             start,
+		EndTime:               end,
+		Limit:                 a.Limit,
+	}
+	logs, err := a.root.Client.ListMCPAuditLogs(cmd.Context(), opts)
+	if err != nil {
+		return err
+	}
+
+	if !a.Follow {
+		return a.print(logs, func(w io.Writer) {
+			auditLogHeader(w)
+			for _, log := range logs.Items {
+				auditLogRow(w, log)
+			}
+		})
+	}
+
+	if !end.IsZero() {
+		return fmt.Errorf("--end cannot be used with --follow")
+	}
+	interval, err := time.ParseDuration(a.Interval)
+	if err != nil || interval <= 0 {
+		return fmt.Errorf("invalid --interval %q", a.Interval)
+	}
+	overlap, err := time.ParseDuration(a.Overlap)
+	if err != nil || overlap < 0 {
+		return fmt.Errorf("invalid --overlap %q", a.Overlap)
+	}
+
+	// Print the latest entries oldest first, then poll for new entries.
+	entries := slices.Clone(logs.Items)
+	slices.Reverse(entries)
+	return a.follow(cmd.Context(), os.Stdout, opts, entries, interval, overlap)
+}
+
+// follow prints the entries and then polls for new ones. Entries can be stored after newer entries, because the audit
+// logs of MCP servers are written in batches, so each poll looks back overlap from the newest entry and skips the
+// entries that were already printed.
+func (a *AuditLogs) follow(ctx context.Context, out io.Writer, opts apiclient.ListMCPAuditLogsOptions, entries []types.MCPAuditLog, interval, overlap time.Duration) error {
+	var (
+		w       = tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+		newest  = opts.StartTime
+		started bool
+		// printed has the creation times of the printed entries by ID, for the entries that later polls can return.
+		printed = map[uint]time.Time{}
+	)
+	opts.SortBy, opts.SortOrder, opts.Limit = "created_at", "asc", 1000
+
+	for {
+		if !started && strings.EqualFold(a.Output, "table") {
+			auditLogHeader(w)
+			started = true
+		}
+		for _, entry := range entries {
+			if _, ok := printed[entry.ID]; ok {
+				continue
+			}
+			printed[entry.ID] = entry.CreatedAt.Time
+			if entry.CreatedAt.Time.After(newest) {
+				newest = entry.CreatedAt.Time
+			}
+			if err := a.printEntry(out, w, entry); err != nil {
+				return err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		if !newest.IsZero() {
+			opts.StartTime = newest.Add(-overlap)
+			for id, createdAt := range printed {
+				if createdAt.Before(opts.StartTime) {
+					delete(printed, id)
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+
+		entries = entries[:0]
+		for opts.Offset = 0; ; opts.Offset += opts.Limit {
+			page, err := a.root.Client.ListMCPAuditLogs(ctx, opts)
+			if ctx.Err() != nil {
+				// Following was interrupted during a poll.
+				return nil
+			} else if err != nil {
+				return err
+			}
+			entries = append(entries, page.Items...)
+			if len(page.Items) < opts.Limit {
+				break
+			}
+		}
+	}
+}
+
+// printEntry prints an entry while following: table rows to the tab writer, or one JSON object per line or YAML
+// documents to out.
+func (a *AuditLogs) printEntry(out, w io.Writer, entry types.MCPAuditLog) error {
+	switch strings.ToLower(a.Output) {
+	case "json":
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(data))
+	case "yaml":
+		data, err := yaml.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "---\n%s", data)
+	case "table", "":
+		auditLogRow(w, entry)
+	default:
+		return fmt.Errorf("invalid output format %q, must be table, json or yaml", a.Output)
+	}
+	return nil
+}
+
+func auditLogHeader(w io.Writer) {
+	row(w, "ID", "TIME", "USER", "SERVER", "CALL TYPE", "IDENTIFIER", "STATUS", "DURATION")
+}
+
+func auditLogRow(w io.Writer, log types.MCPAuditLog) {
+	row(w, log.ID, log.CreatedAt.Time.Local().Format(time.DateTime), log.UserID, log.MCPServerDisplayName, log.CallType,
+		log.CallIdentifier, log.ResponseStatus, (time.Duration(log.ProcessingTimeMs) * time.Millisecond).String())
+}
+
+type AuditStats struct {
+	OutputFlags
+	AuditTimeRange
+	User         []string `usage:"Filter by user ID"`
+	MCPID        string   `usage:"Only include the MCP server with this ID" name:"mcp-id"`
+	Server       []string `usage:"Filter by MCP server display name"`
+	CatalogEntry []string `usage:"Filter by catalog entry ID"`
+	root         *Obot
+}
+
+func (a *AuditStats) Customize(cmd *cobra.Command) {
+	cmd.Use = "stats"
+	cmd.Short = "Show MCP usage statistics, for the last 24 hours by default"
+	cmd.Args = cobra.NoArgs
+}
+
+func (a *AuditStats) Run(cmd *cobra.Command, _ []string) error {
+	start, end, err := a.timeRange()
+	if err != nil {
+		return err
+	}
+
+	stats, err := a.root.Client.GetMCPUsageStats(cmd.Context(), apiclient.MCPUsageStatsOptions{
+		MCPID:                      a.MCPID,
+		MCPServerDisplayNames:      a.Server,
+		MCPServerCatalogEntryNames: a.CatalogEntry,
+		UserIDs:                    a.User,
+		StartTime:                  start,
+		EndTime:                    end,
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.print(stats, func(w io.Writer) {
+		fmt.Fprintf(w, "%s to %s: %d calls by %d users\n\n", stats.TimeStart.Time.Local().Format(time.DateTime),
+			stats.TimeEnd.Time.Local().Format(time.DateTime), stats.TotalCalls, stats.UniqueUsers)
+		row(w, "SERVER", "MCP ID", "KIND", "NAME", "COUNT")
+		for _, item := range stats.Items {
+			for _, tool := range item.ToolCalls {
+				row(w, item.MCPServerDisplayName, item.MCPID, "tool", tool.ToolName, tool.CallCount)
+			}
+			for _, resource := range item.ResourceReads {
+				row(w, item.MCPServerDisplayName, item.MCPID, "resource", resource.ResourceURI, resource.ReadCount)
+			}
+			for _, prompt := range item.PromptReads {
+				row(w, item.MCPServerDisplayName, item.MCPID, "prompt", prompt.PromptName, prompt.ReadCount)
+			}
+		}
+	})
+}
+
+type AuditExport struct {
+	OutputFlags
+	AuditFilters
+	AuditTimeRange
+	Name      string `usage:"Name of the export (required)"`
+	Bucket    string `usage:"Bucket to export to, defaults to the bucket of the configured storage"`
+	KeyPrefix string `usage:"Key prefix of the exported objects"`
+	NoWait    bool   `usage:"Return once the export is created instead of waiting for it to finish"`
+	Timeout   string `usage:"Maximum time to wait for the export" default:"30m"`
+	root      *Obot
+}
+
+func (a *AuditExport) Customize(cmd *cobra.Command) {
+	cmd.Use = "export"
+	cmd.Short = "Export MCP audit logs to the configured storage and wait for the export to finish"
+	cmd.Args = cobra.NoArgs
+}
+
+func (a *AuditExport) Run(cmd *cobra.Command, _ []string) error {
+	if a.Name == "" {
+		return fmt.Errorf("--name is required")
+	}
+	start, end, err := a.timeRange()
+	if err != nil {
+		return err
+	}
+	if start.IsZero() {
+		return fmt.Errorf("--since or --start is required")
+	}
+	if end.IsZero() {
+		end = time.Now()
+	}
+	timeout, err := time.ParseDuration(a.Timeout)
+	if err != nil {
+		return fmt.Errorf("invalid --timeout: %w", err)
+	}
+
+	export, err := a.root.Client.CreateAuditLogExport(cmd.Context(), types.AuditLogExportCreateRequest{
+		Name:      a.Name,
+		StartTime: *types.NewTime(start),
+		EndTime:   *types.NewTime(end),
+		Filters:   a.filters(),
+		Bucket:    a.Bucket,
+		KeyPrefix: a.KeyPrefix,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !a.NoWait {
+		ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+		defer cancel()
+
+		for types.AuditLogExportState(export.State) != types.AuditLogExportStateCompleted &&
+			types.AuditLogExportState(export.State) != types.AuditLogExportStateFailed {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("timed out waiting for audit log export %s, its state is %q", export.ID, export.State)
+			case <-time.After(2 * time.Second):
+			}
+
+			if export, err = a.root.Client.GetAuditLogExport(ctx, export.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := a.print(export, func(w io.Writer) {
+		row(w, "ID", "NAME", "STATE", "PATH", "SIZE")
+		row(w, export.ID, export.Name, export.State, export.ExportPath, export.ExportSize)
+	}); err != nil {
+		return err
+	}
+
+	if types.AuditLogExportState(export.State) == types.AuditLogExportStateFailed {
+		return fmt.Errorf("audit log export %s failed: %s", export.ID, export.Error)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient"
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+func TestAuditLogsFollowCatchesLateEntries(t *testing.T) {
+	var (
+		start = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		entry = func(id uint, offset time.Duration) types.MCPAuditLog {
+			return types.MCPAuditLog{ID: id, CreatedAt: *types.NewTime(start.Add(offset))}
+		}
+		// Entry 9 is stored after entry 10, although it is older.
+		polls = [][]types.MCPAuditLog{
+			{entry(10, 10*time.Second)},
+			{entry(9, 5*time.Second), entry(10, 10*time.Second), entry(11, 12*time.Second)},
+			{entry(9, 5*time.Second), entry(10, 10*time.Second), entry(11, 12*time.Second)},
+		}
+
+		lock       sync.Mutex
+		startTimes []string
+	)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		poll := len(startTimes)
+		startTimes = append(startTimes, r.URL.Query().Get("start_time"))
+		if poll >= len(polls)-1 {
+			cancel()
+			poll = len(polls) - 1
+		}
+		_ = json.NewEncoder(w).Encode(types.MCPAuditLogResponse{MCPAuditLogList: types.MCPAuditLogList{Items: polls[poll]}})
+	}))
+	defer srv.Close()
+
+	var (
+		out bytes.Buffer
+		a   = &AuditLogs{root: &Obot{Client: &apiclient.Client{BaseURL: srv.URL, Token: "token"}}}
+	)
+	a.Output = "json"
+	if err := a.follow(ctx, &out, apiclient.ListMCPAuditLogsOptions{}, []types.MCPAuditLog{entry(8, 0)}, time.Millisecond, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []uint
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		var printed types.MCPAuditLog
+		if err := json.Unmarshal([]byte(line), &printed); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, printed.ID)
+	}
+	if expected := []uint{8, 10, 9, 11}; !slices.Equal(ids, expected) {
+		t.Errorf("expected every entry to be printed once, in the order they were seen, %v, got %v", expected, ids)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if expected := start.Add(10*time.Second - time.Minute).Format(time.RFC3339); len(startTimes) < 2 || startTimes[1] != expected {
+		t.Errorf("expected the second poll to start an overlap before the newest entry, %s, got %v", expected, startTimes)
+	}
+}
@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/obot-platform/obot/pkg/backup"
+	"github.com/obot-platform/obot/pkg/version"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// BackupFlags adds the flags that locate the data of an installation, with the same defaults as the server.
+type BackupFlags struct {
+	DSN            string `usage:"Database dsn in driver://connection_string format" default:"sqlite://file:obot.db?_journal=WAL&cache=shared&_busy_timeout=30000" env:"OBOT_SERVER_DSN"`
+	WorkspaceDir   string `usage:"Directory of the files of workspaces, defaults to the directory of the directory workspace provider" env:"WORKSPACE_PROVIDER_DATA_HOME"`
+	SkipWorkspaces bool   `usage:"Don't back up or restore the files of workspaces"`
+	Passphrase     string `usage:"Passphrase of the archive, prompted for if not set" env:"OBOT_BACKUP_PASSPHRASE"`
+}
+
+func (b BackupFlags) options(confirm bool) (backup.Options, error) {
+	opts := backup.Options{
+		DSN:          b.DSN,
+		WorkspaceDir: b.WorkspaceDir,
+		Passphrase:   b.Passphrase,
+	}
+	if opts.WorkspaceDir == "" {
+		opts.WorkspaceDir = backup.DefaultWorkspaceDir()
+	}
+	if b.SkipWorkspaces {
+		opts.WorkspaceDir = ""
+	}
+
+	if opts.Passphrase == "" {
+		passphrase, err := readPassphrase(confirm)
+		if err != nil {
+			return opts, err
+		}
+		opts.Passphrase = passphrase
+	}
+	return opts, nil
+}
+
+func readPassphrase(confirm bool) (string, error) {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return "", fmt.Errorf("a passphrase is required, set --passphrase or OBOT_BACKUP_PASSPHRASE")
+	}
+
+	fmt.Fprint(os.Stderr, "Passphrase: ")
+	passphrase, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+
+	if confirm {
+		fmt.Fprint(os.Stderr, "Repeat passphrase: ")
+		again, err := term.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", err
+		}
+		if string(again) != string(passphrase) {
+			return "", fmt.Errorf("the passphrases don't match")
+		}
+	}
+
+	if len(passphrase) == 0 {
+		return "", fmt.Errorf("a passphrase is required")
+	}
+	return string(passphrase), nil
+}
+
+type Backup struct {
+	BackupFlags
+}
+
+func (b *Backup) Customize(cmd *cobra.Command) {
+	cmd.Use = "backup FILE"
+	cmd.Short = "Write an encrypted backup of the database, credentials and workspace files of Obot to FILE, or - for stdout"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (b *Backup) Run(cmd *cobra.Command, args []string) error {
+	opts, err := b.options(true)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if args[0] != "-" {
+		// Write to a temporary file first, so that a failed backup doesn't leave a partial archive behind.
+		f, err := os.CreateTemp(filepath.Dir(args[0]), filepath.Base(args[0])+".*.tmp")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+		out = f
+	}
+
+	manifest, err := backup.Create(cmd.Context(), opts, out)
+	if err != nil {
+		return err
+	}
+
+	if f, ok := out.(*os.File); ok && f != os.Stdout {
+		if err := f.Close(); err != nil {
+			return err
+		}
+		if err := os.Chmod(f.Name(), 0o600); err != nil {
+			return err
+		}
+		if err := os.Rename(f.Name(), args[0]); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "Backed up the %s database and %d workspace files\n", manifest.Database, workspaceFiles(manifest))
+	return nil
+}
+
+type Restore struct {
+	BackupFlags
+	Force      bool `usage:"Replace the data of an existing installation"`
+	VerifyOnly bool `usage:"Only verify the integrity of the backup"`
+}
+
+func (r *Restore) Customize(cmd *cobra.Command) {
+	cmd.Use = "restore FILE"
+	cmd.Short = "Verify a backup and restore the database, credentials and workspace files of Obot from it"
+	cmd.Long = `Verify a backup and restore the database, credentials and workspace files of Obot from it.
+
+Stop Obot before restoring. The backup is verified completely before any data is replaced. Backups of SQLite
+installations can only be restored to SQLite, and backups of Postgres installations to Postgres.`
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (r *Restore) Run(cmd *cobra.Command, args []string) error {
+	opts, err := r.options(false)
+	if err != nil {
+		return err
+	}
+
+	if r.VerifyOnly {
+		manifest, err := backup.Verify(args[0], opts.Passphrase)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("The backup is valid: %s database and %d workspace files from Obot %s, created at %s\n",
+			manifest.Database, workspaceFiles(manifest), manifest.ObotVersion, manifest.CreatedAt.Local().Format("2006-01-02 15:04:05"))
+		return nil
+	}
+
+	manifest, err := backup.Restore(cmd.Context(), backup.RestoreOptions{
+		Options: opts,
+		Force:   r.Force,
+	}, args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Restored the %s database and %d workspace files\n", manifest.Database, workspaceFiles(manifest))
+	if current := version.Get().String(); manifest.ObotVersion != current {
+		fmt.Fprintf(os.Stderr, "The backup was created by Obot %s and will be migrated when Obot %s starts\n", manifest.ObotVersion, current)
+	}
+	return nil
+}
+
+func workspaceFiles(manifest *backup.Manifest) int {
+	var count int
+	for _, file := range manifest.Files {
+		if filepath.Dir(file.Name) != "database" {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,469 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/obot-platform/obot/logger"
+)
+
+var log = logger.Package()
+
+// maxTokenRefreshes is how many times in a row the bridge refreshes its token after the server rejected it, before it
+// gives up.
+const maxTokenRefreshes = 3
+
+// MCPBridge proxies MCP messages between a client that uses the stdio transport and a server that uses the streamable
+// HTTP transport. When the server forgets the session, for example because it was restarted, the bridge replays the
+// client's initialization on a new session and retries, so the client doesn't notice.
+type MCPBridge struct {
+	// URL is the streamable HTTP endpoint of the server.
+	URL string
+	// Token returns the bearer token for requests. It is called with refresh set after the server rejected the token
+	// with 401 Unauthorized, and then has to return a new token rather than a cached one.
+	Token func(ctx context.Context, refresh bool) (string, error)
+	// Client is used for requests, http.DefaultClient if nil.
+	Client *http.Client
+
+	lock            sync.Mutex
+	token           *string
+	refreshes       int
+	sessionID       string
+	protocolVersion string
+	initialize      []byte
+	initialized     []byte
+
+	tokenLock     sync.Mutex
+	reconnectLock sync.Mutex
+	listenOnce    sync.Once
+
+	outLock sync.Mutex
+	out     io.Writer
+}
+
+type jsonrpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+}
+
+// Run proxies newline delimited messages from in to the server, and messages from the server to out, until in is
+// closed and all requests are answered.
+func (b *MCPBridge) Run(ctx context.Context, in io.Reader, out io.Writer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	b.out = out
+
+	var (
+		wg     sync.WaitGroup
+		reader = bufio.NewReader(in)
+	)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			var msg jsonrpcMessage
+			_ = json.Unmarshal(line, &msg)
+
+			// Requests can take long, so they are sent concurrently. Everything else is sent in order, so that the
+			// server sees the initialization before any other message.
+			if msg.Method != "" && len(msg.ID) > 0 && msg.Method != "initialize" {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					b.send(ctx, line, msg)
+				}()
+			} else {
+				b.send(ctx, line, msg)
+			}
+		}
+
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+
+	wg.Wait()
+	b.closeSession()
+	return nil
+}
+
+func (b *MCPBridge) send(ctx context.Context, data []byte, msg jsonrpcMessage) {
+	switch msg.Method {
+	case "initialize":
+		b.lock.Lock()
+		b.initialize = data
+		b.lock.Unlock()
+	case "notifications/initialized":
+		b.lock.Lock()
+		b.initialized = data
+		b.lock.Unlock()
+	}
+
+	err := b.post(ctx, data, msg.Method == "initialize", true, b.write)
+	if err == nil {
+		if msg.Method == "initialize" {
+			b.listenOnce.Do(func() {
+				go b.listen(ctx)
+			})
+		}
+		return
+	}
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	log.Errorf("failed to send MCP message %s: %v", msg.Method, err)
+	if msg.Method != "" && len(msg.ID) > 0 {
+		b.write(errorResponse(msg.ID, err))
+	}
+}
+
+// post sends a message and passes the messages of the response to handle. Initialize requests are sent without a
+// session and start a new one. If reconnect is set, the message is sent again on a new session if the server forgot
+// the session.
+func (b *MCPBridge) post(ctx context.Context, data []byte, initialize, reconnect bool, handle func([]byte)) error {
+	var refreshed, reconnected bool
+	for {
+		var sessionID string
+		if !initialize {
+			sessionID = b.session()
+		}
+
+		resp, err := b.do(ctx, http.MethodPost, data, sessionID)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusUnauthorized && !refreshed:
+			resp.Body.Close()
+			if err := b.refreshToken(ctx, resp.Request); err != nil {
+				return err
+			}
+			refreshed = true
+			continue
+		case resp.StatusCode == http.StatusNotFound && sessionID != "" && reconnect && !reconnected:
+			resp.Body.Close()
+			if err := b.reconnect(ctx, sessionID); err != nil {
+				return err
+			}
+			reconnected = true
+			continue
+		}
+
+		if initialize && resp.StatusCode < http.StatusMultipleChoices {
+			b.lock.Lock()
+			b.sessionID = resp.Header.Get("Mcp-Session-Id")
+			b.lock.Unlock()
+
+			next := handle
+			handle = func(data []byte) {
+				var result struct {
+					Result struct {
+						ProtocolVersion string `json:"protocolVersion"`
+					} `json:"result"`
+				}
+				if json.Unmarshal(data, &result) == nil && result.Result.ProtocolVersion != "" {
+					b.lock.Lock()
+					b.protocolVersion = result.Result.ProtocolVersion
+					b.lock.Unlock()
+				}
+				next(data)
+			}
+		}
+
+		return handleResponse(resp, handle)
+	}
+}
+
+// listen receives the messages that the server sends outside of responses, until ctx is canceled.
+func (b *MCPBridge) listen(ctx context.Context) {
+	for ctx.Err() == nil {
+		sessionID := b.session()
+		resp, err := b.do(ctx, http.MethodGet, nil, sessionID)
+		if err == nil {
+			switch resp.StatusCode {
+			case http.StatusMethodNotAllowed:
+				// The server doesn't send messages outside of responses.
+				resp.Body.Close()
+				return
+			case http.StatusUnauthorized:
+				resp.Body.Close()
+				if err := b.refreshToken(ctx, resp.Request); err != nil {
+					log.Errorf("stopped receiving MCP messages from the server: %v", err)
+					return
+				}
+			case http.StatusNotFound:
+				resp.Body.Close()
+				err = b.reconnect(ctx, sessionID)
+			default:
+				err = handleResponse(resp, b.write)
+			}
+		}
+
+		wait := time.Second
+		if err != nil && ctx.Err() == nil {
+			log.Debugf("MCP event stream failed, reconnecting: %v", err)
+			wait = 5 * time.Second
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(wait):
+		}
+	}
+}
+
+// reconnect replays the initialization of the client on a new session, unless another caller already replaced the
+// expired session.
+func (b *MCPBridge) reconnect(ctx context.Context, expiredSessionID string) error {
+	b.reconnectLock.Lock()
+	defer b.reconnectLock.Unlock()
+
+	b.lock.Lock()
+	sessionID, initialize, initialized := b.sessionID, b.initialize, b.initialized
+	b.lock.Unlock()
+
+	if sessionID != expiredSessionID {
+		return nil
+	}
+	if initialize == nil {
+		return fmt.Errorf("MCP session %s expired before the client initialized it", expiredSessionID)
+	}
+
+	log.Infof("MCP session %s expired, starting a new session", expiredSessionID)
+
+	// The client already has the result of its initialize request, so the result for the new session is dropped.
+	if err := b.post(ctx, initialize, true, false, func([]byte) {}); err != nil {
+		return fmt.Errorf("failed to start a new MCP session: %w", err)
+	}
+	if initialized != nil {
+		if err := b.post(ctx, initialized, false, false, b.write); err != nil {
+			return fmt.Errorf("failed to start a new MCP session: %w", err)
+		}
+	}
+	return nil
+}
+
+// refreshToken fetches a new token, unless another caller already replaced the token that req was sent with. It fails
+// when the new token is the rejected one, and after maxTokenRefreshes refreshed tokens in a row were rejected too.
+func (b *MCPBridge) refreshToken(ctx context.Context, req *http.Request) error {
+	b.tokenLock.Lock()
+	defer b.tokenLock.Unlock()
+
+	b.lock.Lock()
+	current, refreshes := b.token, b.refreshes
+	b.lock.Unlock()
+
+	rejected := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if current != nil && *current != rejected {
+		return nil
+	}
+	if refreshes >= maxTokenRefreshes {
+		return fmt.Errorf("the server rejected %d refreshed tokens in a row", refreshes)
+	}
+
+	token, err := b.Token(ctx, true)
+	if err != nil {
+		return fmt.Errorf("failed to refresh token: %w", err)
+	}
+	if token == rejected {
+		return fmt.Errorf("failed to refresh token: the server rejected the token and no new token is available")
+	}
+
+	b.lock.Lock()
+	b.token = &token
+	b.refreshes++
+	b.lock.Unlock()
+	return nil
+}
+
+func (b *MCPBridge) getToken(ctx context.Context) (string, error) {
+	b.tokenLock.Lock()
+	defer b.tokenLock.Unlock()
+
+	b.lock.Lock()
+	current := b.token
+	b.lock.Unlock()
+
+	if current != nil {
+		return *current, nil
+	}
+
+	token, err := b.Token(ctx, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to get token: %w", err)
+	}
+
+	b.lock.Lock()
+	b.token = &token
+	b.lock.Unlock()
+	return token, nil
+}
+
+func (b *MCPBridge) session() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.sessionID
+}
+
+func (b *MCPBridge) do(ctx context.Context, method string, body []byte, sessionID string) (*http.Response, error) {
+	token, err := b.getToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, b.URL, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	if method == http.MethodGet {
+		req.Header.Set("Accept", "text/event-stream")
+	} else {
+		req.Header.Set("Accept", "application/json, text/event-stream")
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if sessionID != "" {
+		req.Header.Set("Mcp-Session-Id", sessionID)
+	}
+
+	b.lock.Lock()
+	if b.protocolVersion != "" {
+		req.Header.Set("Mcp-Protocol-Version", b.protocolVersion)
+	}
+	b.lock.Unlock()
+
+	client := b.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err == nil && resp.StatusCode != http.StatusUnauthorized {
+		b.lock.Lock()
+		b.refreshes = 0
+		b.lock.Unlock()
+	}
+	return resp, err
+}
+
+// closeSession tells the server that the session isn't needed anymore.
+func (b *MCPBridge) closeSession() {
+	sessionID := b.session()
+	if sessionID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := b.do(ctx, http.MethodDelete, nil, sessionID)
+	if err != nil {
+		log.Debugf("failed to close MCP session %s: %v", sessionID, err)
+		return
+	}
+	resp.Body.Close()
+}
+
+// write writes a message to the client on a single line.
+func (b *MCPBridge) write(data []byte) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		log.Errorf("dropping invalid MCP message from server: %v", err)
+		return
+	}
+	buf.WriteByte('\n')
+
+	b.outLock.Lock()
+	defer b.outLock.Unlock()
+	if _, err := b.out.Write(buf.Bytes()); err != nil {
+		log.Errorf("failed to write MCP message: %v", err)
+	}
+}
+
+// handleResponse passes the messages of a JSON or event stream response to handle.
+func handleResponse(resp *http.Response, handle func([]byte)) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if msg := strings.TrimSpace(string(body)); msg != "" {
+			return fmt.Errorf("%s: %s", resp.Status, msg)
+		}
+		return fmt.Errorf("%s", resp.Status)
+	}
+
+	contentType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch contentType {
+	case "text/event-stream":
+		return readEvents(resp.Body, handle)
+	case "application/json":
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(body)) > 0 {
+			handle(body)
+		}
+	}
+	return nil
+}
+
+func readEvents(r io.Reader, handle func([]byte)) error {
+	var (
+		scanner = bufio.NewScanner(r)
+		event   string
+		data    []string
+	)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if len(data) > 0 && (event == "" || event == "message") {
+				handle([]byte(strings.Join(data, "\n")))
+			}
+			event, data = "", nil
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	return scanner.Err()
+}
+
+func errorResponse(id json.RawMessage, err error) []byte {
+	data, _ := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"error": map[string]any{
+			"code":    -32603,
+			"message": err.Error(),
+		},
+	})
+	return data
+}
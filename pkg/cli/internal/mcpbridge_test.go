@@ -0,0 +1,237 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeMCPServer answers initialize with a new session and every other request with its session ID. Forgetting the
+// sessions simulates a restart of the server.
+type fakeMCPServer struct {
+	lock     sync.Mutex
+	sessions map[string]bool
+	next     int
+}
+
+func (f *fakeMCPServer) forget() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.sessions = map[string]bool{}
+}
+
+func (f *fakeMCPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var msg struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	body, _ := io.ReadAll(r.Body)
+	_ = json.Unmarshal(body, &msg)
+
+	if msg.Method == "initialize" {
+		f.next++
+		sessionID := fmt.Sprintf("s%d", f.next)
+		f.sessions[sessionID] = true
+		w.Header().Set("Mcp-Session-Id", sessionID)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{"protocolVersion":"2025-06-18"}}`, msg.ID)
+		return
+	}
+
+	sessionID := r.Header.Get("Mcp-Session-Id")
+	if !f.sessions[sessionID] {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if len(msg.ID) == 0 {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	// Answer with an event stream, as servers do for requests that send notifications before the result.
+	w.Header().Set("Content-Type", "text/event-stream")
+	_, _ = fmt.Fprintf(w, "event: message\ndata: {\"jsonrpc\":\"2.0\",\n")
+	_, _ = fmt.Fprintf(w, "data: \"id\":%s,\"result\":{\"session\":%q}}\n\n", msg.ID, sessionID)
+}
+
+func newTestBridge(url string) *MCPBridge {
+	return &MCPBridge{
+		URL: url,
+		Token: func(context.Context, bool) (string, error) {
+			return "token", nil
+		},
+	}
+}
+
+func TestMCPBridgeReconnects(t *testing.T) {
+	fake := &fakeMCPServer{sessions: map[string]bool{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	var (
+		in, inWriter   = io.Pipe()
+		outReader, out = io.Pipe()
+		lines          = bufio.NewScanner(outReader)
+		done           = make(chan error, 1)
+	)
+	go func() {
+		done <- newTestBridge(server.URL).Run(context.Background(), in, out)
+	}()
+
+	expect := func(expected string) {
+		t.Helper()
+		if !lines.Scan() {
+			t.Fatalf("expected %s, got nothing", expected)
+		}
+		if lines.Text() != expected {
+			t.Fatalf("expected %s, got %s", expected, lines.Text())
+		}
+	}
+
+	_, _ = io.WriteString(inWriter, `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`+"\n")
+	expect(`{"jsonrpc":"2.0","id":1,"result":{"protocolVersion":"2025-06-18"}}`)
+
+	_, _ = io.WriteString(inWriter, `{"jsonrpc":"2.0","method":"notifications/initialized"}`+"\n")
+	_, _ = io.WriteString(inWriter, `{"jsonrpc":"2.0","id":2,"method":"tools/list"}`+"\n")
+	expect(`{"jsonrpc":"2.0","id":2,"result":{"session":"s1"}}`)
+
+	fake.forget()
+
+	// The initialize request is replayed on a new session, without answering it again.
+	_, _ = io.WriteString(inWriter, `{"jsonrpc":"2.0","id":3,"method":"tools/list"}`+"\n")
+	expect(`{"jsonrpc":"2.0","id":3,"result":{"session":"s2"}}`)
+
+	_ = inWriter.Close()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMCPBridgeReportsErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "server is not healthy", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+	in := strings.NewReader(`{"jsonrpc":"2.0","id":"a","method":"initialize","params":{}}` + "\n")
+	if err := newTestBridge(server.URL).Run(context.Background(), in, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"error":{"code":-32603,"message":"503 Service Unavailable: server is not healthy"},"id":"a","jsonrpc":"2.0"}`
+	if got := strings.TrimSpace(out.String()); got != expected {
+		t.Fatalf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestMCPBridgeRefreshesRejectedToken(t *testing.T) {
+	fake := &fakeMCPServer{sessions: map[string]bool{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	var refreshes []bool
+	bridge := &MCPBridge{
+		URL: server.URL,
+		Token: func(_ context.Context, refresh bool) (string, error) {
+			refreshes = append(refreshes, refresh)
+			if refresh {
+				return "token", nil
+			}
+			return "expired", nil
+		},
+	}
+
+	var out bytes.Buffer
+	in := strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}` + "\n")
+	if err := bridge.Run(context.Background(), in, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"jsonrpc":"2.0","id":1,"result":{"protocolVersion":"2025-06-18"}}`
+	if got := strings.TrimSpace(out.String()); got != expected {
+		t.Fatalf("expected %s, got %s", expected, got)
+	}
+	if len(refreshes) != 2 || refreshes[0] || !refreshes[1] {
+		t.Errorf("expected the cached token to be replaced by a refreshed token, got calls with refresh %v", refreshes)
+	}
+}
+
+func TestMCPBridgeStopsRefreshingRejectedTokens(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	var tokens int
+	bridge := &MCPBridge{
+		URL: server.URL,
+		Token: func(context.Context, bool) (string, error) {
+			tokens++
+			return fmt.Sprintf("token%d", tokens), nil
+		},
+	}
+
+	var (
+		out bytes.Buffer
+		in  strings.Builder
+	)
+	for i := range 2 * maxTokenRefreshes {
+		fmt.Fprintf(&in, `{"jsonrpc":"2.0","id":%d,"method":"initialize","params":{}}`+"\n", i)
+	}
+	if err := bridge.Run(context.Background(), strings.NewReader(in.String()), &out); err != nil {
+		t.Fatal(err)
+	}
+
+	if tokens != maxTokenRefreshes+1 {
+		t.Errorf("expected the first token and %d refreshed tokens, got %d tokens", maxTokenRefreshes, tokens)
+	}
+	if responses := strings.Count(out.String(), `"error"`); responses != 2*maxTokenRefreshes {
+		t.Errorf("expected every request to be answered with an error, got %d errors:\n%s", responses, out.String())
+	}
+}
+
+func TestMCPBridgeFailsWhenTheTokenDoesNotChange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	var calls int
+	bridge := &MCPBridge{
+		URL: server.URL,
+		Token: func(context.Context, bool) (string, error) {
+			calls++
+			return "api-key", nil
+		},
+	}
+
+	var out bytes.Buffer
+	in := strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}` + "\n")
+	if err := bridge.Run(context.Background(), in, &out); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || !strings.Contains(out.String(), "no new token is available") {
+		t.Errorf("expected one refresh and an error, got %d calls and %s", calls, out.String())
+	}
+}
@@ -41,6 +41,16 @@ func enter(ctx context.Context) error {
 }
 
 func Token(ctx context.Context, baseURL string, noExpiration, forceRefresh bool) (string, error) {
+	return token(ctx, baseURL, noExpiration, forceRefresh, true)
+}
+
+// NonInteractiveToken is like Token, but never reads from stdin. It is used by commands that use stdin for other
+// purposes, and fails if the user has to select an authentication provider.
+func NonInteractiveToken(ctx context.Context, baseURL string, noExpiration, forceRefresh bool) (string, error) {
+	return token(ctx, baseURL, noExpiration, forceRefresh, false)
+}
+
+func token(ctx context.Context, baseURL string, noExpiration, forceRefresh, interactive bool) (string, error) {
 	// Check to see if authentication is required for this baseURL
 	if testToken(ctx, baseURL, "") {
 		return "", nil
@@ -80,7 +90,7 @@ func Token(ctx context.Context, baseURL string, noExpiration, forceRefresh bool)
 		return token, nil
 	}
 
-	provider, err := userSelectAuthProvider(authProviders)
+	provider, err := userSelectAuthProvider(authProviders, interactive)
 	if err != nil {
 		return "", err
 	}
@@ -91,7 +101,7 @@ func Token(ctx context.Context, baseURL string, noExpiration, forceRefresh bool)
 		return "", fmt.Errorf("failed to create login request: %w", err)
 	}
 
-	if !existed {
+	if !existed && interactive {
 		fmt.Println()
 		fmt.Println(color.GreenString("Authentication is needed"))
 		fmt.Println(color.GreenString("========================"))
@@ -250,7 +260,7 @@ func getAuthProviderServiceInfo(ctx context.Context, baseURL string) ([]types2.A
 	return authProviders.Items, nil
 }
 
-func userSelectAuthProvider(authProviders []types2.AuthProvider) (types2.AuthProvider, error) {
+func userSelectAuthProvider(authProviders []types2.AuthProvider, interactive bool) (types2.AuthProvider, error) {
 	var configuredAuthProviders []types2.AuthProvider
 	for _, provider := range authProviders {
 		if provider.Configured {
@@ -262,6 +272,8 @@ func userSelectAuthProvider(authProviders []types2.AuthProvider) (types2.AuthPro
 		return types2.AuthProvider{}, fmt.Errorf("no configured auth providers found")
 	} else if len(configuredAuthProviders) == 1 {
 		return configuredAuthProviders[0], nil
+	} else if !interactive {
+		return types2.AuthProvider{}, fmt.Errorf("multiple auth providers are configured, run `obot token` to log in first")
 	}
 
 	sort.Slice(configuredAuthProviders, func(i, j int) bool {
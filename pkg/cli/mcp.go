@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"github.com/gptscript-ai/cmd"
+	"github.com/obot-platform/obot/apiclient"
+	"github.com/spf13/cobra"
+)
+
+type MCP struct{}
+
+func (m *MCP) Customize(cmd *cobra.Command) {
+	cmd.Use = "mcp"
+	cmd.Short = "Manage and connect to MCP servers, catalog entries, catalogs, access control rules and webhooks"
+}
+
+func (m *MCP) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func newMCP(root *Obot) *cobra.Command {
+	// Subcommands are added with AddCommand because cmd.Command uses a *cobra.Command as its first child as the base
+	// of the command.
+	mcp := cmd.Command(&MCP{})
+	mcp.AddCommand(
+		cmd.Command(&MCPServers{},
+			&MCPServersList{root: root},
+			&MCPServersGet{root: root},
+			&MCPServersCreate{root: root},
+			&MCPServersUpdate{root: root},
+			&MCPServersDelete{root: root},
+			&MCPServersLogs{root: root},
+			&MCPServersRestart{root: root},
+		),
+		cmd.Command(&MCPEntries{},
+			&MCPEntriesList{root: root},
+			&MCPEntriesGet{root: root},
+			&MCPEntriesCreate{root: root},
+			&MCPEntriesUpdate{root: root},
+			&MCPEntriesDelete{root: root},
+		),
+		cmd.Command(&MCPCatalogs{},
+			&MCPCatalogsList{root: root},
+			&MCPCatalogsGet{root: root},
+			&MCPCatalogsUpdate{root: root},
+			&MCPCatalogsRefresh{root: root},
+		),
+		cmd.Command(&MCPAccessControlRules{},
+			&MCPAccessControlRulesList{root: root},
+			&MCPAccessControlRulesGet{root: root},
+			&MCPAccessControlRulesCreate{root: root},
+			&MCPAccessControlRulesUpdate{root: root},
+			&MCPAccessControlRulesDelete{root: root},
+		),
+		cmd.Command(&MCPWebhooks{},
+			&MCPWebhooksList{root: root},
+			&MCPWebhooksGet{root: root},
+			&MCPWebhooksCreate{root: root},
+			&MCPWebhooksUpdate{root: root},
+			&MCPWebhooksDelete{root: root},
+		),
+		cmd.Command(&MCPConnect{root: root}),
+		cmd.Command(&MCPConfig{root: root}),
+	)
+	return mcp
+}
+
+// CatalogFlags adds the --catalog flag to commands for objects that belong to a catalog.
+type CatalogFlags struct {
+	Catalog string `usage:"ID of the catalog" default:"default" env:"OBOT_MCP_CATALOG"`
+}
+
+// ServerFlags adds the --catalog flag to MCP server commands. Without it, commands act on single-user servers.
+type ServerFlags struct {
+	Catalog string `usage:"ID of the catalog of a multi-user server, omit for single-user servers" env:"OBOT_MCP_CATALOG"`
+}
+
+func (s ServerFlags) options() apiclient.MCPServerOptions {
+	return apiclient.MCPServerOptions{
+		CatalogID: s.Catalog,
+	}
+}
@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/spf13/cobra"
+)
+
+type MCPAccessControlRules struct{}
+
+func (m *MCPAccessControlRules) Customize(cmd *cobra.Command) {
+	cmd.Use = "acr"
+	cmd.Aliases = []string{"access-control-rules"}
+	cmd.Short = "Manage access control rules of MCP catalogs"
+}
+
+func (m *MCPAccessControlRules) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func printAccessControlRules(o OutputFlags, obj any, rules ...types.AccessControlRule) error {
+	return o.print(obj, func(w io.Writer) {
+		row(w, "ID", "NAME", "SUBJECTS", "RESOURCES", "AGE")
+		for _, rule := range rules {
+			row(w, rule.ID, rule.DisplayName, len(rule.Subjects), len(rule.Resources), age(rule.Created))
+		}
+	})
+}
+
+type MCPAccessControlRulesList struct {
+	OutputFlags
+	CatalogFlags
+	root *Obot
+}
+
+func (m *MCPAccessControlRulesList) Customize(cmd *cobra.Command) {
+	cmd.Use = "list"
+	cmd.Aliases = []string{"ls"}
+	cmd.Args = cobra.NoArgs
+}
+
+func (m *MCPAccessControlRulesList) Run(cmd *cobra.Command, _ []string) error {
+	rules, err := m.root.Client.ListAccessControlRules(cmd.Context(), m.Catalog)
+	if err != nil {
+		return err
+	}
+	return printAccessControlRules(m.OutputFlags, rules, rules.Items...)
+}
+
+type MCPAccessControlRulesGet struct {
+	OutputFlags
+	CatalogFlags
+	root *Obot
+}
+
+func (m *MCPAccessControlRulesGet) Customize(cmd *cobra.Command) {
+	cmd.Use = "get ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPAccessControlRulesGet) Run(cmd *cobra.Command, args []string) error {
+	rule, err := m.root.Client.GetAccessControlRule(cmd.Context(), m.Catalog, args[0])
+	if err != nil {
+		return err
+	}
+	return printAccessControlRules(m.OutputFlags, rule, *rule)
+}
+
+type MCPAccessControlRulesCreate struct {
+	OutputFlags
+	CatalogFlags
+	File string `usage:"JSON or YAML file with the access control rule manifest, - for stdin" short:"f" env:"OBOT_MCP_FILE"`
+	root *Obot
+}
+
+func (m *MCPAccessControlRulesCreate) Customize(cmd *cobra.Command) {
+	cmd.Use = "create"
+	cmd.Args = cobra.NoArgs
+}
+
+func (m *MCPAccessControlRulesCreate) Run(cmd *cobra.Command, _ []string) error {
+	var manifest types.AccessControlRuleManifest
+	if err := readManifest(m.File, &manifest); err != nil {
+		return err
+	}
+
+	rule, err := m.root.Client.CreateAccessControlRule(cmd.Context(), m.Catalog, manifest)
+	if err != nil {
+		return err
+	}
+	return printAccessControlRules(m.OutputFlags, rule, *rule)
+}
+
+type MCPAccessControlRulesUpdate struct {
+	OutputFlags
+	CatalogFlags
+	File string `usage:"JSON or YAML file with the access control rule manifest, - for stdin" short:"f" env:"OBOT_MCP_FILE"`
+	root *Obot
+}
+
+func (m *MCPAccessControlRulesUpdate) Customize(cmd *cobra.Command) {
+	cmd.Use = "update ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPAccessControlRulesUpdate) Run(cmd *cobra.Command, args []string) error {
+	var manifest types.AccessControlRuleManifest
+	if err := readManifest(m.File, &manifest); err != nil {
+		return err
+	}
+
+	rule, err := m.root.Client.UpdateAccessControlRule(cmd.Context(), m.Catalog, args[0], manifest)
+	if err != nil {
+		return err
+	}
+	return printAccessControlRules(m.OutputFlags, rule, *rule)
+}
+
+type MCPAccessControlRulesDelete struct {
+	CatalogFlags
+	root *Obot
+}
+
+func (m *MCPAccessControlRulesDelete) Customize(cmd *cobra.Command) {
+	cmd.Use = "delete ID..."
+	cmd.Aliases = []string{"rm"}
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (m *MCPAccessControlRulesDelete) Run(cmd *cobra.Command, args []string) error {
+	for _, id := range args {
+		if err := m.root.Client.DeleteAccessControlRule(cmd.Context(), m.Catalog, id); err != nil {
+			return fmt.Errorf("failed to delete access control rule %s: %w", id, err)
+		}
+		fmt.Println(id)
+	}
+	return nil
+}
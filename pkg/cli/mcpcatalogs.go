@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/spf13/cobra"
+)
+
+type MCPCatalogs struct{}
+
+func (m *MCPCatalogs) Customize(cmd *cobra.Command) {
+	cmd.Use = "catalogs"
+	cmd.Aliases = []string{"catalog"}
+	cmd.Short = "Manage MCP catalogs"
+}
+
+func (m *MCPCatalogs) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func printMCPCatalogs(o OutputFlags, obj any, catalogs ...types.MCPCatalog) error {
+	return o.print(obj, func(w io.Writer) {
+		row(w, "ID", "NAME", "SOURCES", "SYNCING", "SYNC ERRORS", "LAST SYNCED")
+		for _, catalog := range catalogs {
+			row(w, catalog.ID, catalog.DisplayName, strings.Join(catalog.SourceURLs, ","), catalog.IsSyncing,
+				len(catalog.SyncErrors), age(catalog.LastSynced))
+		}
+	})
+}
+
+type MCPCatalogsList struct {
+	OutputFlags
+	root *Obot
+}
+
+func (m *MCPCatalogsList) Customize(cmd *cobra.Command) {
+	cmd.Use = "list"
+	cmd.Aliases = []string{"ls"}
+	cmd.Args = cobra.NoArgs
+}
+
+func (m *MCPCatalogsList) Run(cmd *cobra.Command, _ []string) error {
+	catalogs, err := m.root.Client.ListMCPCatalogs(cmd.Context())
+	if err != nil {
+		return err
+	}
+	return printMCPCatalogs(m.OutputFlags, catalogs, catalogs.Items...)
+}
+
+type MCPCatalogsGet struct {
+	OutputFlags
+	root *Obot
+}
+
+func (m *MCPCatalogsGet) Customize(cmd *cobra.Command) {
+	cmd.Use = "get ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPCatalogsGet) Run(cmd *cobra.Command, args []string) error {
+	catalog, err := m.root.Client.GetMCPCatalog(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+	return printMCPCatalogs(m.OutputFlags, catalog, *catalog)
+}
+
+type MCPCatalogsUpdate struct {
+	OutputFlags
+	File string `usage:"JSON or YAML file with the catalog manifest, - for stdin" short:"f" env:"OBOT_MCP_FILE"`
+	root *Obot
+}
+
+func (m *MCPCatalogsUpdate) Customize(cmd *cobra.Command) {
+	cmd.Use = "update ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPCatalogsUpdate) Run(cmd *cobra.Command, args []string) error {
+	var manifest types.MCPCatalogManifest
+	if err := readManifest(m.File, &manifest); err != nil {
+		return err
+	}
+
+	catalog, err := m.root.Client.UpdateMCPCatalog(cmd.Context(), args[0], manifest)
+	if err != nil {
+		return err
+	}
+	return printMCPCatalogs(m.OutputFlags, catalog, *catalog)
+}
+
+type MCPCatalogsRefresh struct {
+	root *Obot
+}
+
+func (m *MCPCatalogsRefresh) Customize(cmd *cobra.Command) {
+	cmd.Use = "refresh ID"
+	cmd.Short = "Sync a catalog from its sources"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPCatalogsRefresh) Run(cmd *cobra.Command, args []string) error {
+	if err := m.root.Client.RefreshMCPCatalog(cmd.Context(), args[0]); err != nil {
+		return err
+	}
+	fmt.Println(args[0])
+	return nil
+}
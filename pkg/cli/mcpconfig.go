@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/spf13/cobra"
+)
+
+type MCPConfig struct {
+	Output          string `usage:"Output format: config, json or yaml" short:"o" default:"config"`
+	Format          string `usage:"Client configuration format: claude-desktop, cursor, vscode, codex or mcp-json" default:"mcp-json"`
+	APIKeys         bool   `usage:"Create an API key for the servers and add it to the configuration, instead of logging in with OAuth. It replaces the key of the previous configuration of the format" name:"api-keys"`
+	APIKeyExpiresIn string `usage:"Expire the created API key after this duration, such as 720h. It expires after 90 days by default" name:"api-key-expires-in"`
+	root            *Obot
+}
+
+func (m *MCPConfig) Customize(cmd *cobra.Command) {
+	cmd.Use = "config [SERVER...]"
+	cmd.Short = "Print the MCP client configuration for the MCP servers you can connect to"
+	cmd.Long = `Print the MCP client configuration for the MCP servers you can connect to.
+
+Servers are selected by ID or by name, all servers are included if none are given. Merge the printed configuration into
+the configuration of the client, its usual location is printed to stderr.`
+}
+
+func (m *MCPConfig) Run(cmd *cobra.Command, args []string) error {
+	req := types.MCPClientConfigRequest{
+		Format:      types.MCPClientConfigFormat(m.Format),
+		Servers:     args,
+		MintAPIKeys: m.APIKeys,
+	}
+	if m.APIKeyExpiresIn != "" {
+		if !m.APIKeys {
+			return fmt.Errorf("--api-key-expires-in requires --api-keys")
+		}
+		d, err := time.ParseDuration(m.APIKeyExpiresIn)
+		if err != nil {
+			return fmt.Errorf("invalid --api-key-expires-in: %w", err)
+		}
+		req.APIKeyExpiresAt = types.NewTime(time.Now().Add(d))
+	}
+
+	config, err := m.root.Client.GetMCPClientConfig(cmd.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(m.Output, "config") {
+		return OutputFlags{Output: m.Output}.print(config, nil)
+	}
+
+	for _, skipped := range config.Skipped {
+		fmt.Fprintf(os.Stderr, "Skipped %s: %s\n", skipped.Name, skipped.Reason)
+	}
+	if len(config.Servers) == 0 {
+		return fmt.Errorf("no MCP servers to connect to")
+	}
+	path := config.Path
+	if osPath := config.Paths[runtime.GOOS]; osPath != "" {
+		path = osPath
+	}
+	if path == "" {
+		path = "the configuration of " + string(config.Format)
+	}
+	fmt.Fprintf(os.Stderr, "Merge into %s:\n", path)
+	fmt.Print(config.Content)
+	return nil
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient"
+	"github.com/obot-platform/obot/pkg/cli/internal"
+	"github.com/pkg/browser"
+	"github.com/spf13/cobra"
+)
+
+type MCPConnect struct {
+	ServerFlags
+	root *Obot
+}
+
+func (m *MCPConnect) Customize(cmd *cobra.Command) {
+	cmd.Use = "connect SERVER_ID"
+	cmd.Short = "Connect an MCP client that only supports stdio to an MCP server"
+	cmd.Long = `Connect an MCP client that only supports stdio to an MCP server.
+
+The command reads MCP messages from stdin, sends them to the server through the Obot MCP gateway and writes the
+messages of the server to stdout. Configure it as the command of a stdio server in the MCP client, for example:
+
+  {"command": "obot", "args": ["mcp", "connect", "ms1abc"], "env": {"OBOT_BASE_URL": "https://obot.example.com/api"}}
+
+It uses the token of "obot token", logging in with the browser if needed, or the API key in OBOT_API_KEY.`
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPConnect) Run(cmd *cobra.Command, args []string) error {
+	// Stdout belongs to the MCP client, so everything else, such as the login instructions, goes to stderr.
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	// Stdin belongs to the MCP client too, so logging in must not prompt.
+	token := func(ctx context.Context, refresh bool) (string, error) {
+		if m.root.Client.Token != "" {
+			if refresh {
+				return "", fmt.Errorf("the server rejected the token in OBOT_TOKEN or OBOT_API_KEY")
+			}
+			return m.root.Client.Token, nil
+		}
+		return internal.NonInteractiveToken(ctx, m.root.Client.BaseURL, false, refresh)
+	}
+
+	client := m.root.Client
+	if client.Token == "" {
+		client = client.WithTokenFetcher(internal.NonInteractiveToken)
+	}
+	if err := m.authorize(ctx, client, args[0]); err != nil {
+		return err
+	}
+
+	return (&internal.MCPBridge{
+		URL:   strings.TrimSuffix(m.root.Client.BaseURL, "/api") + "/mcp-connect/" + args[0],
+		Token: token,
+	}).Run(ctx, os.Stdin, stdout)
+}
+
+// authorize waits for the user to authorize servers that need OAuth, such as remote servers of SaaS providers.
+func (m *MCPConnect) authorize(ctx context.Context, client *apiclient.Client, id string) error {
+	oauthURL, err := client.GetMCPServerOAuthURL(ctx, id, m.options())
+	if err != nil || oauthURL == "" {
+		// Servers that can't be looked up this way, such as system servers, are connected anyway and the gateway
+		// reports what is missing.
+		return nil
+	}
+
+	fmt.Fprintf(os.Stderr, "MCP server %s needs authorization. Opening browser to %s. If there is an issue, paste this link into a browser manually\n", id, oauthURL)
+	_ = browser.OpenURL(oauthURL)
+
+	ctx, timeoutCancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer timeoutCancel()
+
+	for oauthURL != "" {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for authorization of MCP server %s", id)
+		case <-time.After(2 * time.Second):
+		}
+
+		if oauthURL, err = client.GetMCPServerOAuthURL(ctx, id, m.options()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/spf13/cobra"
+)
+
+type MCPEntries struct{}
+
+func (m *MCPEntries) Customize(cmd *cobra.Command) {
+	cmd.Use = "entries"
+	cmd.Aliases = []string{"entry"}
+	cmd.Short = "Manage MCP server catalog entries"
+}
+
+func (m *MCPEntries) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func printMCPEntries(o OutputFlags, obj any, entries ...types.MCPServerCatalogEntry) error {
+	return o.print(obj, func(w io.Writer) {
+		row(w, "ID", "NAME", "RUNTIME", "SOURCE", "USERS", "AGE")
+		for _, entry := range entries {
+			row(w, entry.ID, entry.Manifest.Name, entry.Manifest.Runtime, entry.SourceURL, entry.UserCount, age(entry.Created))
+		}
+	})
+}
+
+type MCPEntriesList struct {
+	OutputFlags
+	CatalogFlags
+	root *Obot
+}
+
+func (m *MCPEntriesList) Customize(cmd *cobra.Command) {
+	cmd.Use = "list"
+	cmd.Aliases = []string{"ls"}
+	cmd.Args = cobra.NoArgs
+}
+
+func (m *MCPEntriesList) Run(cmd *cobra.Command, _ []string) error {
+	entries, err := m.root.Client.ListMCPServerCatalogEntries(cmd.Context(), m.Catalog)
+	if err != nil {
+		return err
+	}
+	return printMCPEntries(m.OutputFlags, entries, entries.Items...)
+}
+
+type MCPEntriesGet struct {
+	OutputFlags
+	CatalogFlags
+	root *Obot
+}
+
+func (m *MCPEntriesGet) Customize(cmd *cobra.Command) {
+	cmd.Use = "get ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPEntriesGet) Run(cmd *cobra.Command, args []string) error {
+	entry, err := m.root.Client.GetMCPServerCatalogEntry(cmd.Context(), m.Catalog, args[0])
+	if err != nil {
+		return err
+	}
+	return printMCPEntries(m.OutputFlags, entry, *entry)
+}
+
+type MCPEntriesCreate struct {
+	OutputFlags
+	CatalogFlags
+	File string `usage:"JSON or YAML file with the catalog entry manifest, - for stdin" short:"f" env:"OBOT_MCP_FILE"`
+	root *Obot
+}
+
+func (m *MCPEntriesCreate) Customize(cmd *cobra.Command) {
+	cmd.Use = "create"
+	cmd.Args = cobra.NoArgs
+}
+
+func (m *MCPEntriesCreate) Run(cmd *cobra.Command, _ []string) error {
+	var manifest types.MCPServerCatalogEntryManifest
+	if err := readManifest(m.File, &manifest); err != nil {
+		return err
+	}
+
+	entry, err := m.root.Client.CreateMCPServerCatalogEntry(cmd.Context(), m.Catalog, manifest)
+	if err != nil {
+		return err
+	}
+	return printMCPEntries(m.OutputFlags, entry, *entry)
+}
+
+type MCPEntriesUpdate struct {
+	OutputFlags
+	CatalogFlags
+	File string `usage:"JSON or YAML file with the catalog entry manifest, - for stdin" short:"f" env:"OBOT_MCP_FILE"`
+	root *Obot
+}
+
+func (m *MCPEntriesUpdate) Customize(cmd *cobra.Command) {
+	cmd.Use = "update ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPEntriesUpdate) Run(cmd *cobra.Command, args []string) error {
+	var manifest types.MCPServerCatalogEntryManifest
+	if err := readManifest(m.File, &manifest); err != nil {
+		return err
+	}
+
+	entry, err := m.root.Client.UpdateMCPServerCatalogEntry(cmd.Context(), m.Catalog, args[0], manifest)
+	if err != nil {
+		return err
+	}
+	return printMCPEntries(m.OutputFlags, entry, *entry)
+}
+
+type MCPEntriesDelete struct {
+	CatalogFlags
+	root *Obot
+}
+
+func (m *MCPEntriesDelete) Customize(cmd *cobra.Command) {
+	cmd.Use = "delete ID..."
+	cmd.Aliases = []string{"rm"}
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (m *MCPEntriesDelete) Run(cmd *cobra.Command, args []string) error {
+	for _, id := range args {
+		if err := m.root.Client.DeleteMCPServerCatalogEntry(cmd.Context(), m.Catalog, id); err != nil {
+			return fmt.Errorf("failed to delete catalog entry %s: %w", id, err)
+		}
+		fmt.Println(id)
+	}
+	return nil
+}
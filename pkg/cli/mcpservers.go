@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/spf13/cobra"
+)
+
+type MCPServers struct{}
+
+func (m *MCPServers) Customize(cmd *cobra.Command) {
+	cmd.Use = "servers"
+	cmd.Aliases = []string{"server"}
+	cmd.Short = "Manage MCP servers"
+}
+
+func (m *MCPServers) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func printMCPServers(o OutputFlags, obj any, servers ...types.MCPServer) error {
+	return o.print(obj, func(w io.Writer) {
+		row(w, "ID", "NAME", "RUNTIME", "USER", "CATALOG ENTRY", "CONFIGURED", "STATUS", "AGE")
+		for _, server := range servers {
+			row(w, server.ID, server.MCPServerManifest.Name, server.MCPServerManifest.Runtime, server.UserID,
+				server.CatalogEntryID, server.Configured, server.DeploymentStatus, age(server.Created))
+		}
+	})
+}
+
+type MCPServersList struct {
+	OutputFlags
+	ServerFlags
+	root *Obot
+}
+
+func (m *MCPServersList) Customize(cmd *cobra.Command) {
+	cmd.Use = "list"
+	cmd.Aliases = []string{"ls"}
+	cmd.Args = cobra.NoArgs
+}
+
+func (m *MCPServersList) Run(cmd *cobra.Command, _ []string) error {
+	servers, err := m.root.Client.ListMCPServers(cmd.Context(), m.options())
+	if err != nil {
+		return err
+	}
+	return printMCPServers(m.OutputFlags, servers, servers.Items...)
+}
+
+type MCPServersGet struct {
+	OutputFlags
+	ServerFlags
+	root *Obot
+}
+
+func (m *MCPServersGet) Customize(cmd *cobra.Command) {
+	cmd.Use = "get ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPServersGet) Run(cmd *cobra.Command, args []string) error {
+	server, err := m.root.Client.GetMCPServer(cmd.Context(), args[0], m.options())
+	if err != nil {
+		return err
+	}
+	return printMCPServers(m.OutputFlags, server, *server)
+}
+
+type MCPServersCreate struct {
+	OutputFlags
+	ServerFlags
+	File string `usage:"JSON or YAML file with the MCP server, - for stdin" short:"f" env:"OBOT_MCP_FILE"`
+	root *Obot
+}
+
+func (m *MCPServersCreate) Customize(cmd *cobra.Command) {
+	cmd.Use = "create"
+	cmd.Args = cobra.NoArgs
+}
+
+func (m *MCPServersCreate) Run(cmd *cobra.Command, _ []string) error {
+	var server types.MCPServer
+	if err := readManifest(m.File, &server); err != nil {
+		return err
+	}
+
+	created, err := m.root.Client.CreateMCPServer(cmd.Context(), server, m.options())
+	if err != nil {
+		return err
+	}
+	return printMCPServers(m.OutputFlags, created, *created)
+}
+
+type MCPServersUpdate struct {
+	OutputFlags
+	ServerFlags
+	File string `usage:"JSON or YAML file with the MCP server manifest, - for stdin" short:"f" env:"OBOT_MCP_FILE"`
+	root *Obot
+}
+
+func (m *MCPServersUpdate) Customize(cmd *cobra.Command) {
+	cmd.Use = "update ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPServersUpdate) Run(cmd *cobra.Command, args []string) error {
+	var manifest types.MCPServerManifest
+	if err := readManifest(m.File, &manifest); err != nil {
+		return err
+	}
+
+	updated, err := m.root.Client.UpdateMCPServer(cmd.Context(), args[0], manifest, m.options())
+	if err != nil {
+		return err
+	}
+	return printMCPServers(m.OutputFlags, updated, *updated)
+}
+
+type MCPServersDelete struct {
+	ServerFlags
+	root *Obot
+}
+
+func (m *MCPServersDelete) Customize(cmd *cobra.Command) {
+	cmd.Use = "delete ID..."
+	cmd.Aliases = []string{"rm"}
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (m *MCPServersDelete) Run(cmd *cobra.Command, args []string) error {
+	for _, id := range args {
+		if err := m.root.Client.DeleteMCPServer(cmd.Context(), id, m.options()); err != nil {
+			return fmt.Errorf("failed to delete MCP server %s: %w", id, err)
+		}
+		fmt.Println(id)
+	}
+	return nil
+}
+
+type MCPServersLogs struct {
+	ServerFlags
+	root *Obot
+}
+
+func (m *MCPServersLogs) Customize(cmd *cobra.Command) {
+	cmd.Use = "logs ID"
+	cmd.Short = "Stream the logs of an MCP server"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPServersLogs) Run(cmd *cobra.Command, args []string) error {
+	lines, err := m.root.Client.MCPServerLogs(cmd.Context(), args[0], m.options())
+	if err != nil {
+		return err
+	}
+	for line := range lines {
+		fmt.Println(line)
+	}
+	return nil
+}
+
+type MCPServersRestart struct {
+	ServerFlags
+	root *Obot
+}
+
+func (m *MCPServersRestart) Customize(cmd *cobra.Command) {
+	cmd.Use = "restart ID..."
+	cmd.Short = "Restart the deployments of MCP servers"
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (m *MCPServersRestart) Run(cmd *cobra.Command, args []string) error {
+	for _, id := range args {
+		if err := m.root.Client.RestartMCPServer(cmd.Context(), id, m.options()); err != nil {
+			return fmt.Errorf("failed to restart MCP server %s: %w", id, err)
+		}
+		fmt.Println(id)
+	}
+	return nil
+}
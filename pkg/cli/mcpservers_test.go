@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// recordingServer answers every request of the CLI and records its method and path.
+type recordingServer struct {
+	lock     sync.Mutex
+	requests []string
+}
+
+func (r *recordingServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.lock.Lock()
+	r.requests = append(r.requests, req.Method+" "+req.URL.Path)
+	r.lock.Unlock()
+
+	switch {
+	case req.Header.Get("Accept") == "text/event-stream":
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "event: log\ndata: started\n\n")
+	case req.Method == http.MethodGet:
+		fmt.Fprint(w, `{"items":[]}`)
+	default:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func runCLI(t *testing.T, args ...string) []string {
+	t.Helper()
+
+	server := &recordingServer{}
+	srv := httptest.NewServer(server)
+	t.Cleanup(srv.Close)
+	t.Setenv("OBOT_BASE_URL", srv.URL+"/api")
+	t.Setenv("OBOT_TOKEN", "token")
+	t.Setenv("OBOT_MCP_CATALOG", "")
+
+	cmd := New()
+	cmd.SetArgs(args)
+	if err := cmd.ExecuteContext(t.Context()); err != nil {
+		t.Fatalf("obot %v failed: %v", args, err)
+	}
+	return server.requests
+}
+
+func TestMCPServersCatalogFlag(t *testing.T) {
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{
+			args: []string{"mcp", "servers", "list"},
+			want: []string{"GET /api/mcp-servers"},
+		},
+		{
+			args: []string{"mcp", "servers", "list", "--catalog", "team"},
+			want: []string{"GET /api/mcp-catalogs/team/servers"},
+		},
+		{
+			args: []string{"mcp", "servers", "logs", "ms1"},
+			want: []string{"GET /api/mcp-servers/ms1/logs"},
+		},
+		{
+			args: []string{"mcp", "servers", "logs", "--catalog", "team", "ms1"},
+			want: []string{"GET /api/mcp-catalogs/team/servers/ms1/logs"},
+		},
+		{
+			args: []string{"mcp", "servers", "restart", "ms1", "ms2"},
+			want: []string{"POST /api/mcp-servers/ms1/restart", "POST /api/mcp-servers/ms2/restart"},
+		},
+		{
+			args: []string{"mcp", "servers", "restart", "--catalog", "team", "ms1"},
+			want: []string{"POST /api/mcp-catalogs/team/servers/ms1/restart"},
+		},
+		{
+			args: []string{"mcp", "entries", "delete", "fetch"},
+			want: []string{"DELETE /api/mcp-catalogs/default/entries/fetch"},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := runCLI(t, tt.args...); !slices.Equal(got, tt.want) {
+			t.Errorf("expected obot %v to request %v, got %v", tt.args, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/spf13/cobra"
+)
+
+type MCPWebhooks struct{}
+
+func (m *MCPWebhooks) Customize(cmd *cobra.Command) {
+	cmd.Use = "webhooks"
+	cmd.Aliases = []string{"webhook"}
+	cmd.Short = "Manage MCP webhook validations"
+}
+
+func (m *MCPWebhooks) Run(cmd *cobra.Command, _ []string) error {
+	return cmd.Help()
+}
+
+func printMCPWebhooks(o OutputFlags, obj any, webhooks ...types.MCPWebhookValidation) error {
+	return o.print(obj, func(w io.Writer) {
+		row(w, "ID", "NAME", "URL", "RESOURCES", "DISABLED", "AGE")
+		for _, webhook := range webhooks {
+			row(w, webhook.ID, webhook.Name, webhook.URL, len(webhook.Resources), webhook.Disabled, age(webhook.Created))
+		}
+	})
+}
+
+type MCPWebhooksList struct {
+	OutputFlags
+	root *Obot
+}
+
+func (m *MCPWebhooksList) Customize(cmd *cobra.Command) {
+	cmd.Use = "list"
+	cmd.Aliases = []string{"ls"}
+	cmd.Args = cobra.NoArgs
+}
+
+func (m *MCPWebhooksList) Run(cmd *cobra.Command, _ []string) error {
+	webhooks, err := m.root.Client.ListMCPWebhookValidations(cmd.Context())
+	if err != nil {
+		return err
+	}
+	return printMCPWebhooks(m.OutputFlags, webhooks, webhooks.Items...)
+}
+
+type MCPWebhooksGet struct {
+	OutputFlags
+	root *Obot
+}
+
+func (m *MCPWebhooksGet) Customize(cmd *cobra.Command) {
+	cmd.Use = "get ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPWebhooksGet) Run(cmd *cobra.Command, args []string) error {
+	webhook, err := m.root.Client.GetMCPWebhookValidation(cmd.Context(), args[0])
+	if err != nil {
+		return err
+	}
+	return printMCPWebhooks(m.OutputFlags, webhook, *webhook)
+}
+
+type MCPWebhooksCreate struct {
+	OutputFlags
+	File string `usage:"JSON or YAML file with the webhook validation manifest, - for stdin" short:"f" env:"OBOT_MCP_FILE"`
+	root *Obot
+}
+
+func (m *MCPWebhooksCreate) Customize(cmd *cobra.Command) {
+	cmd.Use = "create"
+	cmd.Args = cobra.NoArgs
+}
+
+func (m *MCPWebhooksCreate) Run(cmd *cobra.Command, _ []string) error {
+	var manifest types.MCPWebhookValidationManifest
+	if err := readManifest(m.File, &manifest); err != nil {
+		return err
+	}
+
+	webhook, err := m.root.Client.CreateMCPWebhookValidation(cmd.Context(), manifest)
+	if err != nil {
+		return err
+	}
+	return printMCPWebhooks(m.OutputFlags, webhook, *webhook)
+}
+
+type MCPWebhooksUpdate struct {
+	OutputFlags
+	File string `usage:"JSON or YAML file with the webhook validation manifest, - for stdin" short:"f" env:"OBOT_MCP_FILE"`
+	root *Obot
+}
+
+func (m *MCPWebhooksUpdate) Customize(cmd *cobra.Command) {
+	cmd.Use = "update ID"
+	cmd.Args = cobra.ExactArgs(1)
+}
+
+func (m *MCPWebhooksUpdate) Run(cmd *cobra.Command, args []string) error {
+	var manifest types.MCPWebhookValidationManifest
+	if err := readManifest(m.File, &manifest); err != nil {
+		return err
+	}
+
+	webhook, err := m.root.Client.UpdateMCPWebhookValidation(cmd.Context(), args[0], manifest)
+	if err != nil {
+		return err
+	}
+	return printMCPWebhooks(m.OutputFlags, webhook, *webhook)
+}
+
+type MCPWebhooksDelete struct {
+	root *Obot
+}
+
+func (m *MCPWebhooksDelete) Customize(cmd *cobra.Command) {
+	cmd.Use = "delete ID..."
+	cmd.Aliases = []string{"rm"}
+	cmd.Args = cobra.MinimumNArgs(1)
+}
+
+func (m *MCPWebhooksDelete) Run(cmd *cobra.Command, args []string) error {
+	for _, id := range args {
+		if err := m.root.Client.DeleteMCPWebhookValidation(cmd.Context(), id); err != nil {
+			return fmt.Errorf("failed to delete webhook validation %s: %w", id, err)
+		}
+		fmt.Println(id)
+	}
+	return nil
+}
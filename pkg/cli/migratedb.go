@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/obot-platform/obot/pkg/dbmigration"
+	"github.com/obot-platform/obot/pkg/encryption"
+	"github.com/spf13/cobra"
+)
+
+type MigrateDB struct {
+	OutputFlags
+	encryption.Options
+	SourceDSN string `usage:"Database dsn of the SQLite installation" default:"sqlite://file:obot.db?_journal=WAL&cache=shared&_busy_timeout=30000" env:"OBOT_SERVER_DSN"`
+	TargetDSN string `usage:"Database dsn of the empty Postgres database to migrate to (required)"`
+	DryRun    bool   `usage:"Only report the rows that would be copied"`
+}
+
+func (m *MigrateDB) Customize(cmd *cobra.Command) {
+	cmd.Use = "migrate-db"
+	cmd.Short = "Copy the data of a SQLite installation of Obot to an empty Postgres database"
+	cmd.Long = `Copy the data of a SQLite installation of Obot to an empty Postgres database.
+
+Stop Obot before migrating. All storage objects, gateway tables and credentials are copied in a single transaction.
+Credentials and encrypted fields are copied as they are stored, so Obot must use the same encryption configuration
+after the migration. If an encryption provider is set, users and identities that were stored unencrypted are
+encrypted with it.`
+	cmd.Args = cobra.NoArgs
+}
+
+func (m *MigrateDB) Run(cmd *cobra.Command, _ []string) error {
+	if m.TargetDSN == "" {
+		return fmt.Errorf("a target database is required, use --target-dsn")
+	}
+
+	opts := dbmigration.Options{
+		SourceDSN: m.SourceDSN,
+		TargetDSN: m.TargetDSN,
+		DryRun:    m.DryRun,
+	}
+	if !m.DryRun {
+		encryptionConfig, _, err := encryption.Init(cmd.Context(), m.Options)
+		if err != nil {
+			return err
+		}
+		opts.EncryptionConfig = encryptionConfig
+	}
+
+	report, err := dbmigration.Migrate(cmd.Context(), opts)
+	if report != nil {
+		if err := m.print(report, func(w io.Writer) {
+			var rows, copied int64
+			row(w, "DATABASE", "TABLE", "ROWS", "COPIED", "SKIPPED")
+			for _, table := range report.Tables {
+				row(w, table.Database, table.Table, table.Rows, table.Copied, table.Skipped)
+				rows += table.Rows
+				copied += table.Copied
+			}
+			fmt.Fprintf(w, "\n%d tables, %d rows, %d copied\n", len(report.Tables), rows, copied)
+		}); err != nil {
+			return err
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if m.DryRun {
+		fmt.Fprintln(os.Stderr, "Dry run, nothing was copied")
+	} else {
+		fmt.Fprintln(os.Stderr, "Migrated to Postgres, start Obot with the target dsn as OBOT_SERVER_DSN")
+	}
+	return nil
+}
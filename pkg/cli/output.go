@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"sigs.k8s.io/yaml"
+)
+
+// OutputFlags adds the --output flag to commands that print API objects.
+type OutputFlags struct {
+	Output string `usage:"Output format: table, json or yaml" short:"o" default:"table" env:"OBOT_OUTPUT"`
+}
+
+// print writes obj as JSON or YAML, or calls table to write it as a table.
+func (o OutputFlags) print(obj any, table func(w io.Writer)) error {
+	switch strings.ToLower(o.Output) {
+	case "json":
+		data, err := json.MarshalIndent(obj, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	case "yaml":
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(data))
+	case "table", "":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		table(w)
+		return w.Flush()
+	default:
+		return fmt.Errorf("invalid output format %q, must be table, json or yaml", o.Output)
+	}
+	return nil
+}
+
+// row writes the tab-separated columns of a table row.
+func row(w io.Writer, columns ...any) {
+	for i, column := range columns {
+		if i > 0 {
+			fmt.Fprint(w, "\t")
+		}
+		fmt.Fprint(w, column)
+	}
+	fmt.Fprintln(w)
+}
+
+// age formats the time since t for tables.
+func age(t types.Time) string {
+	if t.Time.IsZero() {
+		return ""
+	}
+	return time.Since(t.Time).Round(time.Second).String()
+}
+
+// readManifest reads a JSON or YAML manifest from a file, or from stdin if file is "-".
+func readManifest(file string, obj any) error {
+	if file == "" {
+		return fmt.Errorf("a manifest file is required, use --file")
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, obj); err != nil {
+		return fmt.Errorf("failed to parse manifest %s: %w", file, err)
+	}
+	return nil
+}
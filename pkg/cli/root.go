@@ -38,12 +38,17 @@ func New() *cobra.Command {
 	root := &Obot{
 		Client: &apiclient.Client{
 			BaseURL: env.VarOrDefault("OBOT_BASE_URL", "http://localhost:8080/api"),
-			Token:   os.Getenv("OBOT_TOKEN"),
+			Token:   env.VarOrDefault("OBOT_TOKEN", os.Getenv("OBOT_API_KEY")),
 		},
 	}
 	return cmd.Command(root,
 		&Server{},
 		&Token{root: root},
+		newMCP(root),
+		newAudit(root),
+		&Backup{},
+		&Restore{},
+		&MigrateDB{},
 		&Version{},
 	)
 }
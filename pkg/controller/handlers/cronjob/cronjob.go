@@ -2,9 +2,9 @@ package cronjob
 
 import (
 	"fmt"
+	"hash/fnv"
 	"time"
 
-	"github.com/adhocore/gronx"
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
@@ -31,6 +31,8 @@ func GetScheduleAndTimezone(cronJob v1.CronJob) (string, string) {
 			schedule = fmt.Sprintf("%d %d * * *", cronJob.Spec.TaskSchedule.Minute, cronJob.Spec.TaskSchedule.Hour)
 		case "weekly":
 			schedule = fmt.Sprintf("%d %d * * %d", cronJob.Spec.TaskSchedule.Minute, cronJob.Spec.TaskSchedule.Hour, cronJob.Spec.TaskSchedule.Weekday)
+		case "cron":
+			schedule = cronJob.Spec.TaskSchedule.Expression
 		case "monthly":
 			if cronJob.Spec.TaskSchedule.Day < 0 {
 				// The day being -1 means the last day of the month. The cron parsing package we use uses `L` for this.
@@ -48,16 +50,39 @@ func GetScheduleAndTimezone(cronJob v1.CronJob) (string, string) {
 
 func (h *Handler) Run(req router.Request, resp router.Response) error {
 	cj := req.Object.(*v1.CronJob)
+	now := time.Now()
+
+	if cj.Spec.TaskSchedule != nil && cj.Spec.TaskSchedule.Paused {
+		cj.Status.Paused = true
+		return nil
+	}
+	if cj.Status.Paused {
+		// Skip the times that were missed while the schedule was paused.
+		cj.Status.Paused = false
+		cj.Status.LastScheduledAt = &metav1.Time{Time: now}
+		return nil
+	}
+
 	next, err := calculateNextRunTime(*cj)
 	if err != nil {
 		return fmt.Errorf("failed to calculate next run time: %w", err)
 	}
 
-	if until := time.Until(next); until > 0 {
+	if until := next.Add(jitter(*cj, next)).Sub(now); until > 0 {
 		resp.RetryAfter(until)
 		return nil
 	}
 
+	scheduledAt, run, err := catchUp(*cj, next, now)
+	if err != nil {
+		return fmt.Errorf("failed to calculate missed run times: %w", err)
+	}
+	cj.Status.LastScheduledAt = &metav1.Time{Time: scheduledAt}
+	if !run {
+		cj.Status.SkippedRuns++
+		return nil
+	}
+
 	var workflow v1.Workflow
 	if err := req.Get(&workflow, cj.Namespace, cj.Spec.WorkflowName); apierror.IsNotFound(err) {
 		return nil
@@ -65,6 +90,13 @@ func (h *Handler) Run(req router.Request, resp router.Response) error {
 		return err
 	}
 
+	if start, err := applyConcurrencyPolicy(req, cj); err != nil {
+		return err
+	} else if !start {
+		cj.Status.SkippedRuns++
+		return nil
+	}
+
 	if err = req.Client.Create(req.Ctx,
 		&v1.WorkflowExecution{
 			ObjectMeta: metav1.ObjectMeta{
@@ -86,25 +118,141 @@ func (h *Handler) Run(req router.Request, resp router.Response) error {
 	return nil
 }
 
+// catchUp decides whether a run is started for the scheduled time next, which is due, and returns the scheduled
+// time that is handled. When later scheduled times are also due, next was missed and the catch-up policy applies.
+// Only scheduled times in the run window count, and no run is started once the window has closed.
+func catchUp(cronJob v1.CronJob, next, now time.Time) (time.Time, bool, error) {
+	window, err := getRunWindow(cronJob)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	scheduledAt, run, err := catchUpInWindow(cronJob, window, next, now)
+	if err != nil || !run {
+		return scheduledAt, run, err
+	}
+	return scheduledAt, window.contains(now.In(getLocation(cronJob))), nil
+}
+
+func catchUpInWindow(cronJob v1.CronJob, window *runWindow, next, now time.Time) (time.Time, bool, error) {
+	schedule, _ := GetScheduleAndTimezone(cronJob)
+	location := getLocation(cronJob)
+
+	latest, err := prevTick(schedule, window, now.In(location), true)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if !latest.After(next) {
+		// Nothing was missed.
+		return next, true, nil
+	}
+
+	var policy types.CatchUpPolicy
+	if cronJob.Spec.TaskSchedule != nil {
+		policy = cronJob.Spec.TaskSchedule.CatchUpPolicy
+	}
+
+	switch policy {
+	case types.CatchUpPolicySkip:
+		// Handle every missed time at once without starting a run.
+		return latest, false, nil
+	case types.CatchUpPolicyAll:
+		// Start a run for each missed time, oldest first, but only for the most recent ones.
+		oldest := latest
+		for range types.MaxCatchUpRuns - 1 {
+			prev, err := prevTick(schedule, window, oldest, false)
+			if err != nil || !prev.After(next) {
+				return next, true, nil
+			}
+			oldest = prev
+		}
+		return oldest, true, nil
+	default:
+		// Start one run for all missed times.
+		return latest, true, nil
+	}
+}
+
+// applyConcurrencyPolicy returns whether a new run can be started while previous runs are still going.
+func applyConcurrencyPolicy(req router.Request, cronJob *v1.CronJob) (bool, error) {
+	var policy types.ConcurrencyPolicy
+	if cronJob.Spec.TaskSchedule != nil {
+		policy = cronJob.Spec.TaskSchedule.ConcurrencyPolicy
+	}
+	if policy == "" || policy == types.ConcurrencyPolicyAllow {
+		return true, nil
+	}
+
+	var workflowExecutions v1.WorkflowExecutionList
+	if err := req.List(&workflowExecutions, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(map[string]string{"spec.cronJobName": cronJob.Name}),
+		Namespace:     cronJob.Namespace,
+	}); err != nil {
+		return false, err
+	}
+
+	for _, execution := range workflowExecutions.Items {
+		if execution.Status.State.IsTerminal() || !execution.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		if policy == types.ConcurrencyPolicyForbid {
+			return false, nil
+		}
+
+		if execution.Status.ThreadName == "" {
+			// The run hasn't started yet, so there is nothing to abort.
+			if err := req.Client.Delete(req.Ctx, &execution); kclient.IgnoreNotFound(err) != nil {
+				return false, err
+			}
+			continue
+		}
+
+		var thread v1.Thread
+		if err := req.Get(&thread, execution.Namespace, execution.Status.ThreadName); apierror.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return false, err
+		}
+		if !thread.Spec.Abort {
+			thread.Spec.Abort = true
+			if err := req.Client.Update(req.Ctx, &thread); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// jitter returns the delay of the run for the scheduled time. It is derived from the cron job and the time, so that
+// it is the same every time the cron job is handled.
+func jitter(cronJob v1.CronJob, scheduledAt time.Time) time.Duration {
+	if cronJob.Spec.TaskSchedule == nil || cronJob.Spec.TaskSchedule.JitterSeconds <= 0 {
+		return 0
+	}
+
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%s/%d", cronJob.UID, scheduledAt.Unix())
+	return time.Duration(h.Sum64()%uint64(cronJob.Spec.TaskSchedule.JitterSeconds+1)) * time.Second
+}
+
 func calculateNextRunTime(cronJob v1.CronJob) (time.Time, error) {
-	lastRun := cronJob.Status.LastRunStartedAt
+	lastRun := cronJob.Status.LastScheduledAt
+	if lastRun.IsZero() {
+		lastRun = cronJob.Status.LastRunStartedAt
+	}
 	if lastRun.IsZero() {
 		lastRun = &metav1.Time{Time: cronJob.CreationTimestamp.Time}
 	}
 
-	schedule, timezone := GetScheduleAndTimezone(cronJob)
-	var location *time.Location
-	if timezone != "" {
-		loc, err := time.LoadLocation(timezone)
-		if err == nil {
-			location = loc
-		}
-	}
-	if location != nil {
-		lastRun = &metav1.Time{Time: lastRun.In(location)}
+	window, err := getRunWindow(cronJob)
+	if err != nil {
+		return time.Time{}, err
 	}
 
-	next, err := gronx.NextTickAfter(schedule, lastRun.Time, false)
+	schedule, _ := GetScheduleAndTimezone(cronJob)
+	next, err := nextTick(schedule, window, lastRun.In(getLocation(cronJob)))
 	if err != nil {
 		return time.Time{}, fmt.Errorf("failed to parse schedule: %w", err)
 	}
@@ -112,6 +260,36 @@ func calculateNextRunTime(cronJob v1.CronJob) (time.Time, error) {
 	return next, nil
 }
 
+// NextRunTime returns when the cron job will next start a run, or nil if it is paused.
+func NextRunTime(cronJob v1.CronJob) *time.Time {
+	if cronJob.Spec.TaskSchedule != nil && cronJob.Spec.TaskSchedule.Paused {
+		return nil
+	}
+
+	window, err := getRunWindow(cronJob)
+	if err != nil {
+		return nil
+	}
+
+	schedule, _ := GetScheduleAndTimezone(cronJob)
+	next, err := nextTick(schedule, window, time.Now().In(getLocation(cronJob)))
+	if err != nil {
+		return nil
+	}
+	next = next.Add(jitter(cronJob, next))
+	return &next
+}
+
+func getLocation(cronJob v1.CronJob) *time.Location {
+	_, timezone := GetScheduleAndTimezone(cronJob)
+	if timezone != "" {
+		if loc, err := time.LoadLocation(timezone); err == nil {
+			return loc
+		}
+	}
+	return time.Local
+}
+
 func (h *Handler) SetSuccessRunTime(req router.Request, _ router.Response) error {
 	cj := req.Object.(*v1.CronJob)
 
@@ -70,3 +70,141 @@ func TestCalculateNextRunTime(t *testing.T) {
 		require.Equal(t, expectedNextRun, nextRun)
 	})
 }
+
+func TestCatchUp(t *testing.T) {
+	newCronJob := func(policy types.CatchUpPolicy) v1.CronJob {
+		return v1.CronJob{
+			Spec: v1.CronJobSpec{
+				CronJobManifest: types.CronJobManifest{
+					TaskSchedule: &types.Schedule{
+						Interval:      "cron",
+						Expression:    "0 * * * *",
+						TimeZone:      "UTC",
+						CatchUpPolicy: policy,
+					},
+				},
+			},
+		}
+	}
+
+	next := time.Date(2025, 4, 26, 9, 0, 0, 0, time.UTC)
+
+	t.Run("nothing missed", func(t *testing.T) {
+		scheduledAt, run, err := catchUp(newCronJob(""), next, next.Add(time.Minute))
+		require.NoError(t, err)
+		require.True(t, run)
+		require.Equal(t, next, scheduledAt)
+	})
+
+	now := next.Add(30*time.Hour + time.Minute)
+	latest := time.Date(2025, 4, 27, 15, 0, 0, 0, time.UTC)
+
+	t.Run("once", func(t *testing.T) {
+		scheduledAt, run, err := catchUp(newCronJob(types.CatchUpPolicyOnce), next, now)
+		require.NoError(t, err)
+		require.True(t, run)
+		require.Equal(t, latest, scheduledAt)
+	})
+
+	t.Run("skip", func(t *testing.T) {
+		scheduledAt, run, err := catchUp(newCronJob(types.CatchUpPolicySkip), next, now)
+		require.NoError(t, err)
+		require.False(t, run)
+		require.Equal(t, latest, scheduledAt)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		scheduledAt, run, err := catchUp(newCronJob(types.CatchUpPolicyAll), next, now)
+		require.NoError(t, err)
+		require.True(t, run)
+		require.Equal(t, latest.Add(-(types.MaxCatchUpRuns-1)*time.Hour), scheduledAt)
+
+		scheduledAt, run, err = catchUp(newCronJob(types.CatchUpPolicyAll), next, next.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.True(t, run)
+		require.Equal(t, next, scheduledAt)
+	})
+}
+
+func TestJitter(t *testing.T) {
+	cronJob := v1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{UID: "uid"},
+		Spec: v1.CronJobSpec{
+			CronJobManifest: types.CronJobManifest{
+				TaskSchedule: &types.Schedule{JitterSeconds: 300},
+			},
+		},
+	}
+	scheduledAt := time.Date(2025, 4, 26, 9, 0, 0, 0, time.UTC)
+
+	delay := jitter(cronJob, scheduledAt)
+	require.GreaterOrEqual(t, delay, time.Duration(0))
+	require.LessOrEqual(t, delay, 300*time.Second)
+	require.Equal(t, delay, jitter(cronJob, scheduledAt))
+
+	cronJob.Spec.TaskSchedule.JitterSeconds = 0
+	require.Zero(t, jitter(cronJob, scheduledAt))
+}
+
+func TestRunWindow(t *testing.T) {
+	newCronJob := func(window types.RunWindow) v1.CronJob {
+		return v1.CronJob{
+			Spec: v1.CronJobSpec{
+				CronJobManifest: types.CronJobManifest{
+					TaskSchedule: &types.Schedule{
+						Interval:   "cron",
+						Expression: "0 * * * *",
+						TimeZone:   "UTC",
+						RunWindow:  &window,
+					},
+				},
+			},
+		}
+	}
+
+	// Saturday evening.
+	lastRun := time.Date(2025, 4, 26, 19, 0, 0, 0, time.UTC)
+
+	t.Run("next run is in the window", func(t *testing.T) {
+		cronJob := newCronJob(types.RunWindow{Start: "09:00", End: "17:00", Weekdays: []int{1, 2, 3, 4, 5}})
+		cronJob.Status.LastScheduledAt = &metav1.Time{Time: lastRun}
+
+		next, err := calculateNextRunTime(cronJob)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2025, 4, 28, 9, 0, 0, 0, time.UTC), next)
+	})
+
+	t.Run("window spanning midnight", func(t *testing.T) {
+		cronJob := newCronJob(types.RunWindow{Start: "22:00", End: "02:00", Weekdays: []int{6}})
+		cronJob.Status.LastScheduledAt = &metav1.Time{Time: time.Date(2025, 4, 27, 1, 0, 0, 0, time.UTC)}
+
+		next, err := calculateNextRunTime(cronJob)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2025, 5, 3, 22, 0, 0, 0, time.UTC), next)
+	})
+
+	t.Run("catch up only counts times in the window", func(t *testing.T) {
+		cronJob := newCronJob(types.RunWindow{Start: "09:00", End: "17:00"})
+		next := time.Date(2025, 4, 26, 9, 0, 0, 0, time.UTC)
+
+		scheduledAt, run, err := catchUp(cronJob, next, time.Date(2025, 4, 27, 12, 30, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.True(t, run)
+		require.Equal(t, time.Date(2025, 4, 27, 12, 0, 0, 0, time.UTC), scheduledAt)
+
+		// After the window closed, the missed times are handled without starting a run.
+		scheduledAt, run, err = catchUp(cronJob, next, time.Date(2025, 4, 27, 20, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		require.False(t, run)
+		require.Equal(t, time.Date(2025, 4, 27, 16, 0, 0, 0, time.UTC), scheduledAt)
+	})
+
+	t.Run("schedule without times in the window", func(t *testing.T) {
+		cronJob := newCronJob(types.RunWindow{Start: "09:00", End: "17:00"})
+		cronJob.Spec.TaskSchedule.Expression = "0 3 * * *"
+		cronJob.Status.LastScheduledAt = &metav1.Time{Time: lastRun}
+
+		_, err := calculateNextRunTime(cronJob)
+		require.ErrorIs(t, err, errNoTimeInRunWindow)
+	})
+}
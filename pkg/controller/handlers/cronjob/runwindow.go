@@ -0,0 +1,123 @@
+package cronjob
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/adhocore/gronx"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// maxRunWindowSearch is how many times the search for a scheduled time in the run window jumps to another opening of
+// the window. The window opens at least once a week, so this covers more than a year of a schedule.
+const maxRunWindowSearch = 1000
+
+var errNoTimeInRunWindow = errors.New("the schedule has no times in the run window")
+
+// runWindow is the run window of a schedule, with times of day in minutes since midnight.
+type runWindow struct {
+	start, end int
+	weekdays   []int
+}
+
+func getRunWindow(cronJob v1.CronJob) (*runWindow, error) {
+	if cronJob.Spec.TaskSchedule == nil || cronJob.Spec.TaskSchedule.RunWindow == nil {
+		return nil, nil
+	}
+
+	window := cronJob.Spec.TaskSchedule.RunWindow
+	start, err := time.Parse("15:04", window.Start)
+	if err != nil {
+		return nil, fmt.Errorf("invalid run window start %q: %w", window.Start, err)
+	}
+	end, err := time.Parse("15:04", window.End)
+	if err != nil {
+		return nil, fmt.Errorf("invalid run window end %q: %w", window.End, err)
+	}
+
+	return &runWindow{
+		start:    start.Hour()*60 + start.Minute(),
+		end:      end.Hour()*60 + end.Minute(),
+		weekdays: window.Weekdays,
+	}, nil
+}
+
+// contains returns whether the window is open at the time. A window that spans midnight belongs to the day it opens
+// on. A nil window is always open.
+func (w *runWindow) contains(t time.Time) bool {
+	if w == nil {
+		return true
+	}
+
+	minute, day := t.Hour()*60+t.Minute(), t
+	switch {
+	case w.start < w.end:
+		if minute < w.start || minute >= w.end {
+			return false
+		}
+	case minute >= w.start:
+	case minute < w.end:
+		day = t.AddDate(0, 0, -1)
+	default:
+		return false
+	}
+
+	return w.opensOn(day)
+}
+
+func (w *runWindow) opensOn(day time.Time) bool {
+	return len(w.weekdays) == 0 || slices.Contains(w.weekdays, int(day.Weekday()))
+}
+
+// nextOpening returns the first time after t that the window opens.
+func (w *runWindow) nextOpening(t time.Time) time.Time {
+	for i := range 8 {
+		day := t.AddDate(0, 0, i)
+		opening := time.Date(day.Year(), day.Month(), day.Day(), w.start/60, w.start%60, 0, 0, t.Location())
+		if opening.After(t) && w.opensOn(opening) {
+			return opening
+		}
+	}
+	return t.AddDate(0, 0, 7)
+}
+
+// lastOpenMinute returns the last minute before t that the window was open.
+func (w *runWindow) lastOpenMinute(t time.Time) time.Time {
+	for i := range 9 {
+		day := t.AddDate(0, 0, -i)
+		closing := time.Date(day.Year(), day.Month(), day.Day(), w.end/60, w.end%60, 0, 0, t.Location())
+		if w.end < w.start {
+			closing = closing.AddDate(0, 0, 1)
+		}
+		if last := closing.Add(-time.Minute); !last.After(t) && w.contains(last) {
+			return last
+		}
+	}
+	return t.AddDate(0, 0, -7)
+}
+
+// nextTick returns the first scheduled time after t that is in the run window.
+func nextTick(schedule string, window *runWindow, t time.Time) (time.Time, error) {
+	next, err := gronx.NextTickAfter(schedule, t, false)
+	for i := 0; err == nil && !window.contains(next); i++ {
+		if i == maxRunWindowSearch {
+			return time.Time{}, errNoTimeInRunWindow
+		}
+		next, err = gronx.NextTickAfter(schedule, window.nextOpening(next), true)
+	}
+	return next, err
+}
+
+// prevTick returns the last scheduled time before t, or at t if inclusive, that is in the run window.
+func prevTick(schedule string, window *runWindow, t time.Time, inclusive bool) (time.Time, error) {
+	prev, err := gronx.PrevTickBefore(schedule, t, inclusive)
+	for i := 0; err == nil && !window.contains(prev); i++ {
+		if i == maxRunWindowSearch {
+			return time.Time{}, errNoTimeInRunWindow
+		}
+		prev, err = gronx.PrevTickBefore(schedule, window.lastOpenMinute(prev), true)
+	}
+	return prev, err
+}
@@ -0,0 +1,356 @@
+package evalrun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	gclient "github.com/obot-platform/obot/pkg/gateway/client"
+	"github.com/obot-platform/obot/pkg/invoke"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const pollInterval = 5 * time.Second
+
+type Handler struct {
+	invoker           *invoke.Invoker
+	gptClient         *gptscript.GPTScript
+	mcpSessionManager *mcp.SessionManager
+	gatewayClient     *gclient.Client
+}
+
+func New(invoker *invoke.Invoker, gptClient *gptscript.GPTScript, mcpSessionManager *mcp.SessionManager, gatewayClient *gclient.Client) *Handler {
+	return &Handler{
+		invoker:           invoker,
+		gptClient:         gptClient,
+		mcpSessionManager: mcpSessionManager,
+		gatewayClient:     gatewayClient,
+	}
+}
+
+// Run invokes the cases of the eval run in ephemeral threads of the project, at most Workers at a time, and grades
+// each case when its run is done.
+func (h *Handler) Run(req router.Request, resp router.Response) error {
+	evalRun := req.Object.(*v1.EvalRun)
+	if evalRun.Status.State == types.EvalRunStateComplete || evalRun.Status.State == types.EvalRunStateError {
+		return nil
+	}
+
+	var project v1.Thread
+	if err := req.Get(&project, evalRun.Namespace, evalRun.Spec.ThreadName); apierrors.IsNotFound(err) {
+		evalRun.Status.State = types.EvalRunStateError
+		evalRun.Status.Error = fmt.Sprintf("project %s not found", evalRun.Spec.ThreadName)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if evalRun.Status.State == "" || evalRun.Status.State == types.EvalRunStatePending {
+		evalRun.Status.State = types.EvalRunStateRunning
+		evalRun.Status.StartTime = &metav1.Time{Time: time.Now()}
+		evalRun.Status.Results = make([]v1.EvalCaseStatus, 0, len(evalRun.Spec.Cases))
+		for _, c := range evalRun.Spec.Cases {
+			evalRun.Status.Results = append(evalRun.Status.Results, v1.EvalCaseStatus{
+				EvalCaseResult: types.EvalCaseResult{
+					CaseID: c.ID,
+					State:  types.EvalCaseStatePending,
+				},
+			})
+		}
+	}
+
+	workers := evalRun.Spec.Manifest.Workers
+	if workers <= 0 {
+		workers = types.DefaultEvalWorkers
+	}
+
+	var inFlight, remaining int
+	for _, result := range evalRun.Status.Results {
+		if !result.State.IsTerminal() && result.ThreadName != "" {
+			inFlight++
+		}
+	}
+
+	for i := range evalRun.Status.Results {
+		result := &evalRun.Status.Results[i]
+		if result.State.IsTerminal() {
+			continue
+		}
+		if i >= len(evalRun.Spec.Cases) {
+			result.State = types.EvalCaseStateError
+			result.Reason = "case not found"
+			continue
+		}
+		if result.ThreadName == "" {
+			if inFlight >= workers {
+				remaining++
+				continue
+			}
+			inFlight++
+		}
+
+		if err := h.step(req.Ctx, req.Client, evalRun, &project, evalRun.Spec.Cases[i], result); err != nil {
+			return err
+		}
+		if result.State.IsTerminal() {
+			inFlight--
+		} else {
+			remaining++
+		}
+	}
+
+	evalRun.Status.Summary = summarize(evalRun.Status.Results)
+	if remaining > 0 {
+		resp.RetryAfter(pollInterval)
+		return nil
+	}
+
+	evalRun.Status.State = types.EvalRunStateComplete
+	evalRun.Status.EndTime = &metav1.Time{Time: time.Now()}
+	return nil
+}
+
+// step moves a case to its next state: creating its thread, invoking the thread once it is ready, and grading the
+// output once the run is done.
+func (h *Handler) step(ctx context.Context, c kclient.WithWatch, evalRun *v1.EvalRun, project *v1.Thread, evalCase types.EvalCase, result *v1.EvalCaseStatus) error {
+	switch {
+	case result.ThreadName == "":
+		thread := newCaseThread(evalRun, project)
+		if err := c.Create(ctx, &thread); err != nil {
+			return err
+		}
+		result.ThreadName = thread.Name
+		return nil
+	case result.RunID == "":
+		var thread v1.Thread
+		if err := c.Get(ctx, router.Key(evalRun.Namespace, result.ThreadName), &thread); apierrors.IsNotFound(err) {
+			result.State = types.EvalCaseStateError
+			result.Reason = "thread was deleted"
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if !thread.Status.Created {
+			return nil
+		}
+
+		resp, err := h.invoker.Thread(ctx, h.mcpSessionManager, h.gptClient, c, &thread, evalCase.Input, invoke.Options{
+			GenerateName:    system.RunPrefix,
+			UserUID:         evalRun.Spec.UserID,
+			IgnoreMCPErrors: true,
+		})
+		if err != nil {
+			result.State = types.EvalCaseStateError
+			result.Reason = err.Error()
+			return nil
+		}
+		resp.Close()
+
+		result.RunID = resp.Run.Name
+		result.State = types.EvalCaseStateRunning
+		return nil
+	}
+
+	if result.JudgeThreadName != "" {
+		return h.judge(ctx, c, evalRun, evalCase, result)
+	}
+
+	var run v1.Run
+	if err := c.Get(ctx, router.Key(evalRun.Namespace, result.RunID), &run); apierrors.IsNotFound(err) {
+		result.State = types.EvalCaseStateError
+		result.Reason = "run was deleted"
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	switch run.Status.State {
+	case v1.Continue, v1.Finished:
+	case v1.Error:
+		result.State = types.EvalCaseStateError
+		result.Reason = run.Status.Error
+		return nil
+	case v1.Waiting:
+		result.State = types.EvalCaseStateError
+		result.Reason = "run is waiting for a tool call to be confirmed"
+		return nil
+	default:
+		return nil
+	}
+
+	result.Output = run.Status.Output
+	end := run.Status.EndTime.Time
+	if end.IsZero() {
+		end = time.Now()
+	}
+	result.LatencyMillis = end.Sub(run.CreationTimestamp.Time).Milliseconds()
+
+	usage, err := h.gatewayClient.TokenUsageForRun(ctx, run.Name)
+	if err != nil {
+		return err
+	}
+	result.PromptTokens = usage.PromptTokens
+	result.CompletionTokens = usage.CompletionTokens
+	result.TotalTokens = usage.TotalTokens
+
+	if evalCase.Grader.Type == types.EvalGraderTypeLLMJudge {
+		// The judge runs in its own thread, so that it uses the default model of the project instead of the model
+		// being evaluated.
+		thread := newJudgeThread(evalRun, project)
+		if err := c.Create(ctx, &thread); err != nil {
+			return err
+		}
+		result.JudgeThreadName = thread.Name
+		return nil
+	}
+
+	passed, reason, err := grade(evalCase.Grader, result.Output)
+	setGrade(result, passed, reason, err)
+	return nil
+}
+
+// judge asks the default model of the project whether the output passes the rubric of the case. The judgement is a
+// run of the judge thread, which is checked on later passes until it is done.
+func (h *Handler) judge(ctx context.Context, c kclient.WithWatch, evalRun *v1.EvalRun, evalCase types.EvalCase, result *v1.EvalCaseStatus) error {
+	if result.JudgeRunName == "" {
+		var thread v1.Thread
+		if err := c.Get(ctx, router.Key(evalRun.Namespace, result.JudgeThreadName), &thread); apierrors.IsNotFound(err) {
+			result.State = types.EvalCaseStateError
+			result.Reason = "judge thread was deleted"
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if !thread.Status.Created {
+			return nil
+		}
+
+		temperature := float32(0)
+		run, err := h.invoker.ThreadTask(ctx, c, &thread, gptscript.ToolDef{
+			Instructions: judgeInstructions,
+			Temperature:  &temperature,
+		}, judgeInput(evalCase, result.Output))
+		if err != nil {
+			result.State = types.EvalCaseStateError
+			result.Reason = fmt.Sprintf("failed to run judge: %v", err)
+			return nil
+		}
+		result.JudgeRunName = run.Name
+		return nil
+	}
+
+	var run v1.Run
+	if err := c.Get(ctx, router.Key(evalRun.Namespace, result.JudgeRunName), &run); apierrors.IsNotFound(err) {
+		result.State = types.EvalCaseStateError
+		result.Reason = "judge run was deleted"
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	switch run.Status.State {
+	case v1.Continue, v1.Finished:
+		passed, reason, err := parseJudgement(run.Status.Output)
+		setGrade(result, passed, reason, err)
+	case v1.Error:
+		result.State = types.EvalCaseStateError
+		result.Reason = "failed to run judge: " + run.Status.Error
+	}
+	return nil
+}
+
+// setGrade records the result of a grader on the case.
+func setGrade(result *v1.EvalCaseStatus, passed bool, reason string, err error) {
+	result.Reason = reason
+	switch {
+	case err != nil:
+		result.State = types.EvalCaseStateError
+		result.Reason = err.Error()
+	case passed:
+		result.State = types.EvalCaseStatePassed
+	default:
+		result.State = types.EvalCaseStateFailed
+	}
+}
+
+func newJudgeThread(evalRun *v1.EvalRun, project *v1.Thread) v1.Thread {
+	return v1.Thread{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.ThreadPrefix,
+			Namespace:    evalRun.Namespace,
+			Finalizers:   []string{v1.ThreadFinalizer},
+		},
+		Spec: v1.ThreadSpec{
+			AgentName:        project.Spec.AgentName,
+			ParentThreadName: project.Name,
+			UserID:           evalRun.Spec.UserID,
+			Ephemeral:        true,
+		},
+	}
+}
+
+func newCaseThread(evalRun *v1.EvalRun, project *v1.Thread) v1.Thread {
+	config := evalRun.Spec.Manifest.Configuration
+	thread := v1.Thread{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.ThreadPrefix,
+			Namespace:    evalRun.Namespace,
+			Finalizers:   []string{v1.ThreadFinalizer},
+		},
+		Spec: v1.ThreadSpec{
+			Manifest: types.ThreadManifest{
+				ModelProvider: config.ModelProvider,
+				Model:         config.Model,
+				Temperature:   config.Temperature,
+				Prompt:        config.Prompt,
+				Tools:         config.Tools,
+			},
+			AgentName:        project.Spec.AgentName,
+			ParentThreadName: project.Name,
+			UserID:           evalRun.Spec.UserID,
+			Ephemeral:        true,
+		},
+	}
+	return thread
+}
+
+func summarize(results []v1.EvalCaseStatus) types.EvalSummary {
+	var (
+		summary   types.EvalSummary
+		latency   int64
+		completed int64
+	)
+	summary.Total = len(results)
+	for _, result := range results {
+		switch result.State {
+		case types.EvalCaseStatePassed:
+			summary.Passed++
+		case types.EvalCaseStateFailed:
+			summary.Failed++
+		case types.EvalCaseStateError:
+			summary.Errors++
+		}
+		if result.LatencyMillis > 0 {
+			latency += result.LatencyMillis
+			completed++
+		}
+		summary.PromptTokens += result.PromptTokens
+		summary.CompletionTokens += result.CompletionTokens
+		summary.TotalTokens += result.TotalTokens
+	}
+	if summary.Total > 0 {
+		summary.PassRate = float64(summary.Passed) / float64(summary.Total)
+	}
+	if completed > 0 {
+		summary.AverageLatencyMillis = latency / completed
+	}
+	return summary
+}
@@ -0,0 +1,157 @@
+package evalrun
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type testResponse struct {
+	retryAfter time.Duration
+}
+
+func (r *testResponse) Attributes() map[string]any {
+	return map[string]any{}
+}
+
+func (r *testResponse) RetryAfter(delay time.Duration) {
+	r.retryAfter = delay
+}
+
+func runEval(t *testing.T, c kclient.WithWatch, evalRun *v1.EvalRun) *testResponse {
+	t.Helper()
+
+	resp := &testResponse{}
+	if err := (&Handler{}).Run(router.Request{
+		Client:    c,
+		Object:    evalRun,
+		Ctx:       t.Context(),
+		Namespace: evalRun.Namespace,
+		Name:      evalRun.Name,
+	}, resp); err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func testProject() *v1.Thread {
+	return &v1.Thread{
+		ObjectMeta: metav1.ObjectMeta{Name: "t1project", Namespace: system.DefaultNamespace},
+		Spec:       v1.ThreadSpec{AgentName: "a1", Project: true},
+	}
+}
+
+func TestRunLimitsWorkers(t *testing.T) {
+	evalRun := &v1.EvalRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "er1", Namespace: system.DefaultNamespace},
+		Spec: v1.EvalRunSpec{
+			ThreadName: "t1project",
+			UserID:     "1",
+			Manifest:   types.EvalRunManifest{Workers: 2},
+			Cases: []types.EvalCase{
+				{ID: "1", Input: "one"},
+				{ID: "2", Input: "two"},
+				{ID: "3", Input: "three"},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(testProject()).Build()
+
+	resp := runEval(t, c, evalRun)
+	if evalRun.Status.State != types.EvalRunStateRunning || resp.retryAfter == 0 {
+		t.Fatalf("expected the run to be running and checked again, got %s and %v", evalRun.Status.State, resp.retryAfter)
+	}
+	if len(evalRun.Status.Results) != 3 {
+		t.Fatalf("expected a result per case, got %d", len(evalRun.Status.Results))
+	}
+	for i, result := range evalRun.Status.Results {
+		if started := result.ThreadName != ""; started != (i < 2) {
+			t.Errorf("expected only the first 2 cases to be started, case %s has thread %q", result.CaseID, result.ThreadName)
+		}
+	}
+
+	var threads v1.ThreadList
+	if err := c.List(t.Context(), &threads, kclient.InNamespace(system.DefaultNamespace)); err != nil {
+		t.Fatal(err)
+	}
+	var caseThreads int
+	for _, thread := range threads.Items {
+		if thread.Spec.ParentThreadName == "t1project" {
+			caseThreads++
+			if !thread.Spec.Ephemeral || thread.Spec.UserID != "1" {
+				t.Errorf("expected an ephemeral thread of the user, got %+v", thread.Spec)
+			}
+		}
+	}
+	if caseThreads != 2 {
+		t.Errorf("expected 2 case threads, got %d", caseThreads)
+	}
+}
+
+func TestRunGradesJudgement(t *testing.T) {
+	evalRun := &v1.EvalRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "er1", Namespace: system.DefaultNamespace},
+		Spec: v1.EvalRunSpec{
+			ThreadName: "t1project",
+			Cases: []types.EvalCase{{
+				ID:     "1",
+				Input:  "greet me",
+				Grader: types.EvalGrader{Type: types.EvalGraderTypeLLMJudge, Rubric: "is a greeting"},
+			}},
+		},
+		Status: v1.EvalRunStatus{
+			State: types.EvalRunStateRunning,
+			Results: []v1.EvalCaseStatus{{
+				EvalCaseResult: types.EvalCaseResult{
+					CaseID: "1",
+					State:  types.EvalCaseStateRunning,
+					RunID:  "r1case",
+					Output: "hello",
+				},
+				ThreadName:      "t1case",
+				JudgeThreadName: "t1judge",
+				JudgeRunName:    "r1judge",
+			}},
+		},
+	}
+	judgeRun := &v1.Run{
+		ObjectMeta: metav1.ObjectMeta{Name: "r1judge", Namespace: system.DefaultNamespace},
+		Status: v1.RunStatus{
+			State:  v1.Finished,
+			Output: "```json\n{\"pass\": true, \"reason\": \"it greets\"}\n```",
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(testProject(), judgeRun).Build()
+
+	resp := runEval(t, c, evalRun)
+	result := evalRun.Status.Results[0]
+	if result.State != types.EvalCaseStatePassed || result.Reason != "it greets" {
+		t.Errorf("expected the case to pass with the judge's reason, got %s: %s", result.State, result.Reason)
+	}
+	if evalRun.Status.State != types.EvalRunStateComplete || resp.retryAfter != 0 {
+		t.Errorf("expected the run to be complete, got %s", evalRun.Status.State)
+	}
+	if evalRun.Status.Summary.Passed != 1 || evalRun.Status.Summary.PassRate != 1 {
+		t.Errorf("expected a summary with 1 passed case, got %+v", evalRun.Status.Summary)
+	}
+
+	judgeRun.Status.State = v1.Error
+	judgeRun.Status.Error = "model unavailable"
+	evalRun.Status.State = types.EvalRunStateRunning
+	evalRun.Status.Results[0].State = types.EvalCaseStateRunning
+	if err := c.Update(t.Context(), judgeRun); err != nil {
+		t.Fatal(err)
+	}
+	runEval(t, c, evalRun)
+	if result := evalRun.Status.Results[0]; result.State != types.EvalCaseStateError || result.Reason != "failed to run judge: model unavailable" {
+		t.Errorf("expected a failed judge run to error the case, got %s: %s", result.State, result.Reason)
+	}
+}
@@ -0,0 +1,134 @@
+package evalrun
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/structuredoutput"
+)
+
+const judgeInstructions = `You are grading the response of an AI assistant. You will be given the grading rubric, the input that was sent to the assistant, and the response of the assistant.
+
+Decide whether the response passes the rubric. Respond with only a JSON object of the form {"pass": true, "reason": "..."} where "reason" briefly explains the decision. Do not include any other text or code fences.`
+
+// grade grades the output of a case with the exact, regex or jsonSchema grader.
+func grade(grader types.EvalGrader, output string) (bool, string, error) {
+	switch grader.Type {
+	case types.EvalGraderTypeExact:
+		expected, actual := strings.TrimSpace(grader.Expected), strings.TrimSpace(output)
+		if actual == expected || grader.IgnoreCase && strings.EqualFold(actual, expected) {
+			return true, "", nil
+		}
+		return false, "output does not match the expected output", nil
+	case types.EvalGraderTypeRegex:
+		re, err := compilePattern(grader)
+		if err != nil {
+			return false, "", err
+		}
+		if re.MatchString(output) {
+			return true, "", nil
+		}
+		return false, fmt.Sprintf("output does not match %q", grader.Pattern), nil
+	case types.EvalGraderTypeJSONSchema:
+		schema, err := resolveSchema(grader.Schema)
+		if err != nil {
+			return false, "", err
+		}
+		if _, err := structuredoutput.Parse(schema, output); err != nil {
+			return false, err.Error(), nil
+		}
+		return true, "", nil
+	}
+	return false, "", fmt.Errorf("unknown grader type %q", grader.Type)
+}
+
+// validateGrader checks that the grader has everything it needs to grade.
+func validateGrader(grader types.EvalGrader) error {
+	switch grader.Type {
+	case types.EvalGraderTypeExact:
+		return nil
+	case types.EvalGraderTypeRegex:
+		_, err := compilePattern(grader)
+		return err
+	case types.EvalGraderTypeJSONSchema:
+		_, err := resolveSchema(grader.Schema)
+		return err
+	case types.EvalGraderTypeLLMJudge:
+		if strings.TrimSpace(grader.Rubric) == "" {
+			return fmt.Errorf("rubric is required for the %s grader", grader.Type)
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown grader type %q", grader.Type)
+}
+
+// ValidateDataset checks the cases of a dataset and fills in missing case IDs.
+func ValidateDataset(manifest *types.EvalDatasetManifest) error {
+	if len(manifest.Cases) == 0 {
+		return fmt.Errorf("at least one case is required")
+	}
+	if len(manifest.Cases) > types.MaxEvalCases {
+		return fmt.Errorf("a dataset can have at most %d cases", types.MaxEvalCases)
+	}
+
+	ids := make(map[string]struct{}, len(manifest.Cases))
+	for i := range manifest.Cases {
+		c := &manifest.Cases[i]
+		if c.ID == "" {
+			c.ID = fmt.Sprint(i + 1)
+		}
+		if _, ok := ids[c.ID]; ok {
+			return fmt.Errorf("duplicate case id %q", c.ID)
+		}
+		ids[c.ID] = struct{}{}
+		if strings.TrimSpace(c.Input) == "" {
+			return fmt.Errorf("case %s: input is required", c.ID)
+		}
+		if err := validateGrader(c.Grader); err != nil {
+			return fmt.Errorf("case %s: %w", c.ID, err)
+		}
+	}
+	return nil
+}
+
+func compilePattern(grader types.EvalGrader) (*regexp.Regexp, error) {
+	pattern := grader.Pattern
+	if pattern == "" {
+		return nil, fmt.Errorf("pattern is required for the %s grader", grader.Type)
+	}
+	if grader.IgnoreCase {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+	return re, nil
+}
+
+func resolveSchema(schema json.RawMessage) (*jsonschema.Resolved, error) {
+	if len(schema) == 0 {
+		return nil, fmt.Errorf("schema is required for the %s grader", types.EvalGraderTypeJSONSchema)
+	}
+	return structuredoutput.ResolveSchema(schema)
+}
+
+func judgeInput(c types.EvalCase, output string) string {
+	return fmt.Sprintf("Rubric:\n%s\n\nInput:\n%s\n\nResponse:\n%s", c.Grader.Rubric, c.Input, output)
+}
+
+// parseJudgement parses the response of the judge model.
+func parseJudgement(response string) (bool, string, error) {
+	var judgement struct {
+		Pass   bool   `json:"pass"`
+		Reason string `json:"reason"`
+	}
+	if err := json.Unmarshal([]byte(structuredoutput.TrimCodeFence(response)), &judgement); err != nil {
+		return false, "", fmt.Errorf("invalid judge response %q: %w", response, err)
+	}
+	return judgement.Pass, judgement.Reason, nil
+}
@@ -0,0 +1,112 @@
+package evalrun
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrade(t *testing.T) {
+	schema := json.RawMessage(`{"type":"object","properties":{"count":{"type":"integer"}},"required":["count"]}`)
+
+	tests := []struct {
+		name    string
+		grader  types.EvalGrader
+		output  string
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:   "exact",
+			grader: types.EvalGrader{Type: types.EvalGraderTypeExact, Expected: "Paris"},
+			output: " Paris\n",
+			want:   true,
+		},
+		{
+			name:   "exact case mismatch",
+			grader: types.EvalGrader{Type: types.EvalGraderTypeExact, Expected: "Paris"},
+			output: "paris",
+		},
+		{
+			name:   "exact ignore case",
+			grader: types.EvalGrader{Type: types.EvalGraderTypeExact, Expected: "Paris", IgnoreCase: true},
+			output: "paris",
+			want:   true,
+		},
+		{
+			name:   "regex",
+			grader: types.EvalGrader{Type: types.EvalGraderTypeRegex, Pattern: `\b42\b`},
+			output: "The answer is 42.",
+			want:   true,
+		},
+		{
+			name:   "regex no match",
+			grader: types.EvalGrader{Type: types.EvalGraderTypeRegex, Pattern: `^\d+$`},
+			output: "forty-two",
+		},
+		{
+			name:    "regex invalid",
+			grader:  types.EvalGrader{Type: types.EvalGraderTypeRegex, Pattern: `(`},
+			output:  "anything",
+			wantErr: true,
+		},
+		{
+			name:   "json schema in code fence",
+			grader: types.EvalGrader{Type: types.EvalGraderTypeJSONSchema, Schema: schema},
+			output: "```json\n{\"count\": 3}\n```",
+			want:   true,
+		},
+		{
+			name:   "json schema invalid value",
+			grader: types.EvalGrader{Type: types.EvalGraderTypeJSONSchema, Schema: schema},
+			output: `{"count": "three"}`,
+		},
+		{
+			name:   "json schema not json",
+			grader: types.EvalGrader{Type: types.EvalGraderTypeJSONSchema, Schema: schema},
+			output: "three",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := grade(tt.grader, tt.output)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseJudgement(t *testing.T) {
+	pass, reason, err := parseJudgement("```json\n{\"pass\": true, \"reason\": \"cites the source\"}\n```")
+	require.NoError(t, err)
+	require.True(t, pass)
+	require.Equal(t, "cites the source", reason)
+
+	_, _, err = parseJudgement("yes")
+	require.Error(t, err)
+}
+
+func TestValidateDataset(t *testing.T) {
+	manifest := types.EvalDatasetManifest{
+		Cases: []types.EvalCase{
+			{Input: "a", Grader: types.EvalGrader{Type: types.EvalGraderTypeExact}},
+			{ID: "custom", Input: "b", Grader: types.EvalGrader{Type: types.EvalGraderTypeLLMJudge, Rubric: "is polite"}},
+		},
+	}
+	require.NoError(t, ValidateDataset(&manifest))
+	require.Equal(t, "1", manifest.Cases[0].ID)
+	require.Equal(t, "custom", manifest.Cases[1].ID)
+
+	manifest.Cases[1].ID = "1"
+	require.ErrorContains(t, ValidateDataset(&manifest), "duplicate")
+
+	manifest.Cases[1] = types.EvalCase{Input: "b", Grader: types.EvalGrader{Type: types.EvalGraderTypeLLMJudge}}
+	require.ErrorContains(t, ValidateDataset(&manifest), "rubric")
+}
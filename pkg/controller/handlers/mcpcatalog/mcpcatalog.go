@@ -90,7 +90,7 @@ func (h *Handler) Sync(req router.Request, resp router.Response) error {
 	mcpCatalog.Status.SyncErrors = make(map[string]string)
 
 	for _, sourceURL := range mcpCatalog.Spec.SourceURLs {
-		objs, err := h.readMCPCatalog(mcpCatalog.Name, sourceURL)
+		objs, err := ReadMCPCatalog(mcpCatalog.Name, sourceURL)
 		if err != nil {
 			log.Errorf("failed to read catalog %s: %v", sourceURL, err)
 			mcpCatalog.Status.SyncErrors[sourceURL] = err.Error()
@@ -134,7 +134,23 @@ func (h *Handler) Sync(req router.Request, resp router.Response) error {
 	return app.Apply(req.Ctx, mcpCatalog, toAdd...)
 }
 
-func (h *Handler) readMCPCatalog(catalogName, sourceURL string) ([]client.Object, error) {
+// EntryValidationError is returned by ReadMCPCatalog for each entry in a source that fails validation.
+type EntryValidationError struct {
+	EntryName string
+	Err       error
+}
+
+func (e *EntryValidationError) Error() string {
+	return fmt.Sprintf("failed to validate catalog entry %s: %v", e.EntryName, e.Err)
+}
+
+func (e *EntryValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ReadMCPCatalog reads the entries from a catalog source and converts them to MCPServerCatalogEntry objects.
+// Entries that fail validation are skipped and reported as EntryValidationErrors joined in the returned error.
+func ReadMCPCatalog(catalogName, sourceURL string) ([]client.Object, error) {
 	var entries []types.MCPServerCatalogEntryManifest
 
 	if strings.HasPrefix(sourceURL, "http://") || strings.HasPrefix(sourceURL, "https://") {
@@ -244,7 +260,7 @@ func (h *Handler) readMCPCatalog(catalogName, sourceURL string) ([]client.Object
 		}
 
 		if err := validation.ValidateCatalogEntryManifest(entry); err != nil {
-			errs = append(errs, fmt.Errorf("failed to validate catalog entry %s: %w", entry.Name, err))
+			errs = append(errs, &EntryValidationError{EntryName: entry.Name, Err: err})
 			continue
 		}
 		catalogEntry.Spec.Manifest = entry
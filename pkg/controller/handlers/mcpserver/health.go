@@ -0,0 +1,52 @@
+package mcpserver
+
+import (
+	"fmt"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/mcphealth"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// CheckHealth periodically probes multi-user MCP servers in the background and records their health.
+func (h *Handler) CheckHealth(req router.Request, resp router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+
+	if !h.healthChecker.Enabled() ||
+		server.Spec.MCPCatalogID == "" && server.Spec.PowerUserWorkspaceID == "" ||
+		server.Spec.Template || server.Spec.NeedsURL ||
+		// Composite servers are checked through their components.
+		server.Spec.Manifest.Runtime == types.RuntimeComposite {
+		return nil
+	}
+
+	if until := h.healthChecker.NextProbe(server.Status.Health); until > 0 {
+		resp.RetryAfter(until)
+		return nil
+	}
+
+	serverConfig, ok, err := h.toolCheckServerConfig(req.Ctx, *server)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		resp.RetryAfter(h.healthChecker.Interval())
+		return nil
+	}
+
+	retryAfter, err := h.healthChecker.Check(mcphealth.Server{
+		ID:   server.Name,
+		Name: server.Spec.Manifest.Name,
+		Kind: "mcpserver",
+	}, serverConfig, server.Status.Health, func(health *types.MCPServerHealth) error {
+		server.Status.Health = health
+		return req.Client.Status().Update(req.Ctx, server)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update health: %w", err)
+	}
+
+	resp.RetryAfter(retryAfter)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/gptscript-ai/gptscript/pkg/hash"
@@ -12,6 +13,7 @@ import (
 	"github.com/obot-platform/nah/pkg/untriggered"
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/mcphealth"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"github.com/obot-platform/obot/pkg/utils"
@@ -24,16 +26,22 @@ import (
 )
 
 type Handler struct {
-	gptClient         *gptscript.GPTScript
-	mcpSessionManager *mcp.SessionManager
-	baseURL           string
+	gptClient           *gptscript.GPTScript
+	mcpSessionManager   *mcp.SessionManager
+	baseURL             string
+	toolCheckInterval   time.Duration
+	autoDisableNewTools bool
+	healthChecker       *mcphealth.Checker
 }
 
-func New(gptClient *gptscript.GPTScript, mcpSessionManager *mcp.SessionManager, baseURL string) *Handler {
+func New(gptClient *gptscript.GPTScript, mcpSessionManager *mcp.SessionManager, baseURL string, toolCheckInterval time.Duration, autoDisableNewTools bool, healthChecker *mcphealth.Checker) *Handler {
 	return &Handler{
-		gptClient:         gptClient,
-		mcpSessionManager: mcpSessionManager,
-		baseURL:           baseURL,
+		gptClient:           gptClient,
+		mcpSessionManager:   mcpSessionManager,
+		baseURL:             baseURL,
+		toolCheckInterval:   toolCheckInterval,
+		autoDisableNewTools: autoDisableNewTools,
+		healthChecker:       healthChecker,
 	}
 }
 
@@ -51,7 +59,7 @@ func (h *Handler) DetectDrift(req router.Request, _ router.Response) error {
 		return err
 	}
 
-	drifted, err := configurationHasDrifted(server.Spec.Manifest, entry.Spec.Manifest)
+	drifted, err := ConfigurationHasDrifted(server.Spec.Manifest, entry.Spec.Manifest)
 	if err != nil {
 		return err
 	}
@@ -95,7 +103,8 @@ func (h *Handler) DetectK8sSettingsDrift(req router.Request, _ router.Response)
 	return nil
 }
 
-func configurationHasDrifted(serverManifest types.MCPServerManifest, entryManifest types.MCPServerCatalogEntryManifest) (bool, error) {
+// ConfigurationHasDrifted returns true if the server manifest no longer matches the catalog entry manifest it was created from.
+func ConfigurationHasDrifted(serverManifest types.MCPServerManifest, entryManifest types.MCPServerCatalogEntryManifest) (bool, error) {
 	// Check if runtime types differ
 	if serverManifest.Runtime != entryManifest.Runtime {
 		return true, nil
@@ -213,6 +222,10 @@ func compositeConfigHasDrifted(serverConfig *types.CompositeRuntimeConfig, entry
 		return true, nil
 	}
 
+	if serverConfig.ToolConflictPolicy != entryConfig.ToolConflictPolicy {
+		return true, nil
+	}
+
 	entryComponents := make(map[string]types.CatalogComponentServer, len(entryConfig.ComponentServers))
 	for _, entryComponent := range entryConfig.ComponentServers {
 		if id := entryComponent.ComponentID(); id != "" {
@@ -232,7 +245,7 @@ func compositeConfigHasDrifted(serverConfig *types.CompositeRuntimeConfig, entry
 		}
 
 		// Compare manifests
-		drifted, err := configurationHasDrifted(serverComponent.Manifest, entryComponent.Manifest)
+		drifted, err := ConfigurationHasDrifted(serverComponent.Manifest, entryComponent.Manifest)
 		if err != nil || drifted {
 			return drifted, err
 		}
@@ -431,7 +431,7 @@ func TestConfigurationHasDrifted(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			drifted, err := configurationHasDrifted(tt.serverManifest, tt.entryManifest)
+			drifted, err := ConfigurationHasDrifted(tt.serverManifest, tt.entryManifest)
 
 			if tt.expectedError {
 				if err == nil {
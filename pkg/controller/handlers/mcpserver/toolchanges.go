@@ -0,0 +1,408 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/gptscript-ai/gptscript/pkg/hash"
+	nmcp "github.com/nanobot-ai/nanobot/pkg/mcp"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+const (
+	// maxToolChangelogLength is the number of tool changes kept on each server.
+	maxToolChangelogLength = 100
+
+	toolChangeBaselinePreview  = "toolPreview"
+	toolChangeBaselineSnapshot = "snapshot"
+)
+
+var (
+	// destructiveToolWords are words in a tool's name that suggest the tool changes or removes data.
+	destructiveToolWords = []string{
+		"delete", "remove", "drop", "destroy", "truncate", "purge", "wipe", "erase",
+		"terminate", "kill", "revoke", "overwrite", "exec", "execute", "shell",
+	}
+
+	// promptInjectionPhrases are phrases in a tool's description that address the model instead of describing the tool.
+	promptInjectionPhrases = []string{
+		"ignore previous", "ignore all previous", "ignore the above", "ignore any previous", "disregard",
+		"system prompt", "<important>", "<system>", "do not tell the user", "don't tell the user",
+		"do not mention", "don't mention", "without telling", "without informing", "before using this tool",
+		"before calling any", "instead of the user", "~/.ssh", "id_rsa", "exfiltrate", "send the contents",
+	}
+
+	// hiddenCharacters are invisible characters that can be used to hide instructions in a tool's description.
+	hiddenCharacters = []string{"\u200b", "\u200c", "\u200d", "\u2060", "\ufeff"}
+)
+
+// DetectToolChanges periodically lists the tools of running multi-user MCP servers and records the differences
+// from the server's previous snapshot, or from its tool preview if there is no snapshot yet. The tool preview of the
+// server's catalog entry is regenerated from the listed tools that aren't waiting for approval.
+func (h *Handler) DetectToolChanges(req router.Request, resp router.Response) error {
+	server := req.Object.(*v1.MCPServer)
+
+	if h.toolCheckInterval <= 0 ||
+		server.Spec.MCPCatalogID == "" && server.Spec.PowerUserWorkspaceID == "" ||
+		server.Spec.Template || server.Spec.NeedsURL ||
+		// Remote servers can require per-user OAuth and composite servers are checked through their components.
+		server.Spec.Manifest.Runtime == types.RuntimeRemote || server.Spec.Manifest.Runtime == types.RuntimeComposite {
+		return nil
+	}
+
+	if server.Status.ToolsLastChecked != nil {
+		if until := time.Until(server.Status.ToolsLastChecked.Add(h.toolCheckInterval)); until > 0 {
+			resp.RetryAfter(until)
+			return nil
+		}
+	}
+
+	serverConfig, ok, err := h.toolCheckServerConfig(req.Ctx, *server)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		resp.RetryAfter(h.toolCheckInterval)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(req.Ctx, time.Minute)
+	defer cancel()
+
+	tools, err := h.mcpSessionManager.ListRunningServerTools(ctx, serverConfig)
+	if err != nil {
+		if !errors.Is(err, mcp.ErrServerNotRunning) {
+			log.Warnf("failed to list tools of MCP server %s for change detection: %v", server.Name, err)
+		}
+		resp.RetryAfter(h.toolCheckInterval)
+		return nil
+	}
+
+	snapshot, err := snapshotTools(tools)
+	if err != nil {
+		return err
+	}
+
+	now := metav1.Now()
+	changes := detectToolChanges(*server, snapshot, *types.NewTime(now.Time))
+
+	for i, change := range changes {
+		switch change.Type {
+		case types.MCPServerToolChangeTypeAdded:
+			if h.autoDisableNewTools && !slices.Contains(server.Status.PendingApprovalTools, change.Tool) {
+				server.Status.PendingApprovalTools = append(server.Status.PendingApprovalTools, change.Tool)
+				changes[i].Disabled = true
+			}
+		case types.MCPServerToolChangeTypeRemoved:
+			server.Status.PendingApprovalTools = slices.DeleteFunc(server.Status.PendingApprovalTools, func(tool string) bool {
+				return tool == change.Tool
+			})
+		}
+
+		if len(change.SuspiciousReasons) > 0 {
+			log.Warnf("suspicious tool change detected on MCP server %s: tool %s %s: %s", server.Name, change.Tool, change.Type, strings.Join(change.SuspiciousReasons, "; "))
+		}
+	}
+
+	server.Status.ToolChangelog = append(server.Status.ToolChangelog, changes...)
+	if extra := len(server.Status.ToolChangelog) - maxToolChangelogLength; extra > 0 {
+		server.Status.ToolChangelog = server.Status.ToolChangelog[extra:]
+	}
+	server.Status.ToolSnapshot = snapshot
+	server.Status.ToolsLastChecked = &now
+
+	if err := req.Client.Status().Update(req.Ctx, server); err != nil {
+		return fmt.Errorf("failed to update tool snapshot: %w", err)
+	}
+
+	if err := regenerateToolPreview(req.Ctx, req.Client, *server, tools); err != nil {
+		return err
+	}
+
+	resp.RetryAfter(h.toolCheckInterval)
+	return nil
+}
+
+// toolCheckServerConfig builds the config of a multi-user server for listing its tools.
+// If the server is not fully configured yet, then false is returned.
+func (h *Handler) toolCheckServerConfig(ctx context.Context, server v1.MCPServer) (mcp.ServerConfig, bool, error) {
+	scope := server.Spec.MCPCatalogID
+	if scope == "" {
+		scope = server.Spec.PowerUserWorkspaceID
+	}
+
+	cred, err := h.gptClient.RevealCredential(ctx, []string{fmt.Sprintf("%s-%s", scope, server.Name)}, server.Name)
+	if err != nil && !errors.As(err, &gptscript.ErrNotFound{}) {
+		return mcp.ServerConfig{}, false, fmt.Errorf("failed to find credential: %w", err)
+	}
+
+	tokenExchangeCred, err := h.gptClient.RevealCredential(ctx, []string{server.Name}, server.Name)
+	if errors.As(err, &gptscript.ErrNotFound{}) {
+		// The token exchange credential hasn't been created yet.
+		return mcp.ServerConfig{}, false, nil
+	} else if err != nil {
+		return mcp.ServerConfig{}, false, fmt.Errorf("failed to find token exchange credential: %w", err)
+	}
+
+	catalogName := server.Spec.MCPCatalogID
+	if catalogName == "" {
+		catalogName = server.Status.MCPCatalogID
+	}
+
+	// Use "system" for the user ID to identify non-user requests.
+	serverConfig, missingRequired, err := mcp.ServerToServerConfig(server, server.ValidConnectURLs(h.baseURL), h.baseURL, "system", scope, catalogName, cred.Env, tokenExchangeCred.Env)
+	if err != nil {
+		return mcp.ServerConfig{}, false, err
+	}
+
+	return serverConfig, len(missingRequired) == 0, nil
+}
+
+// regenerateToolPreview updates the tool preview of the catalog entry a server was created from with the tools listed
+// from the server, if they differ. Servers whose configuration has drifted from their entry are skipped, because their
+// tools may not match the entry's anymore. Tools waiting for approval are left out, so that catalog users don't see
+// them before an admin does.
+func regenerateToolPreview(ctx context.Context, client kclient.Client, server v1.MCPServer, tools []nmcp.Tool) error {
+	if server.Spec.MCPServerCatalogEntryName == "" || server.Spec.CompositeName != "" {
+		return nil
+	}
+
+	var entry v1.MCPServerCatalogEntry
+	if err := client.Get(ctx, kclient.ObjectKey{Namespace: server.Namespace, Name: server.Spec.MCPServerCatalogEntryName}, &entry); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get catalog entry: %w", err)
+	}
+
+	if drifted, err := ConfigurationHasDrifted(server.Spec.Manifest, entry.Spec.Manifest); err != nil || drifted {
+		return err
+	}
+
+	tools = slices.DeleteFunc(slices.Clone(tools), func(tool nmcp.Tool) bool {
+		return slices.Contains(server.Status.PendingApprovalTools, tool.Name)
+	})
+
+	preview, err := mcp.ConvertTools(tools, []string{"*"}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to convert tools of MCP server %s: %w", server.Name, err)
+	}
+
+	if toolPreviewsEqual(entry.Spec.Manifest.ToolPreview, preview) {
+		return nil
+	}
+
+	entry.Spec.Manifest.ToolPreview = preview
+	if err := client.Update(ctx, &entry); err != nil {
+		return fmt.Errorf("failed to update tool preview of catalog entry %s: %w", entry.Name, err)
+	}
+
+	now := metav1.Now()
+	entry.Status.ToolPreviewsLastGenerated = &now
+	if err := client.Status().Update(ctx, &entry); err != nil {
+		return fmt.Errorf("failed to update tool preview of catalog entry %s: %w", entry.Name, err)
+	}
+
+	return nil
+}
+
+// toolPreviewsEqual returns true if the tool previews have the same tools with the same descriptions and parameters.
+func toolPreviewsEqual(a, b []types.MCPServerTool) bool {
+	return slices.EqualFunc(a, b, func(a, b types.MCPServerTool) bool {
+		return a.Name == b.Name && a.Description == b.Description && maps.Equal(a.Params, b.Params)
+	})
+}
+
+// snapshotTools converts the tools listed from a server into a snapshot.
+func snapshotTools(tools []nmcp.Tool) ([]types.MCPServerToolSnapshot, error) {
+	snapshot := make([]types.MCPServerToolSnapshot, 0, len(tools))
+	for _, tool := range tools {
+		var schemaHash string
+		if len(tool.InputSchema) > 0 {
+			// Hash the decoded schema so that formatting and key order don't register as changes.
+			var schema any
+			if err := json.Unmarshal(tool.InputSchema, &schema); err != nil {
+				return nil, fmt.Errorf("failed to decode input schema of tool %s: %w", tool.Name, err)
+			}
+			schemaHash = hash.Digest(schema)
+		}
+
+		snapshot = append(snapshot, types.MCPServerToolSnapshot{
+			Name:        tool.Name,
+			Description: tool.Description,
+			SchemaHash:  schemaHash,
+			Destructive: tool.Annotations != nil && tool.Annotations.DestructiveHint != nil && *tool.Annotations.DestructiveHint,
+		})
+	}
+
+	slices.SortFunc(snapshot, func(a, b types.MCPServerToolSnapshot) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return snapshot, nil
+}
+
+// detectToolChanges compares the current tools of a server with its previous snapshot. If the server doesn't have a
+// snapshot yet, then the tools are compared with the server's tool preview. Schemas are only compared with snapshots,
+// because tool previews don't include them.
+func detectToolChanges(server v1.MCPServer, current []types.MCPServerToolSnapshot, detectedAt types.Time) []types.MCPServerToolChange {
+	var (
+		previous       = server.Status.ToolSnapshot
+		baseline       = toolChangeBaselineSnapshot
+		compareSchemas = true
+	)
+	if server.Status.ToolsLastChecked == nil {
+		if len(server.Spec.Manifest.ToolPreview) == 0 {
+			// Nothing to compare with, this snapshot becomes the baseline.
+			return nil
+		}
+
+		baseline = toolChangeBaselinePreview
+		compareSchemas = false
+		previous = make([]types.MCPServerToolSnapshot, 0, len(server.Spec.Manifest.ToolPreview))
+		for _, tool := range server.Spec.Manifest.ToolPreview {
+			previous = append(previous, types.MCPServerToolSnapshot{
+				Name:        tool.Name,
+				Description: tool.Description,
+			})
+		}
+	}
+
+	previousByName := make(map[string]types.MCPServerToolSnapshot, len(previous))
+	for _, tool := range previous {
+		previousByName[tool.Name] = tool
+	}
+
+	var changes []types.MCPServerToolChange
+	for _, tool := range current {
+		prev, ok := previousByName[tool.Name]
+		delete(previousByName, tool.Name)
+
+		if !ok {
+			changes = append(changes, types.MCPServerToolChange{
+				DetectedAt:        detectedAt,
+				Tool:              tool.Name,
+				Type:              types.MCPServerToolChangeTypeAdded,
+				Description:       tool.Description,
+				Baseline:          baseline,
+				SuspiciousReasons: append(destructiveToolReasons(tool), promptInjectionReasons(tool.Description)...),
+			})
+			continue
+		}
+
+		if prev.Description != tool.Description {
+			changes = append(changes, types.MCPServerToolChange{
+				DetectedAt:          detectedAt,
+				Tool:                tool.Name,
+				Type:                types.MCPServerToolChangeTypeDescriptionChanged,
+				PreviousDescription: prev.Description,
+				Description:         tool.Description,
+				Baseline:            baseline,
+				SuspiciousReasons:   promptInjectionReasons(tool.Description),
+			})
+		}
+
+		if compareSchemas && prev.SchemaHash != tool.SchemaHash {
+			var reasons []string
+			if tool.Destructive && !prev.Destructive {
+				reasons = append(reasons, "tool is now marked as destructive")
+			}
+			changes = append(changes, types.MCPServerToolChange{
+				DetectedAt:        detectedAt,
+				Tool:              tool.Name,
+				Type:              types.MCPServerToolChangeTypeSchemaChanged,
+				Baseline:          baseline,
+				SuspiciousReasons: reasons,
+			})
+		}
+	}
+
+	for _, tool := range previous {
+		if _, ok := previousByName[tool.Name]; ok {
+			changes = append(changes, types.MCPServerToolChange{
+				DetectedAt:          detectedAt,
+				Tool:                tool.Name,
+				Type:                types.MCPServerToolChangeTypeRemoved,
+				PreviousDescription: tool.Description,
+				Baseline:            baseline,
+			})
+		}
+	}
+
+	return changes
+}
+
+// destructiveToolReasons returns the reasons a new tool looks destructive, if any.
+func destructiveToolReasons(tool types.MCPServerToolSnapshot) []string {
+	var reasons []string
+	if tool.Destructive {
+		reasons = append(reasons, "new tool is marked as destructive")
+	}
+
+	words := strings.FieldsFunc(tool.Name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		for _, part := range splitCamelCase(word) {
+			if slices.Contains(destructiveToolWords, strings.ToLower(part)) {
+				return append(reasons, fmt.Sprintf("new tool name suggests a destructive operation: %q", part))
+			}
+		}
+	}
+
+	return reasons
+}
+
+// promptInjectionReasons returns the reasons a tool description looks like it contains instructions for the model, if any.
+func promptInjectionReasons(description string) []string {
+	var reasons []string
+
+	lower := strings.ToLower(description)
+	for _, phrase := range promptInjectionPhrases {
+		if strings.Contains(lower, phrase) {
+			reasons = append(reasons, fmt.Sprintf("description contains %q", phrase))
+		}
+	}
+
+	for _, c := range hiddenCharacters {
+		if strings.Contains(description, c) {
+			reasons = append(reasons, "description contains invisible characters")
+			break
+		}
+	}
+
+	return reasons
+}
+
+// splitCamelCase splits a word like "deleteRepo" into "delete" and "Repo".
+func splitCamelCase(word string) []string {
+	var (
+		parts []string
+		start int
+	)
+	runes := []rune(word)
+	for i := 1; i < len(runes); i++ {
+		if unicode.IsUpper(runes[i]) && !unicode.IsUpper(runes[i-1]) {
+			parts = append(parts, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	return append(parts, string(runes[start:]))
+}
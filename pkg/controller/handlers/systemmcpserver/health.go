@@ -0,0 +1,50 @@
+package systemmcpserver
+
+import (
+	"fmt"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/mcphealth"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// CheckHealth periodically probes enabled and fully configured system MCP servers in the background and records their
+// health.
+func (h *Handler) CheckHealth(req router.Request, resp router.Response) error {
+	systemServer := req.Object.(*v1.SystemMCPServer)
+
+	if !h.healthChecker.Enabled() || !systemServer.Spec.Manifest.Enabled ||
+		!isSystemServerConfigured(req.Ctx, h.gptClient, *systemServer) {
+		return nil
+	}
+
+	if until := h.healthChecker.NextProbe(systemServer.Status.Health); until > 0 {
+		resp.RetryAfter(until)
+		return nil
+	}
+
+	serverConfig, ok, err := h.serverConfig(req.Ctx, *systemServer)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		resp.RetryAfter(h.healthChecker.Interval())
+		return nil
+	}
+
+	retryAfter, err := h.healthChecker.Check(mcphealth.Server{
+		ID:   systemServer.Name,
+		Name: systemServer.Spec.Manifest.Name,
+		Kind: "systemmcpserver",
+	}, serverConfig, systemServer.Status.Health, func(health *types.MCPServerHealth) error {
+		systemServer.Status.Health = health
+		return req.Client.Status().Update(req.Ctx, systemServer)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update health: %w", err)
+	}
+
+	resp.RetryAfter(retryAfter)
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/logger"
 	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/mcphealth"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"golang.org/x/crypto/bcrypt"
@@ -31,13 +32,15 @@ type Handler struct {
 	gptClient         *gptscript.GPTScript
 	mcpSessionManager *mcp.SessionManager
 	serverURL         string
+	healthChecker     *mcphealth.Checker
 }
 
-func New(gptClient *gptscript.GPTScript, mcpLoader *mcp.SessionManager, serverURL string) *Handler {
+func New(gptClient *gptscript.GPTScript, mcpLoader *mcp.SessionManager, serverURL string, healthChecker *mcphealth.Checker) *Handler {
 	return &Handler{
 		gptClient:         gptClient,
 		mcpSessionManager: mcpLoader,
 		serverURL:         serverURL,
+		healthChecker:     healthChecker,
 	}
 }
 
@@ -159,13 +162,40 @@ func (h *Handler) EnsureDeployment(req router.Request, _ router.Response) error
 		return nil
 	}
 
+	serverConfig, ok, err := h.serverConfig(req.Ctx, *systemServer)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// Still missing required configuration
+		return nil
+	}
+
+	log.Infof("Launching system MCP server %s (runtime=%s, image=%s)",
+		systemServer.Name, serverConfig.Runtime, serverConfig.ContainerImage)
+
+	// Deploy the system server via backend
+	// System servers don't use webhooks, so pass nil
+	_, err = h.mcpSessionManager.LaunchServer(req.Ctx, serverConfig)
+	if err != nil {
+		return fmt.Errorf("failed to deploy system MCP server: %w", err)
+	}
+
+	log.Infof("System MCP server %s launched successfully", systemServer.Name)
+
+	return nil
+}
+
+// serverConfig builds the config of a system server from its credentials.
+// If the server is still missing required configuration, then false is returned.
+func (h *Handler) serverConfig(ctx context.Context, systemServer v1.SystemMCPServer) (mcp.ServerConfig, bool, error) {
 	// Get credentials for deployment
 	credCtx := systemServer.Name
-	creds, err := h.gptClient.ListCredentials(req.Ctx, gptscript.ListCredentialsOptions{
+	creds, err := h.gptClient.ListCredentials(ctx, gptscript.ListCredentialsOptions{
 		CredentialContexts: []string{credCtx},
 	})
 	if err != nil {
-		return fmt.Errorf("failed to list credentials: %w", err)
+		return mcp.ServerConfig{}, false, fmt.Errorf("failed to list credentials: %w", err)
 	}
 
 	secretToolName := secretInfoToolName(systemServer.Name)
@@ -176,7 +206,7 @@ func (h *Handler) EnsureDeployment(req router.Request, _ router.Response) error
 			continue
 		}
 		// Get credential details
-		credDetail, err := h.gptClient.RevealCredential(req.Ctx, []string{credCtx}, cred.ToolName)
+		credDetail, err := h.gptClient.RevealCredential(ctx, []string{credCtx}, cred.ToolName)
 		if err != nil {
 			continue
 		}
@@ -198,10 +228,10 @@ func (h *Handler) EnsureDeployment(req router.Request, _ router.Response) error
 	}, func(err error) bool {
 		return errors.As(err, &gptscript.ErrNotFound{})
 	}, func() error {
-		tokenExchangeCred, tokenCredErr = h.gptClient.RevealCredential(req.Ctx, []string{systemServer.Name}, secretToolName)
+		tokenExchangeCred, tokenCredErr = h.gptClient.RevealCredential(ctx, []string{systemServer.Name}, secretToolName)
 		return tokenCredErr
 	}); err != nil {
-		return fmt.Errorf("failed to find token exchange credential: %w", tokenCredErr)
+		return mcp.ServerConfig{}, false, fmt.Errorf("failed to find token exchange credential: %w", tokenCredErr)
 	}
 
 	secretsCred := tokenExchangeCred.Env
@@ -209,31 +239,18 @@ func (h *Handler) EnsureDeployment(req router.Request, _ router.Response) error
 	audiences := systemServer.ValidConnectURLs(h.serverURL)
 
 	// Transform to ServerConfig
-	serverConfig, missingRequired, err := mcp.SystemServerToServerConfig(*systemServer, audiences, h.serverURL, credEnv, secretsCred)
+	serverConfig, missingRequired, err := mcp.SystemServerToServerConfig(systemServer, audiences, h.serverURL, credEnv, secretsCred)
 	if err != nil {
-		return fmt.Errorf("failed to transform system server to config: %w", err)
+		return mcp.ServerConfig{}, false, fmt.Errorf("failed to transform system server to config: %w", err)
 	}
 
 	if len(missingRequired) > 0 {
 		log.Infof("System MCP server %s still has missing required configuration: %v",
 			systemServer.Name, missingRequired)
-		// Still missing required configuration
-		return nil
-	}
-
-	log.Infof("Launching system MCP server %s (runtime=%s, image=%s)",
-		systemServer.Name, serverConfig.Runtime, serverConfig.ContainerImage)
-
-	// Deploy the system server via backend
-	// System servers don't use webhooks, so pass nil
-	_, err = h.mcpSessionManager.LaunchServer(req.Ctx, serverConfig)
-	if err != nil {
-		return fmt.Errorf("failed to deploy system MCP server: %w", err)
+		return mcp.ServerConfig{}, false, nil
 	}
 
-	log.Infof("System MCP server %s launched successfully", systemServer.Name)
-
-	return nil
+	return serverConfig, true, nil
 }
 
 // CleanupDeployment handles cleanup when SystemMCPServer is deleted
@@ -72,6 +72,20 @@ func getParentWorkspaceNames(ctx context.Context, c kclient.Client, thread *v1.T
 		return nil, true, nil
 	}
 
+	// Forks start with a copy of the files of the thread they were forked from, which already include the files of the
+	// project and agent.
+	if thread.Spec.ForkedFromThreadName != "" {
+		var sourceThread v1.Thread
+		if err := c.Get(ctx, kclient.ObjectKey{Namespace: thread.Namespace, Name: thread.Spec.ForkedFromThreadName}, &sourceThread); err == nil {
+			if sourceThread.Status.WorkspaceName == "" {
+				return nil, false, nil
+			}
+			return []string{sourceThread.Status.WorkspaceName}, true, nil
+		} else if !apierrors.IsNotFound(err) {
+			return nil, false, err
+		}
+	}
+
 	parentThreadName := thread.Spec.ParentThreadName
 	for parentThreadName != "" {
 		var parentThread v1.Thread
@@ -0,0 +1,47 @@
+package threads
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetParentWorkspaceNamesForFork(t *testing.T) {
+	source := &v1.Thread{
+		ObjectMeta: metav1.ObjectMeta{Name: "t1source", Namespace: "default"},
+		Spec:       v1.ThreadSpec{ParentThreadName: "t1project"},
+		Status:     v1.ThreadStatus{WorkspaceName: "ws1source"},
+	}
+	project := &v1.Thread{
+		ObjectMeta: metav1.ObjectMeta{Name: "t1project", Namespace: "default"},
+		Spec:       v1.ThreadSpec{Project: true},
+		Status:     v1.ThreadStatus{Created: true, WorkspaceName: "ws1project"},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(source, project).Build()
+
+	fork := &v1.Thread{
+		ObjectMeta: metav1.ObjectMeta{Name: "t1fork", Namespace: "default"},
+		Spec:       v1.ThreadSpec{ParentThreadName: "t1project", ForkedFromThreadName: "t1source"},
+	}
+	names, ok, err := getParentWorkspaceNames(t.Context(), c, fork)
+	if err != nil || !ok {
+		t.Fatalf("expected the workspace names to be ready, got %v, %v", ok, err)
+	}
+	if !slices.Equal(names, []string{"ws1source"}) {
+		t.Errorf("expected the fork to copy the workspace of the source thread, got %v", names)
+	}
+
+	// A fork of a deleted thread starts from the project's files.
+	fork.Spec.ForkedFromThreadName = "t1deleted"
+	names, ok, err = getParentWorkspaceNames(t.Context(), c, fork)
+	if err != nil || !ok {
+		t.Fatalf("expected the workspace names to be ready, got %v, %v", ok, err)
+	}
+	if !slices.Equal(names, []string{"ws1project"}) {
+		t.Errorf("expected the fork to copy the workspace of the project, got %v", names)
+	}
+}
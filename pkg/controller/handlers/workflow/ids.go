@@ -33,6 +33,22 @@ func populateStepID(seen map[string]struct{}, step types.Step) types.Step {
 		step.ID = nextID(seen)
 	} else if _, ok := seen[step.ID]; ok {
 		step.ID = nextID(seen)
+	} else {
+		seen[step.ID] = struct{}{}
+	}
+
+	if step.If != nil {
+		for i, nested := range step.If.Steps {
+			step.If.Steps[i] = populateStepID(seen, nested)
+		}
+		for i, nested := range step.If.Else {
+			step.If.Else[i] = populateStepID(seen, nested)
+		}
+	}
+	if step.While != nil {
+		for i, nested := range step.While.Steps {
+			step.While.Steps[i] = populateStepID(seen, nested)
+		}
 	}
 	return step
 }
@@ -0,0 +1,101 @@
+package workflow
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// ValidateSteps checks that the steps, including nested if and while steps, are well-formed.
+func ValidateSteps(steps []types.Step) error {
+	for _, step := range steps {
+		if err := validateStep(step); err != nil {
+			if step.ID != "" {
+				return fmt.Errorf("step %s: %w", step.ID, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func validateStep(step types.Step) error {
+	if strings.ContainsAny(step.ID, "{}") {
+		return fmt.Errorf("step ID must not contain braces")
+	}
+
+	var kinds int
+	for _, set := range []bool{len(step.Loop) > 0, step.If != nil, step.While != nil, step.SubWorkflow != nil, step.Approval != nil} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds > 1 {
+		return fmt.Errorf("only one of loop, if, while, subWorkflow, and approval may be set")
+	}
+
+	if step.SubWorkflow != nil && step.SubWorkflow.WorkflowID == "" {
+		return fmt.Errorf("invalid subWorkflow: workflowID is required")
+	}
+
+	if step.Approval != nil && step.Approval.TimeoutMinutes < 0 {
+		return fmt.Errorf("invalid approval: timeoutMinutes must not be negative")
+	}
+
+	if step.If != nil {
+		if err := validateCondition(step.If.Condition, step.If.Compare); err != nil {
+			return fmt.Errorf("invalid if: %w", err)
+		}
+		if len(step.If.Steps) == 0 && len(step.If.Else) == 0 {
+			return fmt.Errorf("invalid if: steps or else is required")
+		}
+		if err := ValidateSteps(step.If.Steps); err != nil {
+			return err
+		}
+		return ValidateSteps(step.If.Else)
+	}
+
+	if step.While != nil {
+		if err := validateCondition(step.While.Condition, step.While.Compare); err != nil {
+			return fmt.Errorf("invalid while: %w", err)
+		}
+		if step.While.MaxLoops < 0 || step.While.MaxLoops > types.MaxWhileMaxLoops {
+			return fmt.Errorf("invalid while: maxLoops must be between 0 and %d", types.MaxWhileMaxLoops)
+		}
+		if len(step.While.Steps) == 0 {
+			return fmt.Errorf("invalid while: steps are required")
+		}
+		return ValidateSteps(step.While.Steps)
+	}
+
+	return nil
+}
+
+func validateCondition(condition string, compare *types.Comparison) error {
+	if (condition == "") == (compare == nil) {
+		return fmt.Errorf("exactly one of condition and compare is required")
+	}
+	if compare == nil {
+		return nil
+	}
+
+	switch compare.Operator {
+	case types.ComparisonOperatorEquals, types.ComparisonOperatorNotEquals,
+		types.ComparisonOperatorContains, types.ComparisonOperatorNotContains:
+	case types.ComparisonOperatorMatches:
+		if _, err := regexp.Compile(compare.Value); err != nil {
+			return fmt.Errorf("invalid regular expression %q: %w", compare.Value, err)
+		}
+	case types.ComparisonOperatorGreaterThan, types.ComparisonOperatorLessThan:
+		if _, err := strconv.ParseFloat(compare.Value, 64); err != nil {
+			return fmt.Errorf("value %q must be a number for operator %s", compare.Value, compare.Operator)
+		}
+	default:
+		return fmt.Errorf("unknown comparison operator %q", compare.Operator)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package workflowexecution
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"text/template"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+const defaultNotificationTemplate = `{{if eq .State "Error"}}Task "{{.Task}}" failed{{if gt .Attempt 1}} after {{.Attempt}} attempts{{end}}: {{.Error}}{{else}}Task "{{.Task}}" completed successfully.{{end}}
+Run: {{.RunID}}`
+
+type notificationData struct {
+	Task      string    `json:"task"`
+	TaskID    string    `json:"taskID"`
+	RunID     string    `json:"runID"`
+	State     string    `json:"state"`
+	Error     string    `json:"error,omitempty"`
+	Output    string    `json:"output,omitempty"`
+	Attempt   int       `json:"attempt"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+}
+
+// Notify sends the workflow's notifications once the execution is finished. Failures that will be retried don't
+// send notifications, the last attempt does.
+func (h *Handler) Notify(req router.Request, _ router.Response) error {
+	we := req.Object.(*v1.WorkflowExecution)
+	if !we.Status.State.IsTerminal() || we.Status.EndTime == nil ||
+		we.Status.WorkflowManifest == nil || len(we.Status.WorkflowManifest.Notifications) == 0 {
+		return nil
+	}
+	if we.Status.NotifiedAt != nil && !we.Status.NotifiedAt.Before(we.Status.EndTime) {
+		return nil
+	}
+
+	event := types.TaskNotificationEventSuccess
+	if we.Status.State == types.WorkflowStateError {
+		if willRetry(we) {
+			return nil
+		}
+		event = types.TaskNotificationEventFailure
+	}
+
+	data := notificationData{
+		Task:      we.Status.WorkflowManifest.Name,
+		TaskID:    we.Spec.WorkflowName,
+		RunID:     we.Name,
+		State:     string(we.Status.State),
+		Error:     we.Status.Error,
+		Output:    we.Status.Output,
+		Attempt:   attemptOf(we),
+		StartTime: we.CreationTimestamp.Time,
+		EndTime:   we.Status.EndTime.Time,
+	}
+	if data.Task == "" {
+		data.Task = data.TaskID
+	}
+
+	var sends []func(context.Context) error
+	for _, notification := range we.Status.WorkflowManifest.Notifications {
+		on := notification.On
+		if len(on) == 0 {
+			on = []types.TaskNotificationEvent{types.TaskNotificationEventFailure}
+		}
+		if !slices.Contains(on, event) {
+			continue
+		}
+
+		message, err := renderNotification(notification.Template, data)
+		if err != nil {
+			sends = append(sends, func(context.Context) error {
+				return err
+			})
+			continue
+		}
+
+		switch {
+		case len(notification.Email) > 0:
+			subject := fmt.Sprintf("Task %q completed", data.Task)
+			if event == types.TaskNotificationEventFailure {
+				subject = fmt.Sprintf("Task %q failed", data.Task)
+			}
+			sends = append(sends, func(context.Context) error {
+				return h.notifier.Email(notification.Email, subject, message)
+			})
+		case notification.SlackWebhookURL != "":
+			sends = append(sends, func(ctx context.Context) error {
+				return h.notifier.Slack(ctx, notification.SlackWebhookURL, message)
+			})
+		case notification.WebhookURL != "":
+			payload := struct {
+				notificationData
+				Event   types.TaskNotificationEvent `json:"event"`
+				Message string                      `json:"message"`
+			}{
+				notificationData: data,
+				Event:            event,
+				Message:          message,
+			}
+			sends = append(sends, func(ctx context.Context) error {
+				return h.notifier.Webhook(ctx, notification.WebhookURL, payload)
+			})
+		}
+	}
+
+	// Notifications are sent in the background and aren't resent on failure, so that an unreachable destination
+	// doesn't block the execution or the controller.
+	we.Status.NotifiedAt = &metav1.Time{Time: time.Now()}
+	we.Status.NotificationError = ""
+	if len(sends) == 0 {
+		return nil
+	}
+
+	key := router.Key(we.Namespace, we.Name)
+	err := h.notifier.Enqueue(func(ctx context.Context) error {
+		var errs []error
+		for _, send := range sends {
+			errs = append(errs, send(ctx))
+		}
+		return errors.Join(errs...)
+	}, func(err error) {
+		if err != nil {
+			recordNotificationError(req.Client, key, err)
+		}
+	})
+	if err != nil {
+		log.Warnf("failed to queue notifications for workflow execution %s: %v", we.Name, err)
+		we.Status.NotificationError = err.Error()
+	}
+	return nil
+}
+
+// recordNotificationError stores the error of notifications that were sent in the background.
+func recordNotificationError(c kclient.Client, key kclient.ObjectKey, sendErr error) {
+	log.Warnf("failed to send notifications for workflow execution %s: %v", key.Name, sendErr)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var we v1.WorkflowExecution
+		if err := c.Get(ctx, key, &we); err != nil {
+			return err
+		}
+		we.Status.NotificationError = sendErr.Error()
+		return c.Status().Update(ctx, &we)
+	}); err != nil {
+		log.Warnf("failed to record notification error for workflow execution %s: %v", key.Name, err)
+	}
+}
+
+func renderNotification(text string, data notificationData) (string, error) {
+	if text == "" {
+		text = defaultNotificationTemplate
+	}
+
+	tmpl, err := template.New("notification").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid notification template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render notification: %w", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,23 @@
+package workflowexecution
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderNotification(t *testing.T) {
+	data := notificationData{Task: "Daily report", RunID: "we1abc", State: string(types.WorkflowStateError), Error: "boom", Attempt: 3}
+
+	message, err := renderNotification("", data)
+	require.NoError(t, err)
+	require.Equal(t, "Task \"Daily report\" failed after 3 attempts: boom\nRun: we1abc", message)
+
+	message, err = renderNotification("{{.Task}} is {{.State}}", data)
+	require.NoError(t, err)
+	require.Equal(t, "Daily report is Error", message)
+
+	_, err = renderNotification("{{.Task", data)
+	require.Error(t, err)
+}
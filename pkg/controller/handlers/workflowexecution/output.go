@@ -0,0 +1,49 @@
+package workflowexecution
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/structuredoutput"
+)
+
+// outputPrompt returns the prompt of the output step, which asks for JSON when the workflow has an output schema.
+func outputPrompt(manifest types.WorkflowManifest) string {
+	if len(manifest.OutputSchema) == 0 {
+		return manifest.Output
+	}
+
+	prompt := manifest.Output
+	if prompt == "" {
+		prompt = "Produce the final result of the work done so far."
+	}
+	return fmt.Sprintf(`%s
+
+Respond with only a JSON value that conforms to the following JSON Schema. Do not include any other text or code fences.
+
+%s`, prompt, manifest.OutputSchema)
+}
+
+// outputRetries returns how many times the model is asked again for output that doesn't conform to the schema.
+func outputRetries(manifest types.WorkflowManifest) int {
+	if manifest.OutputRetries == nil {
+		return types.DefaultOutputRetries
+	}
+	return *manifest.OutputRetries
+}
+
+func outputRetryPrompt(err error) string {
+	return fmt.Sprintf(`Your previous response did not conform to the JSON Schema: %v
+
+Respond again with only a JSON value that conforms to the schema. Do not include any other text or code fences.`, err)
+}
+
+// parseStructuredOutput extracts the JSON value from the output and validates it against the output schema.
+func parseStructuredOutput(schema json.RawMessage, output string) (json.RawMessage, error) {
+	resolved, err := structuredoutput.ResolveSchema(schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output schema: %w", err)
+	}
+	return structuredoutput.Parse(resolved, output)
+}
@@ -0,0 +1,15 @@
+package workflowexecution
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputRetries(t *testing.T) {
+	zero, three := 0, 3
+	require.Equal(t, types.DefaultOutputRetries, outputRetries(types.WorkflowManifest{}))
+	require.Equal(t, 0, outputRetries(types.WorkflowManifest{OutputRetries: &zero}))
+	require.Equal(t, 3, outputRetries(types.WorkflowManifest{OutputRetries: &three}))
+}
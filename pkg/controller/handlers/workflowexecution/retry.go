@@ -0,0 +1,130 @@
+package workflowexecution
+
+import (
+	"slices"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/hash"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var defaultRetryConditions = []types.TaskRetryCondition{
+	types.TaskRetryConditionRateLimit,
+	types.TaskRetryConditionMCPServerLaunch,
+}
+
+// Retry starts a new execution after a backoff when this execution failed and the retry policy of the workflow
+// allows another attempt.
+func (h *Handler) Retry(req router.Request, resp router.Response) error {
+	we := req.Object.(*v1.WorkflowExecution)
+	if !willRetry(we) || we.Status.RetryWorkflowExecutionName != "" {
+		return nil
+	}
+
+	attempt := attemptOf(we)
+	retryAt := we.Status.EndTime.Add(backoff(*we.Status.WorkflowManifest.RetryPolicy, attempt))
+	we.Status.RetryAt = &metav1.Time{Time: retryAt}
+	if until := time.Until(retryAt); until > 0 {
+		resp.RetryAfter(until)
+		return nil
+	}
+
+	retry := &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      system.WorkflowExecutionPrefix + hash.String([]any{we.Name, attempt + 1})[:12],
+			Namespace: we.Namespace,
+		},
+		Spec: v1.WorkflowExecutionSpec{
+			Input:           we.Spec.Input,
+			ThreadName:      we.Spec.ThreadName,
+			WorkflowName:    we.Spec.WorkflowName,
+			CronJobName:     we.Spec.CronJobName,
+			WebhookName:     we.Spec.WebhookName,
+			WebhookDelivery: we.Spec.WebhookDelivery,
+			TaskBreakCrumb:  we.Spec.TaskBreakCrumb,
+			Attempt:         attempt + 1,
+			PreviousAttempts: append(slices.Clone(we.Spec.PreviousAttempts), v1.WorkflowExecutionAttempt{
+				WorkflowExecutionName: we.Name,
+				Error:                 we.Status.Error,
+				StartTime:             we.CreationTimestamp,
+				EndTime:               we.Status.EndTime,
+			}),
+		},
+	}
+	if err := req.Client.Create(req.Ctx, retry); err != nil && !apierror.IsAlreadyExists(err) {
+		return err
+	}
+
+	we.Status.RetryWorkflowExecutionName = retry.Name
+	return nil
+}
+
+// willRetry returns true if the execution failed and will be, or was, retried according to the retry policy.
+func willRetry(we *v1.WorkflowExecution) bool {
+	if we.Status.State != types.WorkflowStateError || we.Status.EndTime == nil ||
+		we.Status.WorkflowManifest == nil || we.Status.WorkflowManifest.RetryPolicy == nil {
+		return false
+	}
+
+	// Executions started by a run or by another workflow report their result to the caller, which doesn't follow retries.
+	if we.Spec.RunName != "" || we.Spec.ParentWorkflowExecutionName != "" {
+		return false
+	}
+
+	policy := we.Status.WorkflowManifest.RetryPolicy
+	return attemptOf(we) < policy.MaxAttempts && isRetryable(we.Status.ErrorReason, policy.RetryOn)
+}
+
+func attemptOf(we *v1.WorkflowExecution) int {
+	return max(we.Spec.Attempt, 1)
+}
+
+// backoff returns the delay before retrying the given attempt, which doubles with every attempt.
+func backoff(policy types.TaskRetryPolicy, attempt int) time.Duration {
+	initial := policy.InitialBackoffSeconds
+	if initial <= 0 {
+		initial = types.DefaultTaskRetryInitialBackoffSeconds
+	}
+	maxBackoff := policy.MaxBackoffSeconds
+	if maxBackoff <= 0 {
+		maxBackoff = types.DefaultTaskRetryMaxBackoffSeconds
+	}
+
+	delay := initial
+	for i := 1; i < attempt && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return time.Duration(min(delay, maxBackoff)) * time.Second
+}
+
+// isRetryable returns true if the reason the execution failed is one of the conditions to retry on. Aborted runs and
+// rejected approvals are never retried.
+func isRetryable(reason v1.ErrorReason, conditions []types.TaskRetryCondition) bool {
+	if reason == v1.ErrorReasonAborted || reason == v1.ErrorReasonRejected {
+		return false
+	}
+	if len(conditions) == 0 {
+		conditions = defaultRetryConditions
+	}
+
+	for _, condition := range conditions {
+		switch condition {
+		case types.TaskRetryConditionAny:
+			return true
+		case types.TaskRetryConditionRateLimit:
+			if reason == v1.ErrorReasonRateLimit {
+				return true
+			}
+		case types.TaskRetryConditionMCPServerLaunch:
+			if reason == v1.ErrorReasonMCPServerLaunch {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package workflowexecution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoff(t *testing.T) {
+	policy := types.TaskRetryPolicy{InitialBackoffSeconds: 10, MaxBackoffSeconds: 60}
+	require.Equal(t, 10*time.Second, backoff(policy, 1))
+	require.Equal(t, 20*time.Second, backoff(policy, 2))
+	require.Equal(t, 40*time.Second, backoff(policy, 3))
+	require.Equal(t, 60*time.Second, backoff(policy, 4))
+	require.Equal(t, 60*time.Second, backoff(policy, 20))
+
+	require.Equal(t, types.DefaultTaskRetryInitialBackoffSeconds*time.Second, backoff(types.TaskRetryPolicy{}, 1))
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name       string
+		reason     v1.ErrorReason
+		conditions []types.TaskRetryCondition
+		want       bool
+	}{
+		{name: "rate limit by default", reason: v1.ErrorReasonRateLimit, want: true},
+		{name: "mcp server launch by default", reason: v1.ErrorReasonMCPServerLaunch, want: true},
+		{name: "other errors not by default"},
+		{name: "any", conditions: []types.TaskRetryCondition{types.TaskRetryConditionAny}, want: true},
+		{name: "only rate limit", reason: v1.ErrorReasonMCPServerLaunch, conditions: []types.TaskRetryCondition{types.TaskRetryConditionRateLimit}},
+		{name: "aborted", reason: v1.ErrorReasonAborted, conditions: []types.TaskRetryCondition{types.TaskRetryConditionAny}},
+		{name: "rejected", reason: v1.ErrorReasonRejected, conditions: []types.TaskRetryCondition{types.TaskRetryConditionAny}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isRetryable(tt.reason, tt.conditions))
+		})
+	}
+}
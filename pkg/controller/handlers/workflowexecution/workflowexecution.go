@@ -2,6 +2,8 @@ package workflowexecution
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/obot-platform/nah/pkg/apply"
@@ -9,6 +11,7 @@ import (
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/controller/handlers/workflowstep"
 	"github.com/obot-platform/obot/pkg/invoke"
+	"github.com/obot-platform/obot/pkg/notification"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
@@ -17,12 +20,14 @@ import (
 )
 
 type Handler struct {
-	invoker *invoke.Invoker
+	invoker  *invoke.Invoker
+	notifier *notification.Sender
 }
 
-func New(invoker *invoke.Invoker) *Handler {
+func New(invoker *invoke.Invoker, notifier *notification.Sender) *Handler {
 	return &Handler{
-		invoker: invoker,
+		invoker:  invoker,
+		notifier: notifier,
 	}
 }
 
@@ -137,12 +142,13 @@ func (h *Handler) Run(req router.Request, _ router.Response) error {
 		lastStepName = newStep.Name
 	}
 
-	if we.Status.WorkflowManifest.Output != "" {
+	if we.Status.WorkflowManifest.Output != "" || len(we.Status.WorkflowManifest.OutputSchema) > 0 {
 		newStep := workflowstep.NewStep(we.Namespace, we.Name, lastStepName, we.Spec.WorkflowGeneration, types.Step{
 			ID:   "output",
-			Step: we.Status.WorkflowManifest.Output,
+			Step: outputPrompt(*we.Status.WorkflowManifest),
 		})
 		steps = append(steps, newStep)
+		lastStepName = newStep.Name
 	}
 
 	_, output, warning, newState, err := workflowstep.GetStateFromSteps(req.Ctx, req.Client, we.Spec.WorkflowGeneration, steps...)
@@ -150,20 +156,69 @@ func (h *Handler) Run(req router.Request, _ router.Response) error {
 		return err
 	}
 
+	var (
+		structuredOutput json.RawMessage
+		outputInvalid    bool
+	)
+	if schema := we.Status.WorkflowManifest.OutputSchema; len(schema) > 0 && newState == types.WorkflowStateComplete {
+		retries := outputRetries(*we.Status.WorkflowManifest)
+
+		// Ask the model again, with the validation error, until the output conforms to the schema.
+		for i := 1; ; i++ {
+			var validationErr error
+			structuredOutput, validationErr = parseStructuredOutput(schema, output)
+			if validationErr == nil {
+				break
+			}
+			if i > retries {
+				newState = types.WorkflowStateError
+				outputInvalid = true
+				output = fmt.Sprintf("output does not conform to the output schema after %d retries: %v", retries, validationErr)
+				break
+			}
+
+			retryStep := workflowstep.NewStep(we.Namespace, we.Name, lastStepName, we.Spec.WorkflowGeneration, types.Step{
+				ID:   fmt.Sprintf("output{retry=%d}", i),
+				Step: outputRetryPrompt(validationErr),
+			})
+			steps = append(steps, retryStep)
+			lastStepName = retryStep.Name
+
+			_, output, warning, newState, err = workflowstep.GetStateFromSteps(req.Ctx, req.Client, we.Spec.WorkflowGeneration, retryStep)
+			if err != nil {
+				return err
+			}
+			if newState != types.WorkflowStateComplete {
+				break
+			}
+		}
+	}
+
 	// Always set the warning if there is one.
 	if warning != "" {
 		we.Status.Warning = warning
 	}
 
+	we.Status.ErrorReason = ""
+	if newState == types.WorkflowStateError && !outputInvalid {
+		if we.Status.ErrorReason, err = workflowstep.GetErrorReason(req.Ctx, req.Client, we.Namespace, we.Name, we.Spec.WorkflowGeneration); err != nil {
+			return err
+		}
+	}
+
 	if newState.IsBlocked() {
 		we.Status.State = newState
 		we.Status.Error = output
 		return apply.New(req.Client).Apply(req.Ctx, req.Object, steps...)
 	}
 
+	// Clear the message left by a step that was blocked, such as one waiting for approval.
+	we.Status.Error = ""
+
 	switch newState {
 	case types.WorkflowStateComplete:
 		we.Status.Output = output
+		we.Status.StructuredOutput = string(structuredOutput)
 	case types.WorkflowStateError:
 		we.Status.Error = output
 	}
@@ -0,0 +1,172 @@
+package workflowstep
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/nah/pkg/untriggered"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (h *Handler) RunApproval(req router.Request, resp router.Response) (err error) {
+	rootStep := req.Object.(*v1.WorkflowStep)
+
+	approval := rootStep.Spec.Step.Approval
+	if approval == nil {
+		return nil
+	}
+
+	var completeResponse bool
+	defer func() {
+		apply := apply.New(req.Client)
+		if !completeResponse {
+			apply.WithNoPrune()
+		}
+		if applyErr := apply.Apply(req.Ctx, req.Object); applyErr != nil && err == nil {
+			err = applyErr
+		}
+	}()
+
+	// reset
+	rootStep.Status.Error = ""
+	rootStep.Status.ErrorReason = ""
+	rootStep.Status.Output = ""
+
+	var wfe v1.WorkflowExecution
+	if err := req.Get(&wfe, rootStep.Namespace, rootStep.Spec.WorkflowExecutionName); err != nil {
+		return err
+	}
+
+	var decision *v1.ApprovalDecision
+	for i, d := range wfe.Spec.ApprovalDecisions {
+		if d.StepID == rootStep.Spec.Step.ID && d.WorkflowGeneration == rootStep.Spec.WorkflowGeneration {
+			decision = &wfe.Spec.ApprovalDecisions[i]
+		}
+	}
+
+	if decision == nil {
+		pending, requested, err := requestApproval(req.Ctx, req.Client, &wfe, rootStep, *approval)
+		if err != nil {
+			return err
+		}
+		if requested {
+			// The request is recorded before notifying, so that a conflict on a later update can't notify twice.
+			if err := h.notifyApprovers(req.Ctx, req.Client, &wfe, pending); err != nil {
+				log.Warnf("failed to notify the approvers of step %s of workflow execution %s: %v", pending.StepID, wfe.Name, err)
+			}
+		}
+
+		if remaining := time.Until(pending.ExpiresAt.Time); remaining > 0 {
+			rootStep.Status.State = types.WorkflowStateBlocked
+			rootStep.Status.Error = "Waiting for approval"
+			if approval.Message != "" {
+				rootStep.Status.Error += ": " + approval.Message
+			}
+			resp.RetryAfter(remaining)
+			return nil
+		}
+
+		if err := removePendingApproval(req.Ctx, req.Client, &wfe, rootStep); err != nil {
+			return err
+		}
+		rootStep.Status.State = types.WorkflowStateError
+		rootStep.Status.Error = fmt.Sprintf("no decision was made within %s", pending.ExpiresAt.Sub(pending.RequestedAt.Time))
+		return nil
+	}
+
+	if err := removePendingApproval(req.Ctx, req.Client, &wfe, rootStep); err != nil {
+		return err
+	}
+
+	if !decision.Approved {
+		rootStep.Status.State = types.WorkflowStateError
+		rootStep.Status.Error = "Rejected by " + decision.UserID
+		rootStep.Status.ErrorReason = v1.ErrorReasonRejected
+		if decision.Comment != "" {
+			rootStep.Status.Error += ": " + decision.Comment
+		}
+		return nil
+	}
+
+	// The decision is recorded as the step's output, and the next step gets it in its prompt.
+	completeResponse = true
+	rootStep.Status.State = types.WorkflowStateComplete
+	rootStep.Status.Output = "Approved by " + decision.UserID
+	if decision.Comment != "" {
+		rootStep.Status.Output += ": " + decision.Comment
+	}
+	return nil
+}
+
+// requestApproval records the pending approval on the workflow execution, where approvers can find it. It returns
+// true if the approval wasn't requested before.
+func requestApproval(ctx context.Context, c kclient.Client, wfe *v1.WorkflowExecution, step *v1.WorkflowStep, approval types.Approval) (v1.PendingApproval, bool, error) {
+	if pending, ok := findPendingApproval(wfe, step); ok {
+		return pending, false, nil
+	}
+
+	timeout := approval.TimeoutMinutes
+	if timeout == 0 {
+		timeout = types.DefaultApprovalTimeoutMinutes
+	}
+
+	now := time.Now()
+	pending := v1.PendingApproval{
+		StepID:             step.Spec.Step.ID,
+		WorkflowGeneration: step.Spec.WorkflowGeneration,
+		Approval:           approval,
+		RequestedAt:        metav1.NewTime(now),
+		ExpiresAt:          metav1.NewTime(now.Add(time.Duration(timeout) * time.Minute)),
+	}
+
+	requested := true
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := c.Get(ctx, router.Key(wfe.Namespace, wfe.Name), untriggered.UncachedGet(wfe)); err != nil {
+			return err
+		}
+		if existing, ok := findPendingApproval(wfe, step); ok {
+			pending, requested = existing, false
+			return nil
+		}
+
+		// Drop the approval requested by an earlier run of this step, if there is one.
+		wfe.Status.PendingApprovals = append(slices.DeleteFunc(wfe.Status.PendingApprovals, func(existing v1.PendingApproval) bool {
+			return existing.StepID == pending.StepID
+		}), pending)
+		return c.Status().Update(ctx, wfe)
+	})
+	return pending, requested && err == nil, err
+}
+
+func removePendingApproval(ctx context.Context, c kclient.Client, wfe *v1.WorkflowExecution, step *v1.WorkflowStep) error {
+	if _, ok := findPendingApproval(wfe, step); !ok {
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := c.Get(ctx, router.Key(wfe.Namespace, wfe.Name), untriggered.UncachedGet(wfe)); err != nil {
+			return err
+		}
+		wfe.Status.PendingApprovals = slices.DeleteFunc(wfe.Status.PendingApprovals, func(pending v1.PendingApproval) bool {
+			return pending.StepID == step.Spec.Step.ID
+		})
+		return c.Status().Update(ctx, wfe)
+	})
+}
+
+func findPendingApproval(wfe *v1.WorkflowExecution, step *v1.WorkflowStep) (v1.PendingApproval, bool) {
+	for _, pending := range wfe.Status.PendingApprovals {
+		if pending.StepID == step.Spec.Step.ID && pending.WorkflowGeneration == step.Spec.WorkflowGeneration {
+			return pending, true
+		}
+	}
+	return v1.PendingApproval{}, false
+}
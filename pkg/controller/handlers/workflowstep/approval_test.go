@@ -0,0 +1,220 @@
+package workflowstep
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type testResponse struct {
+	retryAfter time.Duration
+}
+
+func (r *testResponse) Attributes() map[string]any {
+	return map[string]any{}
+}
+
+func (r *testResponse) RetryAfter(delay time.Duration) {
+	r.retryAfter = delay
+}
+
+func approvalTestStep() *v1.WorkflowStep {
+	return &v1.WorkflowStep{
+		ObjectMeta: metav1.ObjectMeta{Name: "wfs1review", Namespace: system.DefaultNamespace},
+		Spec: v1.WorkflowStepSpec{
+			WorkflowExecutionName: "we1review",
+			WorkflowGeneration:    1,
+			Step: types.Step{
+				ID:       "review",
+				Approval: &types.Approval{Message: "Publish the release notes?", Approvers: []string{"2"}},
+			},
+		},
+	}
+}
+
+func approvalTestExecution() *v1.WorkflowExecution {
+	return &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: "we1review", Namespace: system.DefaultNamespace},
+		Spec:       v1.WorkflowExecutionSpec{WorkflowName: "w1review", WorkflowGeneration: 1},
+	}
+}
+
+func runApproval(t *testing.T, c kclient.WithWatch, step *v1.WorkflowStep) *testResponse {
+	t.Helper()
+
+	resp := &testResponse{}
+	err := (&Handler{}).RunApproval(router.Request{
+		Client:    c,
+		Object:    step,
+		Ctx:       t.Context(),
+		Namespace: step.Namespace,
+		Name:      step.Name,
+	}, resp)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return resp
+}
+
+func getExecution(t *testing.T, c kclient.WithWatch) *v1.WorkflowExecution {
+	t.Helper()
+
+	var wfe v1.WorkflowExecution
+	if err := c.Get(t.Context(), router.Key(system.DefaultNamespace, "we1review"), &wfe); err != nil {
+		t.Fatal(err)
+	}
+	return &wfe
+}
+
+func decide(t *testing.T, c kclient.WithWatch, approved bool, comment string) {
+	t.Helper()
+
+	wfe := getExecution(t, c)
+	wfe.Spec.ApprovalDecisions = append(wfe.Spec.ApprovalDecisions, v1.ApprovalDecision{
+		StepID:             "review",
+		WorkflowGeneration: 1,
+		Approved:           approved,
+		Comment:            comment,
+		UserID:             "2",
+	})
+	if err := c.Update(t.Context(), wfe); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunApprovalApproved(t *testing.T) {
+	c := newTestClient(approvalTestExecution())
+	step := approvalTestStep()
+
+	resp := runApproval(t, c, step)
+	if step.Status.State != types.WorkflowStateBlocked || !strings.Contains(step.Status.Error, "Publish the release notes?") {
+		t.Fatalf("expected the step to wait for approval, got %s: %s", step.Status.State, step.Status.Error)
+	}
+	if resp.retryAfter <= 0 || resp.retryAfter > time.Duration(types.DefaultApprovalTimeoutMinutes)*time.Minute {
+		t.Errorf("expected the step to be checked again when the approval expires, got %s", resp.retryAfter)
+	}
+	if wfe := getExecution(t, c); !wfe.AwaitingApproval() || wfe.Status.PendingApprovals[0].StepID != "review" {
+		t.Fatalf("expected the approval to be pending on the execution, got %+v", wfe.Status.PendingApprovals)
+	}
+
+	decide(t, c, true, "Ship it.")
+
+	runApproval(t, c, step)
+	if step.Status.State != types.WorkflowStateComplete {
+		t.Fatalf("expected the step to complete, got %s: %s", step.Status.State, step.Status.Error)
+	}
+	if step.Status.LastRunName != "" || step.Status.Output != "Approved by 2: Ship it." {
+		t.Errorf("expected the decision to be the step's output without a run, got %+v", step.Status)
+	}
+	if !strings.Contains(stepContext(step), "Ship it.") {
+		t.Errorf("expected the decision to be passed to the next step, got %q", stepContext(step))
+	}
+	if wfe := getExecution(t, c); wfe.AwaitingApproval() {
+		t.Errorf("expected the pending approval to be removed, got %+v", wfe.Status.PendingApprovals)
+	}
+
+	var steps v1.WorkflowStepList
+	if err := c.List(t.Context(), &steps); err != nil {
+		t.Fatal(err)
+	}
+	if len(steps.Items) != 0 {
+		t.Errorf("expected no steps to be added to acknowledge the approval, got %d", len(steps.Items))
+	}
+}
+
+func TestRunApprovalRejected(t *testing.T) {
+	c := newTestClient(approvalTestExecution())
+	step := approvalTestStep()
+
+	runApproval(t, c, step)
+	decide(t, c, false, "The notes are incomplete.")
+
+	runApproval(t, c, step)
+	if step.Status.State != types.WorkflowStateError || step.Status.Error != "Rejected by 2: The notes are incomplete." {
+		t.Errorf("expected the rejection to fail the step, got %s: %s", step.Status.State, step.Status.Error)
+	}
+	if wfe := getExecution(t, c); wfe.AwaitingApproval() {
+		t.Errorf("expected the pending approval to be removed, got %+v", wfe.Status.PendingApprovals)
+	}
+}
+
+func TestRunApprovalTimeout(t *testing.T) {
+	wfe := approvalTestExecution()
+	wfe.Status.PendingApprovals = []v1.PendingApproval{{
+		StepID:             "review",
+		WorkflowGeneration: 1,
+		RequestedAt:        metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+		ExpiresAt:          metav1.NewTime(time.Now().Add(-time.Hour)),
+	}}
+	c := newTestClient(wfe)
+	step := approvalTestStep()
+
+	runApproval(t, c, step)
+	if step.Status.State != types.WorkflowStateError || !strings.Contains(step.Status.Error, "no decision was made within 1h0m0s") {
+		t.Errorf("expected the step to time out, got %s: %s", step.Status.State, step.Status.Error)
+	}
+	if wfe := getExecution(t, c); wfe.AwaitingApproval() {
+		t.Errorf("expected the pending approval to be removed, got %+v", wfe.Status.PendingApprovals)
+	}
+}
+
+func TestApproverIDs(t *testing.T) {
+	c := newTestClient(
+		&v1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "w1review", Namespace: system.DefaultNamespace},
+			Spec:       v1.WorkflowSpec{ThreadName: "t1project"},
+		},
+		&v1.Thread{
+			ObjectMeta: metav1.ObjectMeta{Name: "t1project", Namespace: system.DefaultNamespace},
+			Spec:       v1.ThreadSpec{UserID: "1"},
+		},
+		&v1.ThreadAuthorization{
+			ObjectMeta: metav1.ObjectMeta{Name: "ta1member", Namespace: system.DefaultNamespace},
+			Spec:       v1.ThreadAuthorizationSpec{ThreadAuthorizationManifest: types.ThreadAuthorizationManifest{UserID: "3", ThreadID: "t1project"}},
+		},
+	)
+	usersInGroup := func(_ context.Context, group string) ([]gtypes.User, error) {
+		if group == "reviewers" {
+			return []gtypes.User{{ID: 2}, {ID: 4}}, nil
+		}
+		return nil, nil
+	}
+
+	tests := []struct {
+		name     string
+		approval types.Approval
+		expected []string
+	}{
+		{
+			name:     "approvers and groups",
+			approval: types.Approval{Approvers: []string{"2", "5"}, Groups: []string{"reviewers"}},
+			expected: []string{"2", "4", "5"},
+		},
+		{
+			name:     "project owner and members",
+			expected: []string{"1", "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userIDs, err := approverIDs(t.Context(), c, usersInGroup, approvalTestExecution(), v1.PendingApproval{Approval: tt.approval})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(userIDs, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, userIDs)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package workflowstep
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/logger"
+	gtypes "github.com/obot-platform/obot/pkg/gateway/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+// notifyApprovers queues emails to the users that can decide on the pending approval. Nothing is sent when email
+// isn't configured.
+func (h *Handler) notifyApprovers(ctx context.Context, c kclient.Client, wfe *v1.WorkflowExecution, pending v1.PendingApproval) error {
+	if h.notifier == nil || !h.notifier.EmailEnabled() || h.gatewayClient == nil {
+		return nil
+	}
+
+	userIDs, err := approverIDs(ctx, c, h.gatewayClient.GetUsersInGroup, wfe, pending)
+	if err != nil {
+		return err
+	}
+
+	var to []string
+	for _, userID := range userIDs {
+		user, err := h.gatewayClient.UserByID(ctx, userID)
+		if err != nil {
+			log.Warnf("failed to get approver %s of workflow execution %s: %v", userID, wfe.Name, err)
+			continue
+		}
+		if user.Email != "" {
+			to = append(to, user.Email)
+		}
+	}
+	if len(to) == 0 {
+		return nil
+	}
+
+	task := wfe.Spec.WorkflowName
+	if wfe.Status.WorkflowManifest != nil && wfe.Status.WorkflowManifest.Name != "" {
+		task = wfe.Status.WorkflowManifest.Name
+	}
+
+	subject, message := fmt.Sprintf("Task %q is waiting for your approval", task), approvalMessage(h.serverURL, task, wfe.Name, pending)
+	return h.notifier.Enqueue(func(context.Context) error {
+		return h.notifier.Email(to, subject, message)
+	}, func(err error) {
+		if err != nil {
+			log.Warnf("failed to notify approvers of workflow execution %s: %v", wfe.Name, err)
+		}
+	})
+}
+
+func approvalMessage(serverURL, task, runID string, pending v1.PendingApproval) string {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "Task %q is waiting for your approval.\n", task)
+	if pending.Approval.Message != "" {
+		fmt.Fprintf(&msg, "\n%s\n", pending.Approval.Message)
+	}
+	fmt.Fprintf(&msg, "\nRun: %s\n", runID)
+	fmt.Fprintf(&msg, "Decide before: %s\n", pending.ExpiresAt.UTC().Format("2006-01-02 15:04 MST"))
+	fmt.Fprintf(&msg, "\nTo approve or reject it, post an optional {\"comment\": \"...\"} to:\n")
+	fmt.Fprintf(&msg, "%s/api/task-approvals/%s/steps/%s/approve\n", serverURL, runID, pending.StepID)
+	fmt.Fprintf(&msg, "%s/api/task-approvals/%s/steps/%s/reject\n", serverURL, runID, pending.StepID)
+	return msg.String()
+}
+
+// approverIDs returns the IDs of the users that can decide on the pending approval: the designated approvers and the
+// members of the designated groups, or the project owner and members when there are none.
+func approverIDs(ctx context.Context, c kclient.Client, usersInGroup func(context.Context, string) ([]gtypes.User, error), wfe *v1.WorkflowExecution, pending v1.PendingApproval) ([]string, error) {
+	userIDs := slices.Clone(pending.Approval.Approvers)
+	for _, group := range pending.Approval.Groups {
+		users, err := usersInGroup(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			userIDs = append(userIDs, strconv.FormatUint(uint64(user.ID), 10))
+		}
+	}
+
+	if len(pending.Approval.Approvers) == 0 && len(pending.Approval.Groups) == 0 {
+		var workflow v1.Workflow
+		if err := c.Get(ctx, router.Key(wfe.Namespace, wfe.Spec.WorkflowName), &workflow); err != nil {
+			return nil, kclient.IgnoreNotFound(err)
+		}
+
+		var projectThread v1.Thread
+		if err := c.Get(ctx, router.Key(wfe.Namespace, workflow.Spec.ThreadName), &projectThread); err != nil {
+			return nil, kclient.IgnoreNotFound(err)
+		}
+		if projectThread.Spec.UserID != "" {
+			userIDs = append(userIDs, projectThread.Spec.UserID)
+		}
+
+		var threadAuths v1.ThreadAuthorizationList
+		if err := c.List(ctx, &threadAuths, kclient.InNamespace(wfe.Namespace), kclient.MatchingFields{
+			"spec.threadID": projectThread.Name,
+		}); err != nil {
+			return nil, err
+		}
+		for _, auth := range threadAuths.Items {
+			userIDs = append(userIDs, auth.Spec.UserID)
+		}
+	}
+
+	slices.Sort(userIDs)
+	return slices.Compact(userIDs), nil
+}
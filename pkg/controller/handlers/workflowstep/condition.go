@@ -0,0 +1,191 @@
+package workflowstep
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type conditionResult struct {
+	// step evaluates a model-judged condition. It is nil for comparisons.
+	step *v1.WorkflowStep
+	// lastStepName is the step that the steps following the condition run after.
+	lastStepName string
+	// lastRunName is the last run before the steps following the condition.
+	lastRunName string
+	state       types.WorkflowState
+	errMsg      string
+	warning     string
+	value       bool
+}
+
+// evaluateCondition evaluates a condition that follows the given step. Model-judged conditions are evaluated by a
+// separate workflow step, which is returned so that it can be applied. The value is only set when the state is complete.
+func evaluateCondition(ctx context.Context, c kclient.Client, rootStep *v1.WorkflowStep, id, afterStepName, condition string, compare *types.Comparison) (conditionResult, error) {
+	if compare != nil {
+		if afterStepName == "" {
+			return conditionResult{
+				state:  types.WorkflowStateError,
+				errMsg: "a comparison requires a previous step",
+			}, nil
+		}
+
+		var afterStep v1.WorkflowStep
+		if err := c.Get(ctx, router.Key(rootStep.Namespace, afterStepName), &afterStep); err != nil {
+			return conditionResult{}, err
+		}
+
+		// Steps that complete without running the agent, like sub-workflows and approvals, have their output on the step.
+		output, lastRunName := afterStep.Status.Output, afterStep.Status.LastRunName
+		if completesWithoutRun(&afterStep) {
+			var err error
+			if lastRunName, _, err = resolveLastRun(ctx, c, &afterStep); err != nil {
+				return conditionResult{}, err
+			}
+		} else {
+			var run v1.Run
+			if err := c.Get(ctx, router.Key(rootStep.Namespace, lastRunName), &run); err != nil {
+				return conditionResult{}, err
+			}
+			output = run.Status.Output
+		}
+
+		value, err := compareOutput(*compare, output)
+		if err != nil {
+			return conditionResult{
+				state:  types.WorkflowStateError,
+				errMsg: err.Error(),
+			}, nil
+		}
+
+		return conditionResult{
+			lastStepName: afterStepName,
+			lastRunName:  lastRunName,
+			state:        types.WorkflowStateComplete,
+			value:        value,
+		}, nil
+	}
+
+	conditionStep := NewStep(rootStep.Namespace, rootStep.Spec.WorkflowExecutionName, afterStepName, rootStep.Spec.WorkflowGeneration, types.Step{
+		ID:   id,
+		Step: conditionPrompt(condition),
+	})
+
+	runName, output, warning, state, err := GetStateFromSteps(ctx, c, rootStep.Spec.WorkflowGeneration, conditionStep)
+	if err != nil {
+		return conditionResult{}, err
+	}
+
+	result := conditionResult{
+		step:         conditionStep,
+		lastStepName: conditionStep.Name,
+		lastRunName:  runName,
+		state:        state,
+		warning:      warning,
+	}
+	if state.IsBlocked() {
+		// The output is the error message when the state is blocked.
+		result.errMsg = output
+		return result, nil
+	}
+	if state != types.WorkflowStateComplete {
+		return result, nil
+	}
+
+	result.value, err = parseConditionOutput(output)
+	if err != nil {
+		result.state = types.WorkflowStateError
+		result.errMsg = err.Error()
+	}
+
+	return result, nil
+}
+
+func conditionPrompt(condition string) string {
+	return fmt.Sprintf(`
+	Based on the conversation so far, decide whether the following condition is true:
+	%q
+
+	Respond with only the word "true" or the word "false".
+	`, condition)
+}
+
+// parseConditionOutput reads the model's answer to a condition, tolerating surrounding quotes and punctuation.
+func parseConditionOutput(output string) (bool, error) {
+	answer := strings.ToLower(strings.Trim(strings.TrimSpace(output), "\"'`*.!"))
+	switch {
+	case strings.HasPrefix(answer, "true"):
+		return true, nil
+	case strings.HasPrefix(answer, "false"):
+		return false, nil
+	}
+	return false, fmt.Errorf("condition was evaluated to %q instead of true or false", output)
+}
+
+// compareOutput deterministically checks the output of a step.
+func compareOutput(comparison types.Comparison, output string) (bool, error) {
+	output = strings.TrimSpace(output)
+
+	switch comparison.Operator {
+	case types.ComparisonOperatorEquals:
+		return output == comparison.Value, nil
+	case types.ComparisonOperatorNotEquals:
+		return output != comparison.Value, nil
+	case types.ComparisonOperatorContains:
+		return strings.Contains(output, comparison.Value), nil
+	case types.ComparisonOperatorNotContains:
+		return !strings.Contains(output, comparison.Value), nil
+	case types.ComparisonOperatorMatches:
+		re, err := regexp.Compile(comparison.Value)
+		if err != nil {
+			return false, fmt.Errorf("invalid regular expression %q: %w", comparison.Value, err)
+		}
+		return re.MatchString(output), nil
+	case types.ComparisonOperatorGreaterThan, types.ComparisonOperatorLessThan:
+		value, err := strconv.ParseFloat(comparison.Value, 64)
+		if err != nil {
+			return false, fmt.Errorf("comparison value %q is not a number", comparison.Value)
+		}
+		number, err := strconv.ParseFloat(output, 64)
+		if err != nil {
+			return false, fmt.Errorf("output of the previous step %q is not a number", output)
+		}
+		if comparison.Operator == types.ComparisonOperatorGreaterThan {
+			return number > value, nil
+		}
+		return number < value, nil
+	}
+
+	return false, fmt.Errorf("unknown comparison operator %q", comparison.Operator)
+}
+
+// nestedStepID returns the ID of a step nested in the given parent. The parent's suffix is kept so that
+// nested steps are unique when the parent is itself part of a loop.
+func nestedStepID(parentID, stepID, suffix string) string {
+	_, parentSuffix, _ := strings.Cut(parentID, "{")
+	if parentSuffix != "" {
+		parentSuffix = "{" + parentSuffix
+	}
+	return stepID + parentSuffix + suffix
+}
+
+// defineNestedSteps chains the nested steps after the given step.
+func defineNestedSteps(rootStep *v1.WorkflowStep, afterStepName, suffix string, steps []types.Step) []kclient.Object {
+	result := make([]kclient.Object, 0, len(steps))
+	for _, step := range steps {
+		step = *step.DeepCopy()
+		step.ID = nestedStepID(rootStep.Spec.Step.ID, step.ID, suffix)
+
+		newStep := NewStep(rootStep.Namespace, rootStep.Spec.WorkflowExecutionName, afterStepName, rootStep.Spec.WorkflowGeneration, step)
+		result = append(result, newStep)
+		afterStepName = newStep.Name
+	}
+	return result
+}
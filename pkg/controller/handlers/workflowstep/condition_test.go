@@ -0,0 +1,92 @@
+package workflowstep
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseConditionOutput(t *testing.T) {
+	tests := []struct {
+		output   string
+		expected bool
+		err      bool
+	}{
+		{output: "true", expected: true},
+		{output: " **False**.\n", expected: false},
+		{output: `"TRUE"`, expected: true},
+		{output: "maybe", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.output, func(t *testing.T) {
+			value, err := parseConditionOutput(tt.output)
+			if tt.err != (err != nil) {
+				t.Fatalf("expected error=%v, got %v", tt.err, err)
+			}
+			if value != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, value)
+			}
+		})
+	}
+}
+
+func TestCompareOutput(t *testing.T) {
+	tests := []struct {
+		name       string
+		comparison types.Comparison
+		output     string
+		expected   bool
+		err        bool
+	}{
+		{name: "equals", comparison: types.Comparison{Operator: types.ComparisonOperatorEquals, Value: "done"}, output: "done\n", expected: true},
+		{name: "not contains", comparison: types.Comparison{Operator: types.ComparisonOperatorNotContains, Value: "error"}, output: "all good", expected: true},
+		{name: "matches", comparison: types.Comparison{Operator: types.ComparisonOperatorMatches, Value: `^\d+ issues$`}, output: "12 issues", expected: true},
+		{name: "greater than", comparison: types.Comparison{Operator: types.ComparisonOperatorGreaterThan, Value: "10"}, output: "9.5", expected: false},
+		{name: "non-numeric output", comparison: types.Comparison{Operator: types.ComparisonOperatorLessThan, Value: "10"}, output: "nine", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := compareOutput(tt.comparison, tt.output)
+			if tt.err != (err != nil) {
+				t.Fatalf("expected error=%v, got %v", tt.err, err)
+			}
+			if value != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, value)
+			}
+		})
+	}
+}
+
+func TestEvaluateComparisonAfterSubWorkflow(t *testing.T) {
+	first := &v1.WorkflowStep{
+		ObjectMeta: metav1.ObjectMeta{Name: "wfs1first", Namespace: system.DefaultNamespace},
+		Spec:       v1.WorkflowStepSpec{Step: types.Step{ID: "first", Step: "List the open issues"}},
+		Status:     v1.WorkflowStepStatus{State: types.WorkflowStateComplete, LastRunName: "r1first"},
+	}
+	triage := &v1.WorkflowStep{
+		ObjectMeta: metav1.ObjectMeta{Name: "wfs1triage", Namespace: system.DefaultNamespace},
+		Spec: v1.WorkflowStepSpec{
+			AfterWorkflowStepName: first.Name,
+			Step:                  types.Step{ID: "triage", SubWorkflow: &types.SubWorkflow{WorkflowID: "triage"}},
+		},
+		Status: v1.WorkflowStepStatus{State: types.WorkflowStateComplete, Output: "3 bugs"},
+	}
+	rootStep := &v1.WorkflowStep{ObjectMeta: metav1.ObjectMeta{Name: "wfs1check", Namespace: system.DefaultNamespace}}
+
+	result, err := evaluateCondition(t.Context(), newTestClient(first, triage), rootStep, "check", triage.Name, "",
+		&types.Comparison{Operator: types.ComparisonOperatorContains, Value: "bugs"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.state != types.WorkflowStateComplete || !result.value {
+		t.Fatalf("expected the comparison with the sub-workflow's output to be true, got %+v", result)
+	}
+	if result.lastRunName != "r1first" {
+		t.Errorf("expected the steps after the comparison to continue from r1first, got %q", result.lastRunName)
+	}
+}
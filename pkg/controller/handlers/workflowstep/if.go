@@ -0,0 +1,99 @@
+package workflowstep
+
+import (
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (h *Handler) RunIf(req router.Request, _ router.Response) (err error) {
+	rootStep := req.Object.(*v1.WorkflowStep)
+
+	if rootStep.Spec.Step.If == nil {
+		return nil
+	}
+
+	var (
+		completeResponse bool
+		objects          []kclient.Object
+	)
+	defer func() {
+		apply := apply.New(req.Client)
+		if !completeResponse {
+			apply.WithNoPrune()
+		}
+		if applyErr := apply.Apply(req.Ctx, req.Object, objects...); applyErr != nil && err == nil {
+			err = applyErr
+		}
+	}()
+
+	// reset
+	rootStep.Status.Error = ""
+
+	ifStep := rootStep.Spec.Step.If
+	condition, err := evaluateCondition(req.Ctx, req.Client, rootStep, rootStep.Spec.Step.ID+"{condition}",
+		rootStep.Spec.AfterWorkflowStepName, ifStep.Condition, ifStep.Compare)
+	if err != nil {
+		return err
+	}
+	if condition.step != nil {
+		objects = append(objects, condition.step)
+	}
+
+	if condition.warning != "" && rootStep.Status.RunMessage == "" {
+		rootStep.Status.RunMessage = condition.warning
+	}
+
+	if condition.state.IsBlocked() {
+		rootStep.Status.State = condition.state
+		rootStep.Status.Error = condition.errMsg
+		return nil
+	}
+
+	if condition.state != types.WorkflowStateComplete {
+		rootStep.Status.State = condition.state
+		return nil
+	}
+
+	branch, suffix := ifStep.Steps, "{then}"
+	if !condition.value {
+		branch, suffix = ifStep.Else, "{else}"
+	}
+
+	steps := defineNestedSteps(rootStep, condition.lastStepName, suffix, branch)
+	objects = append(objects, steps...)
+
+	runName := condition.lastRunName
+	if len(steps) > 0 {
+		var (
+			errMsg, warning string
+			newState        types.WorkflowState
+		)
+		runName, errMsg, warning, newState, err = GetStateFromSteps(req.Ctx, req.Client, rootStep.Spec.WorkflowGeneration, steps...)
+		if err != nil {
+			return err
+		}
+
+		if warning != "" && rootStep.Status.RunMessage == "" {
+			rootStep.Status.RunMessage = warning
+		}
+
+		if newState.IsBlocked() {
+			rootStep.Status.State = newState
+			rootStep.Status.Error = errMsg
+			return nil
+		}
+
+		if newState != types.WorkflowStateComplete {
+			rootStep.Status.State = newState
+			return nil
+		}
+	}
+
+	completeResponse = true
+	rootStep.Status.State = types.WorkflowStateComplete
+	rootStep.Status.LastRunName = runName
+	return nil
+}
@@ -15,10 +15,11 @@ func (h *Handler) RunInvoke(req router.Request, _ router.Response) error {
 		client      = req.Client
 		step        = req.Object.(*v1.WorkflowStep)
 		lastRunName string
+		outputs     []string
 	)
 
-	if step.Spec.Step.Loop != nil {
-		// This will get picked up by the loop handler.
+	if step.Spec.Step.Loop != nil || step.Spec.Step.If != nil || step.Spec.Step.While != nil || step.Spec.Step.SubWorkflow != nil || step.Spec.Step.Approval != nil {
+		// This will get picked up by the loop, if, while, sub-workflow, or approval handler.
 		return nil
 	}
 
@@ -27,7 +28,10 @@ func (h *Handler) RunInvoke(req router.Request, _ router.Response) error {
 		if err := client.Get(ctx, router.Key(step.Namespace, step.Spec.AfterWorkflowStepName), &previousStep); err != nil {
 			return err
 		}
-		lastRunName = previousStep.Status.LastRunName
+		var err error
+		if lastRunName, outputs, err = resolveLastRun(ctx, client, &previousStep); err != nil {
+			return err
+		}
 	}
 
 	var run v1.Run
@@ -35,6 +39,7 @@ func (h *Handler) RunInvoke(req router.Request, _ router.Response) error {
 		invokeResp, err := h.invoker.Step(ctx, h.mcpSessionManager, h.gptscriptClient, req.Client, step, invoke.StepOptions{
 			PreviousRunName: lastRunName,
 			IgnoreMCPErrors: true,
+			Context:         outputs,
 		})
 		if err != nil {
 			return err
@@ -72,6 +77,7 @@ func (h *Handler) setStepStateFromRun(step *v1.WorkflowStep, run *v1.Run) {
 		step.Status.State = types.WorkflowStateError
 		step.Status.LastRunName = step.Status.RunNames[0]
 		step.Status.Error = "Aborted"
+		step.Status.ErrorReason = v1.ErrorReasonAborted
 		if run.Status.Output != "" {
 			step.Status.Error += ": " + run.Status.Output
 		}
@@ -79,9 +85,11 @@ func (h *Handler) setStepStateFromRun(step *v1.WorkflowStep, run *v1.Run) {
 		step.Status.State = types.WorkflowStateComplete
 		step.Status.LastRunName = step.Status.RunNames[0]
 		step.Status.Error = ""
+		step.Status.ErrorReason = ""
 	case v1.Error:
 		step.Status.State = types.WorkflowStateError
 		step.Status.LastRunName = step.Status.RunNames[0]
 		step.Status.Error = run.Status.Error
+		step.Status.ErrorReason = run.Status.ErrorReason
 	}
 }
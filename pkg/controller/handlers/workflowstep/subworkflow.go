@@ -0,0 +1,325 @@
+package workflowstep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/nah/pkg/untriggered"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/hash"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var inputReferenceRegex = regexp.MustCompile(`\$\{([^}]+)}`)
+
+func (h *Handler) RunSubWorkflow(req router.Request, _ router.Response) (err error) {
+	rootStep := req.Object.(*v1.WorkflowStep)
+
+	subWorkflow := rootStep.Spec.Step.SubWorkflow
+	if subWorkflow == nil {
+		return nil
+	}
+
+	var (
+		completeResponse bool
+		objects          []kclient.Object
+	)
+	defer func() {
+		apply := apply.New(req.Client)
+		if !completeResponse {
+			apply.WithNoPrune()
+		}
+		if applyErr := apply.Apply(req.Ctx, req.Object, objects...); applyErr != nil && err == nil {
+			err = applyErr
+		}
+	}()
+
+	// reset
+	rootStep.Status.Error = ""
+	rootStep.Status.ErrorReason = ""
+	rootStep.Status.Output = ""
+
+	var parent v1.WorkflowExecution
+	if err := req.Get(&parent, rootStep.Namespace, rootStep.Spec.WorkflowExecutionName); err != nil {
+		return err
+	}
+
+	wf, err := findSubWorkflow(req, &parent, subWorkflow.WorkflowID)
+	if err != nil {
+		return err
+	}
+	if wf == nil {
+		rootStep.Status.State = types.WorkflowStateError
+		rootStep.Status.Error = fmt.Sprintf("workflow %s not found", subWorkflow.WorkflowID)
+		return nil
+	}
+
+	breadCrumb := parent.Spec.WorkflowName
+	if parent.Spec.TaskBreakCrumb != "" {
+		breadCrumb = parent.Spec.TaskBreakCrumb + "," + breadCrumb
+	}
+	if slices.Contains(strings.Split(breadCrumb, ","), wf.Name) {
+		rootStep.Status.State = types.WorkflowStateError
+		rootStep.Status.Error = fmt.Sprintf("workflow %s would call itself", subWorkflow.WorkflowID)
+		return nil
+	}
+
+	input, err := subWorkflowInput(req.Ctx, req.Client, &parent, subWorkflow.Input)
+	if err != nil {
+		rootStep.Status.State = types.WorkflowStateError
+		rootStep.Status.Error = err.Error()
+		return nil
+	}
+
+	child := &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			// The name changes when the step is rerun or its input changes, so that a new execution is started.
+			Name:      system.WorkflowExecutionPrefix + hash.String([]any{rootStep.Name, rootStep.Spec.WorkflowGeneration, input})[:12],
+			Namespace: rootStep.Namespace,
+		},
+		Spec: v1.WorkflowExecutionSpec{
+			Input:                       input,
+			ThreadName:                  wf.Spec.ThreadName,
+			WorkflowName:                wf.Name,
+			TaskBreakCrumb:              breadCrumb,
+			ParentWorkflowExecutionName: parent.Name,
+		},
+	}
+	objects = append(objects, child)
+
+	if err := recordSubWorkflowExecution(req.Ctx, req.Client, &parent, rootStep.Spec.Step.ID, child); err != nil {
+		return err
+	}
+
+	var current v1.WorkflowExecution
+	if err := req.Get(&current, child.Namespace, child.Name); apierrors.IsNotFound(err) {
+		rootStep.Status.State = types.WorkflowStateRunning
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if aborted, err := propagateAbort(req, &parent, &current); err != nil {
+		return err
+	} else if aborted {
+		rootStep.Status.State = types.WorkflowStateError
+		rootStep.Status.Error = "Aborted"
+		rootStep.Status.ErrorReason = v1.ErrorReasonAborted
+		return nil
+	}
+
+	switch current.Status.State {
+	case types.WorkflowStateError:
+		rootStep.Status.State = types.WorkflowStateError
+		rootStep.Status.Error = fmt.Sprintf("workflow %s failed: %s", subWorkflow.WorkflowID, current.Status.Error)
+		rootStep.Status.ErrorReason = current.Status.ErrorReason
+		return nil
+	case types.WorkflowStateComplete:
+	default:
+		rootStep.Status.State = types.WorkflowStateRunning
+		return nil
+	}
+
+	// The output is recorded as is, and later steps get it with their prompts.
+	completeResponse = true
+	rootStep.Status.State = types.WorkflowStateComplete
+	rootStep.Status.Output = current.Status.Output
+	return nil
+}
+
+// findSubWorkflow looks up a workflow by ID, alias, or name. Only workflows in the same project as the parent are returned.
+func findSubWorkflow(req router.Request, parent *v1.WorkflowExecution, id string) (*v1.Workflow, error) {
+	var parentWorkflow v1.Workflow
+	if err := req.Get(&parentWorkflow, parent.Namespace, parent.Spec.WorkflowName); err != nil {
+		return nil, err
+	}
+
+	var wf v1.Workflow
+	if err := req.Get(&wf, parent.Namespace, id); err == nil {
+		if wf.Spec.ThreadName == parentWorkflow.Spec.ThreadName {
+			return &wf, nil
+		}
+		return nil, nil
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	var workflows v1.WorkflowList
+	if err := req.List(&workflows, &kclient.ListOptions{
+		Namespace: parent.Namespace,
+		FieldSelector: fields.SelectorFromSet(map[string]string{
+			"spec.threadName": parentWorkflow.Spec.ThreadName,
+		}),
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, wf := range workflows.Items {
+		if wf.Spec.Manifest.Alias == id || wf.Spec.Manifest.Name == id {
+			return &wf, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// subWorkflowInput builds the JSON input of the other workflow from the input mapping.
+func subWorkflowInput(ctx context.Context, c kclient.Client, parent *v1.WorkflowExecution, mapping map[string]string) (string, error) {
+	if len(mapping) == 0 {
+		return "", nil
+	}
+
+	var params map[string]any
+	if parent.Spec.Input != "" {
+		// The input doesn't have to be JSON, in which case there are no parameters to reference.
+		_ = json.Unmarshal([]byte(parent.Spec.Input), &params)
+	}
+
+	var steps []v1.WorkflowStep
+	lookup := func(ref string) (string, error) {
+		if param, ok := strings.CutPrefix(ref, "input."); ok {
+			value, ok := params[param]
+			if !ok {
+				return "", fmt.Errorf("input parameter %s is not set", param)
+			}
+			if s, ok := value.(string); ok {
+				return s, nil
+			}
+			data, err := json.Marshal(value)
+			return string(data), err
+		}
+
+		if stepID, ok := strings.CutPrefix(ref, "steps."); ok {
+			if stepID, ok = strings.CutSuffix(stepID, ".output"); ok {
+				if steps == nil {
+					var list v1.WorkflowStepList
+					if err := c.List(ctx, &list, kclient.InNamespace(parent.Namespace), kclient.MatchingFields{
+						"spec.workflowExecutionName": parent.Name,
+					}); err != nil {
+						return "", err
+					}
+					steps = list.Items
+				}
+				return stepOutput(ctx, c, parent, steps, stepID)
+			}
+		}
+
+		return "", fmt.Errorf("unknown reference ${%s}", ref)
+	}
+
+	input := make(map[string]string, len(mapping))
+	for key, value := range mapping {
+		resolved, err := resolveInputReferences(value, lookup)
+		if err != nil {
+			return "", fmt.Errorf("invalid input %s: %w", key, err)
+		}
+		input[key] = resolved
+	}
+
+	data, err := json.Marshal(input)
+	return string(data), err
+}
+
+func resolveInputReferences(value string, lookup func(ref string) (string, error)) (string, error) {
+	var lookupErr error
+	result := inputReferenceRegex.ReplaceAllStringFunc(value, func(match string) string {
+		if lookupErr != nil {
+			return match
+		}
+		resolved, err := lookup(inputReferenceRegex.FindStringSubmatch(match)[1])
+		if err != nil {
+			lookupErr = err
+			return match
+		}
+		return resolved
+	})
+	return result, lookupErr
+}
+
+func stepOutput(ctx context.Context, c kclient.Client, parent *v1.WorkflowExecution, steps []v1.WorkflowStep, stepID string) (string, error) {
+	for _, step := range steps {
+		if step.Spec.Step.ID != stepID || step.Spec.WorkflowGeneration != parent.Spec.WorkflowGeneration {
+			continue
+		}
+		if step.Status.State != types.WorkflowStateComplete {
+			break
+		}
+		if completesWithoutRun(&step) {
+			return step.Status.Output, nil
+		}
+		if step.Status.LastRunName == "" {
+			break
+		}
+
+		var run v1.Run
+		if err := c.Get(ctx, router.Key(step.Namespace, step.Status.LastRunName), &run); err != nil {
+			return "", err
+		}
+		return run.Status.Output, nil
+	}
+	return "", fmt.Errorf("step %s has no output", stepID)
+}
+
+// recordSubWorkflowExecution adds the child execution to the parent so that it can be found from the API.
+func recordSubWorkflowExecution(ctx context.Context, c kclient.Client, parent *v1.WorkflowExecution, stepID string, child *v1.WorkflowExecution) error {
+	subWorkflowExecution := v1.SubWorkflowExecution{
+		StepID:                stepID,
+		WorkflowName:          child.Spec.WorkflowName,
+		WorkflowExecutionName: child.Name,
+	}
+	if slices.Contains(parent.Status.SubWorkflowExecutions, subWorkflowExecution) {
+		return nil
+	}
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := c.Get(ctx, router.Key(parent.Namespace, parent.Name), untriggered.UncachedGet(parent)); err != nil {
+			return err
+		}
+		if slices.Contains(parent.Status.SubWorkflowExecutions, subWorkflowExecution) {
+			return nil
+		}
+		parent.Status.SubWorkflowExecutions = append(parent.Status.SubWorkflowExecutions, subWorkflowExecution)
+		return c.Status().Update(ctx, parent)
+	})
+}
+
+// propagateAbort aborts the child's thread if the parent's thread was aborted.
+func propagateAbort(req router.Request, parent, child *v1.WorkflowExecution) (bool, error) {
+	if parent.Status.ThreadName == "" {
+		return false, nil
+	}
+
+	var parentThread v1.Thread
+	if err := req.Get(&parentThread, parent.Namespace, parent.Status.ThreadName); err != nil {
+		return false, kclient.IgnoreNotFound(err)
+	}
+	if !parentThread.Spec.Abort {
+		return false, nil
+	}
+
+	if child.Status.ThreadName != "" {
+		var childThread v1.Thread
+		if err := req.Get(&childThread, child.Namespace, child.Status.ThreadName); kclient.IgnoreNotFound(err) != nil {
+			return false, err
+		} else if err == nil && !childThread.Spec.Abort {
+			childThread.Spec.Abort = true
+			if err := req.Client.Update(req.Ctx, &childThread); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,309 @@
+package workflowstep
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/nah/pkg/untriggered"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	"github.com/obot-platform/obot/pkg/system"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestResolveInputReferences(t *testing.T) {
+	values := map[string]string{
+		"input.repo":          "obot",
+		"steps.search.output": "3 issues",
+	}
+	lookup := func(ref string) (string, error) {
+		if value, ok := values[ref]; ok {
+			return value, nil
+		}
+		return "", fmt.Errorf("unknown reference ${%s}", ref)
+	}
+
+	tests := []struct {
+		value    string
+		expected string
+		err      bool
+	}{
+		{value: "literal", expected: "literal"},
+		{value: "${input.repo} has ${steps.search.output}", expected: "obot has 3 issues"},
+		{value: "${steps.missing.output}", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			result, err := resolveInputReferences(tt.value, lookup)
+			if tt.err != (err != nil) {
+				t.Fatalf("expected error=%v, got %v", tt.err, err)
+			}
+			if !tt.err && result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+// newTestClient returns a fake client with the objects. Uncached reads are served by the fake client like any other.
+func newTestClient(objs ...kclient.Object) kclient.WithWatch {
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{v1.SchemeGroupVersion})
+	for kind := range scheme.Scheme.KnownTypes(v1.SchemeGroupVersion) {
+		mapper.Add(v1.SchemeGroupVersion.WithKind(kind), meta.RESTScopeNamespace)
+	}
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithRESTMapper(mapper).
+		WithObjects(objs...).
+		WithStatusSubresource(&v1.WorkflowExecution{}, &v1.WorkflowStep{}).
+		WithIndex(&v1.WorkflowExecution{}, "spec.parentWorkflowExecutionName", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.WorkflowExecution).Spec.ParentWorkflowExecutionName}
+		}).
+		WithIndex(&v1.Workflow{}, "spec.threadName", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.Workflow).Spec.ThreadName}
+		}).
+		WithIndex(&v1.ThreadAuthorization{}, "spec.threadID", func(obj kclient.Object) []string {
+			return []string{obj.(*v1.ThreadAuthorization).Spec.ThreadID}
+		}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c kclient.WithWatch, key kclient.ObjectKey, obj kclient.Object, opts ...kclient.GetOption) error {
+				if holder, ok := obj.(*untriggered.Holder); ok {
+					obj = holder.Object
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+}
+
+func subWorkflowTestObjects(parentWorkflow, breadCrumb string) []kclient.Object {
+	return []kclient.Object{
+		&v1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "w1parent", Namespace: system.DefaultNamespace},
+			Spec:       v1.WorkflowSpec{ThreadName: "t1project", Manifest: types.WorkflowManifest{Name: "parent"}},
+		},
+		&v1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "w1child", Namespace: system.DefaultNamespace},
+			Spec:       v1.WorkflowSpec{ThreadName: "t1project", Manifest: types.WorkflowManifest{Name: "summarize"}},
+		},
+		&v1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "w1other", Namespace: system.DefaultNamespace},
+			Spec:       v1.WorkflowSpec{ThreadName: "t1otherproject", Manifest: types.WorkflowManifest{Name: "elsewhere"}},
+		},
+		&v1.WorkflowExecution{
+			ObjectMeta: metav1.ObjectMeta{Name: "we1parent", Namespace: system.DefaultNamespace},
+			Spec: v1.WorkflowExecutionSpec{
+				WorkflowName:   parentWorkflow,
+				TaskBreakCrumb: breadCrumb,
+				Input:          `{"topic":"release notes"}`,
+			},
+		},
+	}
+}
+
+func subWorkflowTestStep(workflowID string) *v1.WorkflowStep {
+	return &v1.WorkflowStep{
+		ObjectMeta: metav1.ObjectMeta{Name: "wfs1summarize", Namespace: system.DefaultNamespace},
+		Spec: v1.WorkflowStepSpec{
+			WorkflowExecutionName: "we1parent",
+			Step: types.Step{
+				ID: "summarize",
+				SubWorkflow: &types.SubWorkflow{
+					WorkflowID: workflowID,
+					Input:      map[string]string{"subject": "${input.topic}"},
+				},
+			},
+		},
+	}
+}
+
+func runSubWorkflow(t *testing.T, c kclient.WithWatch, step *v1.WorkflowStep) {
+	t.Helper()
+
+	err := (&Handler{}).RunSubWorkflow(router.Request{
+		Client:    c,
+		Object:    step,
+		Ctx:       t.Context(),
+		Namespace: step.Namespace,
+		Name:      step.Name,
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func childExecution(t *testing.T, c kclient.WithWatch) v1.WorkflowExecution {
+	t.Helper()
+
+	var executions v1.WorkflowExecutionList
+	if err := c.List(t.Context(), &executions, kclient.MatchingFields{"spec.parentWorkflowExecutionName": "we1parent"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(executions.Items) != 1 {
+		t.Fatalf("expected 1 child execution, got %d", len(executions.Items))
+	}
+	return executions.Items[0]
+}
+
+func TestRunSubWorkflow(t *testing.T) {
+	c := newTestClient(subWorkflowTestObjects("w1parent", "")...)
+	step := subWorkflowTestStep("summarize")
+
+	runSubWorkflow(t, c, step)
+	if step.Status.State != types.WorkflowStateRunning {
+		t.Fatalf("expected the step to wait for the child execution, got %s: %s", step.Status.State, step.Status.Error)
+	}
+
+	child := childExecution(t, c)
+	if child.Spec.WorkflowName != "w1child" || child.Spec.Input != `{"subject":"release notes"}` || child.Spec.TaskBreakCrumb != "w1parent" {
+		t.Errorf("unexpected child execution: %+v", child.Spec)
+	}
+
+	var parent v1.WorkflowExecution
+	if err := c.Get(t.Context(), router.Key(system.DefaultNamespace, "we1parent"), &parent); err != nil {
+		t.Fatal(err)
+	}
+	if len(parent.Status.SubWorkflowExecutions) != 1 || parent.Status.SubWorkflowExecutions[0].WorkflowExecutionName != child.Name {
+		t.Errorf("expected the child execution to be recorded on the parent, got %+v", parent.Status.SubWorkflowExecutions)
+	}
+
+	child.Status.State = types.WorkflowStateComplete
+	child.Status.Output = "Three bugs were fixed."
+	if err := c.Status().Update(t.Context(), &child); err != nil {
+		t.Fatal(err)
+	}
+
+	runSubWorkflow(t, c, step)
+	if step.Status.State != types.WorkflowStateComplete {
+		t.Fatalf("expected the step to complete, got %s: %s", step.Status.State, step.Status.Error)
+	}
+	if step.Status.Output != child.Status.Output || step.Status.LastRunName != "" {
+		t.Errorf("expected the child output to be the step's output without a run, got %+v", step.Status)
+	}
+
+	var steps v1.WorkflowStepList
+	if err := c.List(t.Context(), &steps); err != nil {
+		t.Fatal(err)
+	}
+	if len(steps.Items) != 0 {
+		t.Errorf("expected no steps to be added to relay the output, got %d", len(steps.Items))
+	}
+}
+
+func TestRunSubWorkflowFailure(t *testing.T) {
+	c := newTestClient(subWorkflowTestObjects("w1parent", "")...)
+	step := subWorkflowTestStep("summarize")
+
+	runSubWorkflow(t, c, step)
+
+	child := childExecution(t, c)
+	child.Status.State = types.WorkflowStateError
+	child.Status.Error = "model unavailable"
+	if err := c.Status().Update(t.Context(), &child); err != nil {
+		t.Fatal(err)
+	}
+
+	runSubWorkflow(t, c, step)
+	if step.Status.State != types.WorkflowStateError || !strings.Contains(step.Status.Error, "model unavailable") {
+		t.Errorf("expected the child's error to fail the step, got %s: %s", step.Status.State, step.Status.Error)
+	}
+}
+
+func TestRunSubWorkflowRejected(t *testing.T) {
+	tests := []struct {
+		name           string
+		parentWorkflow string
+		breadCrumb     string
+		workflowID     string
+		expectedError  string
+	}{
+		{
+			name:           "workflow calls itself",
+			parentWorkflow: "w1child",
+			workflowID:     "summarize",
+			expectedError:  "would call itself",
+		},
+		{
+			name:           "workflow calls one of its callers",
+			parentWorkflow: "w1parent",
+			breadCrumb:     "w1child",
+			workflowID:     "summarize",
+			expectedError:  "would call itself",
+		},
+		{
+			name:           "workflow of another project",
+			parentWorkflow: "w1parent",
+			workflowID:     "w1other",
+			expectedError:  "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(subWorkflowTestObjects(tt.parentWorkflow, tt.breadCrumb)...)
+			step := subWorkflowTestStep(tt.workflowID)
+
+			runSubWorkflow(t, c, step)
+			if step.Status.State != types.WorkflowStateError || !strings.Contains(step.Status.Error, tt.expectedError) {
+				t.Errorf("expected the step to fail with %q, got %s: %s", tt.expectedError, step.Status.State, step.Status.Error)
+			}
+
+			var executions v1.WorkflowExecutionList
+			if err := c.List(t.Context(), &executions); err != nil {
+				t.Fatal(err)
+			}
+			if len(executions.Items) != 1 {
+				t.Errorf("expected no child execution to be started, got %d executions", len(executions.Items))
+			}
+		})
+	}
+}
+
+func TestResolveLastRun(t *testing.T) {
+	first := &v1.WorkflowStep{
+		ObjectMeta: metav1.ObjectMeta{Name: "wfs1first", Namespace: system.DefaultNamespace},
+		Spec:       v1.WorkflowStepSpec{Step: types.Step{ID: "first", Step: "List the open issues"}},
+		Status:     v1.WorkflowStepStatus{State: types.WorkflowStateComplete, LastRunName: "r1first"},
+	}
+	summarize := &v1.WorkflowStep{
+		ObjectMeta: metav1.ObjectMeta{Name: "wfs1summarize", Namespace: system.DefaultNamespace},
+		Spec: v1.WorkflowStepSpec{
+			AfterWorkflowStepName: first.Name,
+			Step:                  types.Step{ID: "summarize", SubWorkflow: &types.SubWorkflow{WorkflowID: "summarize"}},
+		},
+		Status: v1.WorkflowStepStatus{State: types.WorkflowStateComplete, Output: "Three bugs were fixed."},
+	}
+	c := newTestClient(first, summarize)
+
+	runName, outputs, err := resolveLastRun(t.Context(), c, summarize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runName != "r1first" {
+		t.Errorf("expected the steps after the sub-workflow to continue from r1first, got %q", runName)
+	}
+	if len(outputs) != 1 || !strings.Contains(outputs[0], "Three bugs were fixed.") {
+		t.Errorf("expected the sub-workflow's output to be passed to the next step, got %v", outputs)
+	}
+
+	output, err := stepOutput(t.Context(), c, &v1.WorkflowExecution{}, []v1.WorkflowStep{*first, *summarize}, "summarize")
+	if err != nil || output != "Three bugs were fixed." {
+		t.Errorf("expected the output of the sub-workflow step, got %q, %v", output, err)
+	}
+
+	_, output, _, state, err := GetStateFromSteps(t.Context(), c, 0, summarize)
+	if err != nil || state != types.WorkflowStateComplete || output != "Three bugs were fixed." {
+		t.Errorf("expected the sub-workflow's output to be the state's output, got %s %q, %v", state, output, err)
+	}
+}
@@ -0,0 +1,110 @@
+package workflowstep
+
+import (
+	"fmt"
+
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (h *Handler) RunWhile(req router.Request, _ router.Response) (err error) {
+	rootStep := req.Object.(*v1.WorkflowStep)
+
+	if rootStep.Spec.Step.While == nil {
+		return nil
+	}
+
+	var (
+		completeResponse bool
+		objects          []kclient.Object
+	)
+	defer func() {
+		apply := apply.New(req.Client)
+		if !completeResponse {
+			apply.WithNoPrune()
+		}
+		if applyErr := apply.Apply(req.Ctx, req.Object, objects...); applyErr != nil && err == nil {
+			err = applyErr
+		}
+	}()
+
+	// reset
+	rootStep.Status.Error = ""
+
+	whileStep := rootStep.Spec.Step.While
+	maxLoops := whileStep.MaxLoops
+	if maxLoops == 0 {
+		maxLoops = types.DefaultWhileMaxLoops
+	}
+
+	lastStepName := rootStep.Spec.AfterWorkflowStepName
+	for i := 0; i < maxLoops; i++ {
+		suffix := fmt.Sprintf("{while=%d}", i)
+		condition, err := evaluateCondition(req.Ctx, req.Client, rootStep, rootStep.Spec.Step.ID+suffix+"{condition}",
+			lastStepName, whileStep.Condition, whileStep.Compare)
+		if err != nil {
+			return err
+		}
+		if condition.step != nil {
+			objects = append(objects, condition.step)
+		}
+
+		if condition.warning != "" && rootStep.Status.RunMessage == "" {
+			rootStep.Status.RunMessage = condition.warning
+		}
+
+		if condition.state.IsBlocked() {
+			rootStep.Status.State = condition.state
+			rootStep.Status.Error = condition.errMsg
+			return nil
+		}
+
+		if condition.state != types.WorkflowStateComplete {
+			rootStep.Status.State = condition.state
+			return nil
+		}
+
+		if !condition.value {
+			completeResponse = true
+			rootStep.Status.State = types.WorkflowStateComplete
+			rootStep.Status.LastRunName = condition.lastRunName
+			return nil
+		}
+
+		steps := defineNestedSteps(rootStep, condition.lastStepName, suffix, whileStep.Steps)
+		objects = append(objects, steps...)
+
+		runName, errMsg, warning, newState, err := GetStateFromSteps(req.Ctx, req.Client, rootStep.Spec.WorkflowGeneration, steps...)
+		if err != nil {
+			return err
+		}
+
+		if warning != "" && rootStep.Status.RunMessage == "" {
+			rootStep.Status.RunMessage = warning
+		}
+
+		if newState.IsBlocked() {
+			rootStep.Status.State = newState
+			rootStep.Status.Error = errMsg
+			return nil
+		}
+
+		if newState != types.WorkflowStateComplete {
+			rootStep.Status.State = newState
+			return nil
+		}
+
+		lastStepName = steps[len(steps)-1].GetName()
+		if i == maxLoops-1 {
+			completeResponse = true
+			rootStep.Status.State = types.WorkflowStateComplete
+			rootStep.Status.LastRunName = runName
+			rootStep.Status.RunMessage = fmt.Sprintf("while loop stopped after reaching the maximum of %d iterations", maxLoops)
+		}
+	}
+
+	return nil
+}
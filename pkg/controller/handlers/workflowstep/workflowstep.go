@@ -2,6 +2,7 @@ package workflowstep
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"slices"
 	"strings"
@@ -10,8 +11,10 @@ import (
 	"github.com/obot-platform/nah/pkg/name"
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
+	gclient "github.com/obot-platform/obot/pkg/gateway/client"
 	"github.com/obot-platform/obot/pkg/invoke"
 	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/notification"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,18 +26,62 @@ type Handler struct {
 	invoker           *invoke.Invoker
 	gptscriptClient   *gptscript.GPTScript
 	mcpSessionManager *mcp.SessionManager
+	notifier          *notification.Sender
+	gatewayClient     *gclient.Client
+	serverURL         string
 }
 
-func New(invoker *invoke.Invoker, gptscriptClient *gptscript.GPTScript, mcpSessionManager *mcp.SessionManager) *Handler {
+func New(invoker *invoke.Invoker, gptscriptClient *gptscript.GPTScript, mcpSessionManager *mcp.SessionManager, notifier *notification.Sender, gatewayClient *gclient.Client, serverURL string) *Handler {
 	return &Handler{
 		invoker:           invoker,
 		gptscriptClient:   gptscriptClient,
 		mcpSessionManager: mcpSessionManager,
+		notifier:          notifier,
+		gatewayClient:     gatewayClient,
+		serverURL:         serverURL,
 	}
 }
 
-func lastRunMatches(ctx context.Context, c kclient.Client, parent, current *v1.WorkflowStep) (bool, error) {
-	if parent.Status.LastRunName == "" {
+// completesWithoutRun returns true for steps that don't run the agent, like sub-workflow and approval steps. Their
+// result is recorded in their output instead.
+func completesWithoutRun(step *v1.WorkflowStep) bool {
+	return step.Spec.Step.SubWorkflow != nil || step.Spec.Step.Approval != nil
+}
+
+// stepContext describes the output of a step that completed without running the agent, for the next step's prompt.
+func stepContext(step *v1.WorkflowStep) string {
+	if subWorkflow := step.Spec.Step.SubWorkflow; subWorkflow != nil {
+		return fmt.Sprintf("The workflow %s finished with the following output:\n%s", subWorkflow.WorkflowID, step.Status.Output)
+	}
+	if approval := step.Spec.Step.Approval; approval != nil {
+		return fmt.Sprintf("A person reviewed the work so far and approved continuing.\nApproval request: %s\nDecision: %s", approval.Message, step.Status.Output)
+	}
+	return ""
+}
+
+// resolveLastRun returns the run that the steps after the given step continue from. Steps that completed without
+// running the agent continue from the last run before them, and the descriptions of their outputs are returned, oldest
+// first, so that they can be added to the next step's prompt.
+func resolveLastRun(ctx context.Context, c kclient.Client, step *v1.WorkflowStep) (string, []string, error) {
+	var outputs []string
+	for step.Status.LastRunName == "" && completesWithoutRun(step) {
+		outputs = append([]string{stepContext(step)}, outputs...)
+		if step.Spec.AfterWorkflowStepName == "" {
+			return "", outputs, nil
+		}
+
+		var previous v1.WorkflowStep
+		if err := c.Get(ctx, router.Key(step.Namespace, step.Spec.AfterWorkflowStepName), &previous); err != nil {
+			return "", nil, err
+		}
+		step = &previous
+	}
+
+	return step.Status.LastRunName, outputs, nil
+}
+
+func lastRunMatches(ctx context.Context, c kclient.Client, parentLastRunName string, current *v1.WorkflowStep) (bool, error) {
+	if parentLastRunName == "" {
 		if current.Status.HasRunsSet() {
 			return false, nil
 		}
@@ -53,7 +100,7 @@ func lastRunMatches(ctx context.Context, c kclient.Client, parent, current *v1.W
 		return false, err
 	}
 
-	return firstRun.Spec.PreviousRunName == parent.Status.LastRunName, nil
+	return firstRun.Spec.PreviousRunName == parentLastRunName, nil
 }
 
 func deleteLastRuns(ctx context.Context, client kclient.Client, step *v1.WorkflowStep) error {
@@ -163,8 +210,13 @@ func (h *Handler) checkPreconditions(req router.Request, _ router.Response) (pro
 		return false, nil
 	}
 
+	parentLastRunName, _, err := resolveLastRun(req.Ctx, req.Client, &parent)
+	if err != nil {
+		return false, err
+	}
+
 	// If parent lastRun doesn't match our first run, we cleanup
-	if matches, err := lastRunMatches(req.Ctx, req.Client, &parent, step); err != nil {
+	if matches, err := lastRunMatches(req.Ctx, req.Client, parentLastRunName, step); err != nil {
 		return false, err
 	} else if !matches {
 		if err := deleteLastRuns(req.Ctx, req.Client, step); err != nil {
@@ -172,7 +224,7 @@ func (h *Handler) checkPreconditions(req router.Request, _ router.Response) (pro
 		}
 	}
 
-	if parent.Status.LastRunName == "" {
+	if parentLastRunName == "" && !completesWithoutRun(&parent) {
 		step.Status.State = types.WorkflowStateBlocked
 		return false, nil
 	}
@@ -215,6 +267,14 @@ func GetStateFromSteps[T kclient.Object](ctx context.Context, client kclient.Cli
 			return "", "", "", types.WorkflowStateRunning, nil
 		}
 		if i == len(steps)-1 && step.Status.State == types.WorkflowStateComplete {
+			if step.Status.LastRunName == "" && completesWithoutRun(step) {
+				runName, _, err := resolveLastRun(ctx, client, step)
+				if err != nil {
+					return "", "", "", "", err
+				}
+				return runName, step.Status.Output, step.Status.RunMessage, types.WorkflowStateComplete, nil
+			}
+
 			var run v1.Run
 			if err := client.Get(ctx, router.Key(step.Namespace, step.Status.LastRunName), &run); err != nil {
 				return "", "", "", "", err
@@ -226,6 +286,32 @@ func GetStateFromSteps[T kclient.Object](ctx context.Context, client kclient.Cli
 	return "", "", "", types.WorkflowStateRunning, nil
 }
 
+// GetErrorReason returns why the steps of the workflow execution failed. Failures that can't be retried, like aborted
+// runs and rejected approvals, take precedence over failures of other steps.
+func GetErrorReason(ctx context.Context, client kclient.Client, namespace, workflowExecutionName string, generation int64) (v1.ErrorReason, error) {
+	var steps v1.WorkflowStepList
+	if err := client.List(ctx, &steps, kclient.InNamespace(namespace), kclient.MatchingFields{
+		"spec.workflowExecutionName": workflowExecutionName,
+	}); err != nil {
+		return "", err
+	}
+
+	var reason v1.ErrorReason
+	for _, step := range steps.Items {
+		if step.Spec.WorkflowGeneration != generation || step.Status.State != types.WorkflowStateError {
+			continue
+		}
+		switch step.Status.ErrorReason {
+		case v1.ErrorReasonAborted, v1.ErrorReasonRejected:
+			return step.Status.ErrorReason, nil
+		case "":
+		default:
+			reason = step.Status.ErrorReason
+		}
+	}
+	return reason, nil
+}
+
 var replaceRegexp = regexp.MustCompile(`[{},=]+`)
 
 func NewStep(namespace, workflowExecutionName, afterStepName string, generation int64, step types.Step) *v1.WorkflowStep {
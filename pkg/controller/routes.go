@@ -11,6 +11,7 @@ import (
 	"github.com/obot-platform/obot/pkg/controller/handlers/auditlogexport"
 	"github.com/obot-platform/obot/pkg/controller/handlers/cleanup"
 	"github.com/obot-platform/obot/pkg/controller/handlers/cronjob"
+	"github.com/obot-platform/obot/pkg/controller/handlers/evalrun"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgefile"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgeset"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgesource"
@@ -48,8 +49,8 @@ import (
 func (c *Controller) setupRoutes() {
 	root := c.router
 
-	workflowExecution := workflowexecution.New(c.services.Invoker)
-	workflowStep := workflowstep.New(c.services.Invoker, c.services.GPTClient, c.services.MCPLoader)
+	workflowExecution := workflowexecution.New(c.services.Invoker, c.services.Notifier)
+	workflowStep := workflowstep.New(c.services.Invoker, c.services.GPTClient, c.services.MCPLoader, c.services.Notifier, c.services.GatewayClient, c.services.ServerURL)
 	toolRef := toolreference.New(
 		c.services.GPTClient,
 		c.services.ProviderDispatcher,
@@ -62,6 +63,7 @@ func (c *Controller) setupRoutes() {
 	knowledgefile := knowledgefile.New(c.services.Invoker, c.services.GPTClient, c.services.KnowledgeSetIngestionLimit)
 	runs := runs.New(c.services.Invoker, c.services.Router.Backend(), c.services.GatewayClient, c.services.GPTClient)
 	cronJobs := cronjob.New()
+	evalRuns := evalrun.New(c.services.Invoker, c.services.GPTClient, c.services.MCPLoader, c.services.GatewayClient)
 	oauthLogins := oauthapp.NewLogin(c.services.Invoker, c.services.GPTClient, c.services.ServerURL)
 	knowledgesummary := knowledgesummary.NewHandler(c.services.GPTClient)
 	toolInfo := toolinfo.New(c.services.GPTClient)
@@ -72,7 +74,7 @@ func (c *Controller) setupRoutes() {
 	userCleanup := cleanup.NewUserCleanup(c.services.GatewayClient, c.services.AccessControlRuleHelper)
 	mcpCatalog := mcpcatalog.New(c.services.DefaultMCPCatalogPath, c.services.GatewayClient, c.services.AccessControlRuleHelper)
 	mcpSession := mcpsession.New(c.services.GPTClient)
-	mcpserver := mcpserver.New(c.services.GPTClient, c.services.MCPLoader, c.services.ServerURL)
+	mcpserver := mcpserver.New(c.services.GPTClient, c.services.MCPLoader, c.services.ServerURL, c.services.MCPToolChangeCheckInterval, c.services.MCPToolChangeAutoDisable, c.services.MCPHealthChecker)
 	mcpserverinstance := mcpserverinstance.New(c.services.GatewayClient)
 	accesscontrolrule := accesscontrolrule.New(c.services.AccessControlRuleHelper)
 	mcpWebhookValidations := mcpwebhookvalidation.New()
@@ -83,7 +85,7 @@ func (c *Controller) setupRoutes() {
 	scheduledAuditLogExportHandler := scheduledauditlogexport.NewHandler()
 	oauthclients := oauthclients.NewHandler(c.services.GPTClient)
 	projectMCPServerHandler := projectmcpserver.NewHandler()
-	systemMCPServerHandler := systemmcpserver.New(c.services.GPTClient, c.services.MCPLoader, c.services.ServerURL)
+	systemMCPServerHandler := systemmcpserver.New(c.services.GPTClient, c.services.MCPLoader, c.services.ServerURL, c.services.MCPHealthChecker)
 	nanobotAgentHandler := nanobotagent.New(c.services.GPTClient, c.services.PersistentTokenServer, c.services.GatewayClient, c.services.MCPRemoteShimBaseImage, c.services.ServerURL, c.services.MCPLoader)
 
 	// Runs
@@ -133,6 +135,8 @@ func (c *Controller) setupRoutes() {
 	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.Run)
 	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.UpdateRun)
 	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.ReassignThread)
+	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.Retry)
+	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.Notify)
 
 	// Agents
 	root.Type(&v1.Agent{}).HandlerFunc(modelaccesspolicy.MigrateAgentAllowedModels)
@@ -191,6 +195,15 @@ func (c *Controller) setupRoutes() {
 	root.Type(&v1.CronJob{}).HandlerFunc(cronJobs.Run)
 	root.Type(&v1.CronJob{}).HandlerFunc(cleanup.Cleanup)
 
+	// Webhooks
+	root.Type(&v1.Webhook{}).HandlerFunc(cleanup.Cleanup)
+	root.Type(&v1.Webhook{}).FinalizeFunc(v1.WebhookFinalizer, credentialCleanup.Remove)
+
+	// Evals
+	root.Type(&v1.EvalDataset{}).HandlerFunc(cleanup.Cleanup)
+	root.Type(&v1.EvalRun{}).HandlerFunc(cleanup.Cleanup)
+	root.Type(&v1.EvalRun{}).HandlerFunc(evalRuns.Run)
+
 	// OAuthApps
 	root.Type(&v1.OAuthApp{}).HandlerFunc(cleanup.Cleanup)
 	root.Type(&v1.OAuthApp{}).HandlerFunc(alias.AssignAlias)
@@ -214,6 +227,10 @@ func (c *Controller) setupRoutes() {
 	root.Type(&v1.WorkflowStep{}).HandlerFunc(handlers.GCOrphans)
 	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunInvoke)
 	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunLoop)
+	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunIf)
+	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunWhile)
+	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunSubWorkflow)
+	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunApproval)
 
 	// Tools
 	root.Type(&v1.Tool{}).HandlerFunc(cleanup.Cleanup)
@@ -250,6 +267,8 @@ func (c *Controller) setupRoutes() {
 	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.SyncOAuthCredentialStatus)
 	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureMCPServerSecretInfo)
 	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.EnsureCompositeComponents)
+	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.DetectToolChanges)
+	root.Type(&v1.MCPServer{}).HandlerFunc(mcpserver.CheckHealth)
 	root.Type(&v1.MCPServer{}).FinalizeFunc(v1.MCPServerFinalizer, credentialCleanup.RemoveMCPCredentials)
 
 	// MCPServerInstance
@@ -317,6 +336,7 @@ func (c *Controller) setupRoutes() {
 	// System MCP Servers
 	root.Type(&v1.SystemMCPServer{}).HandlerFunc(systemMCPServerHandler.EnsureSecretInfo)
 	root.Type(&v1.SystemMCPServer{}).HandlerFunc(systemMCPServerHandler.EnsureDeployment)
+	root.Type(&v1.SystemMCPServer{}).HandlerFunc(systemMCPServerHandler.CheckHealth)
 	root.Type(&v1.SystemMCPServer{}).FinalizeFunc(v1.SystemMCPServerFinalizer, systemMCPServerHandler.CleanupDeployment)
 
 	// AuditLogExport
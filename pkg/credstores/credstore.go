@@ -32,18 +32,26 @@ func setUpPostgres(toolRegistries []string, dsn, encryptionConfigFile string) (s
 	}, nil
 }
 
-func setUpSQLite(toolRegistries []string, dsn, encryptionConfigFile string) (string, []string, error) {
+// SQLiteFiles returns the database file of a sqlite DSN and the file that the credential store uses next to it.
+func SQLiteFiles(dsn string) (string, string, error) {
 	dbFile, ok := strings.CutPrefix(dsn, "sqlite://file:")
 	if !ok {
-		return "", nil, fmt.Errorf("invalid sqlite dsn, must start with sqlite://file: %s", dsn)
+		return "", "", fmt.Errorf("invalid sqlite dsn, must start with sqlite://file: %s", dsn)
 	}
 	dbFile, _, _ = strings.Cut(dbFile, "?")
 
 	if !strings.HasSuffix(dbFile, ".db") {
-		return "", nil, fmt.Errorf("invalid sqlite dsn, file must end in .db: %s", dsn)
+		return "", "", fmt.Errorf("invalid sqlite dsn, file must end in .db: %s", dsn)
 	}
 
-	dbFile = strings.TrimSuffix(dbFile, ".db") + "-credentials.db"
+	return dbFile, strings.TrimSuffix(dbFile, ".db") + "-credentials.db", nil
+}
+
+func setUpSQLite(toolRegistries []string, dsn, encryptionConfigFile string) (string, []string, error) {
+	_, dbFile, err := SQLiteFiles(dsn)
+	if err != nil {
+		return "", nil, err
+	}
 
 	toolRef, err := resolveToolRef(toolRegistries, "credential-stores/sqlite")
 	if err != nil {
@@ -0,0 +1,276 @@
+package dbmigration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Postgres accepts at most 65535 parameters per statement.
+const maxParameters = 65535
+
+// copyTable copies the rows of a table to the table with the same name in the target. Columns that the target doesn't
+// have are dropped, which only happens for columns that Obot no longer uses.
+func copyTable(ctx context.Context, src, dst *sql.Tx, table string) (int64, error) {
+	targetColumns, err := columnTypes(ctx, dst, table)
+	if err != nil {
+		return 0, err
+	}
+
+	rows, err := src.QueryContext(ctx, "SELECT * FROM "+quote(table))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	sourceColumns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		columns []string
+		types   []string
+		indexes []int
+	)
+	for i, column := range sourceColumns {
+		dataType, ok := targetColumns[column]
+		if !ok {
+			log.Infof("Dropping column %s of table %s, the target table doesn't have it", column, table)
+			continue
+		}
+		columns = append(columns, quote(column))
+		types = append(types, dataType)
+		indexes = append(indexes, i)
+	}
+	if len(columns) == 0 {
+		return 0, fmt.Errorf("the target table has none of the columns of the source table")
+	}
+
+	insert := "INSERT INTO " + quote(table) + " (" + strings.Join(columns, ", ") + ") VALUES "
+	var onConflict string
+	if table == migrationsTable {
+		onConflict = " ON CONFLICT DO NOTHING"
+	}
+
+	var (
+		batchSize = min(500, maxParameters/len(columns))
+		values    = make([]any, len(sourceColumns))
+		pointers  = make([]any, len(sourceColumns))
+		args      = make([]any, 0, batchSize*len(columns))
+		copied    int64
+	)
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	flush := func() error {
+		if len(args) == 0 {
+			return nil
+		}
+
+		result, err := dst.ExecContext(ctx, insert+placeholders(len(args)/len(columns), len(columns))+onConflict, args...)
+		if err != nil {
+			return err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		copied += n
+		args = args[:0]
+		return nil
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return copied, err
+		}
+		for i, index := range indexes {
+			value, err := convertValue(values[index], types[i])
+			if err != nil {
+				return copied, fmt.Errorf("invalid value of column %s: %w", sourceColumns[index], err)
+			}
+			args = append(args, value)
+		}
+		if len(args) == batchSize*len(columns) {
+			if err := flush(); err != nil {
+				return copied, err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return copied, err
+	}
+
+	return copied, flush()
+}
+
+// columnTypes returns the data types of the columns of a table in the target, by column name.
+func columnTypes(ctx context.Context, tx *sql.Tx, table string) (map[string]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT column_name, data_type FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1", table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := map[string]string{}
+	for rows.Next() {
+		var column, dataType string
+		if err := rows.Scan(&column, &dataType); err != nil {
+			return nil, err
+		}
+		result[column] = dataType
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("table %s doesn't exist in the target database", table)
+	}
+	return result, nil
+}
+
+func placeholders(rows, columns int) string {
+	var sb strings.Builder
+	for row := range rows {
+		if row > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("(")
+		for column := range columns {
+			if column > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("$")
+			sb.WriteString(strconv.Itoa(row*columns + column + 1))
+		}
+		sb.WriteString(")")
+	}
+	return sb.String()
+}
+
+// sqliteTimeFormats are the formats that gorm and the sqlite driver store times in.
+var sqliteTimeFormats = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+	time.RFC3339Nano,
+}
+
+// convertValue converts a value read from SQLite to the data type of the Postgres column. SQLite stores booleans as
+// integers and times as text, and doesn't distinguish between text and blobs.
+func convertValue(value any, dataType string) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch dataType {
+	case "boolean":
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case int64:
+			return v != 0, nil
+		case float64:
+			return v != 0, nil
+		case string:
+			return strconv.ParseBool(v)
+		case []byte:
+			return strconv.ParseBool(string(v))
+		}
+	case "timestamp with time zone", "timestamp without time zone", "date":
+		switch v := value.(type) {
+		case time.Time:
+			return v, nil
+		case int64:
+			return time.Unix(v, 0).UTC(), nil
+		case []byte:
+			value = string(v)
+		}
+		if v, ok := value.(string); ok {
+			v = strings.TrimSuffix(v, "Z")
+			for _, format := range sqliteTimeFormats {
+				if t, err := time.Parse(format, v); err == nil {
+					return t, nil
+				}
+			}
+			return nil, fmt.Errorf("invalid time %q", v)
+		}
+	case "bytea":
+		switch v := value.(type) {
+		case []byte:
+			return v, nil
+		case string:
+			return []byte(v), nil
+		}
+	case "text", "character varying", "character", "json", "jsonb":
+		switch v := value.(type) {
+		case string:
+			return v, nil
+		case []byte:
+			return string(v), nil
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		case time.Time:
+			return v.Format(time.RFC3339Nano), nil
+		}
+	case "smallint", "integer", "bigint", "numeric", "real", "double precision":
+		switch v := value.(type) {
+		case bool:
+			if v {
+				return int64(1), nil
+			}
+			return int64(0), nil
+		case []byte:
+			return string(v), nil
+		}
+	}
+
+	return value, nil
+}
+
+// resetSequences moves the sequences of serial columns past the copied ids, so that new rows get new ids.
+func resetSequences(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, `SELECT table_name, column_name, pg_get_serial_sequence(quote_ident(table_name), column_name)
+FROM information_schema.columns
+WHERE table_schema = current_schema() AND pg_get_serial_sequence(quote_ident(table_name), column_name) IS NOT NULL`)
+	if err != nil {
+		return fmt.Errorf("failed to list sequences: %w", err)
+	}
+
+	type sequence struct {
+		table, column, name string
+	}
+	var sequences []sequence
+	for rows.Next() {
+		var s sequence
+		if err := rows.Scan(&s.table, &s.column, &s.name); err != nil {
+			rows.Close()
+			return err
+		}
+		sequences = append(sequences, s)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, s := range sequences {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("SELECT setval($1, COALESCE((SELECT max(%s) FROM %s), 0) + 1, false)", quote(s.column), quote(s.table)), s.name); err != nil {
+			return fmt.Errorf("failed to reset sequence %s: %w", s.name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,363 @@
+// Package dbmigration copies the data of an Obot installation from SQLite to an empty Postgres database: the objects of
+// the storage server, the gateway tables and the credentials.
+package dbmigration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	_ "github.com/glebarez/go-sqlite"
+	kdb "github.com/obot-platform/kinm/pkg/db"
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/credstores"
+	"github.com/obot-platform/obot/pkg/gateway/client"
+	gdb "github.com/obot-platform/obot/pkg/gateway/db"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/storage/scheme"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apiserver/pkg/server/options/encryptionconfig"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+const (
+	DatabaseObot        = "obot"
+	DatabaseCredentials = "credentials"
+
+	// migrationsTable is seeded when the gateway tables are created, so its rows are merged with those of the source.
+	migrationsTable = "migrations"
+	// compactionTable holds the compaction state of the storage tables.
+	compactionTable = "compaction"
+)
+
+type Options struct {
+	// SourceDSN is the sqlite DSN of the installation. The credentials are read from the file next to its database.
+	SourceDSN string
+	// TargetDSN is the DSN of the Postgres database, which must be empty.
+	TargetDSN string
+	// DryRun only counts the rows that would be copied.
+	DryRun bool
+	// EncryptionConfig, if set, is used to encrypt the users and identities that were stored unencrypted.
+	EncryptionConfig *encryptionconfig.EncryptionConfiguration
+}
+
+type Report struct {
+	Tables []TableReport `json:"tables"`
+}
+
+type TableReport struct {
+	// Database is the source database of the table, obot or credentials.
+	Database string `json:"database"`
+	Table    string `json:"table"`
+	Rows     int64  `json:"rows"`
+	// Copied is the number of rows copied. It is zero for dry runs.
+	Copied int64 `json:"copied"`
+	// Skipped is the reason that the table isn't copied.
+	Skipped string `json:"skipped,omitempty"`
+}
+
+// Migrate copies the data of a SQLite installation to an empty Postgres database. The schema is created the same way
+// that Obot creates it, and the rows are copied in a single transaction, so a failed migration leaves no data behind.
+// Obot must not be running while it is migrated.
+func Migrate(ctx context.Context, opts Options) (*Report, error) {
+	dbFile, credentialsFile, err := credstores.SQLiteFiles(opts.SourceDSN)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(dbFile); err != nil {
+		return nil, fmt.Errorf("failed to find the database of the installation: %w", err)
+	}
+
+	targetDSN := strings.Replace(opts.TargetDSN, "postgresql://", "postgres://", 1)
+	if !strings.HasPrefix(targetDSN, "postgres://") {
+		return nil, fmt.Errorf("the target must be a postgres database, got %s", strings.Split(targetDSN, "://")[0])
+	}
+
+	sources := []source{{name: DatabaseObot, file: dbFile}}
+	if _, err := os.Stat(credentialsFile); err == nil {
+		sources = append(sources, source{name: DatabaseCredentials, file: credentialsFile})
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	for i := range sources {
+		if err := sources[i].open(ctx); err != nil {
+			return nil, err
+		}
+		defer sources[i].close()
+	}
+
+	factory, err := kdb.NewFactory(scheme.Scheme, targetDSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the target database: %w", err)
+	}
+	defer factory.SQLDB.Close()
+
+	if err := checkEmpty(ctx, factory.SQLDB); err != nil {
+		return nil, err
+	}
+
+	gatewayDB, err := gdb.New(factory.DB, factory.SQLDB, true)
+	if err != nil {
+		return nil, err
+	}
+
+	known, err := knownTables(gatewayDB)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	for _, src := range sources {
+		for _, table := range src.tables {
+			rows, err := src.count(ctx, table)
+			if err != nil {
+				return nil, err
+			}
+
+			tableReport := TableReport{
+				Database: src.name,
+				Table:    table,
+				Rows:     rows,
+			}
+			if !known[table] {
+				tableReport.Skipped = "Obot doesn't use this table"
+			}
+			report.Tables = append(report.Tables, tableReport)
+		}
+	}
+
+	if opts.DryRun {
+		return report, nil
+	}
+
+	if err := createSchema(gatewayDB, factory); err != nil {
+		return nil, err
+	}
+
+	tx, err := factory.SQLDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for i, table := range report.Tables {
+		if table.Skipped != "" {
+			log.Warnf("Skipping table %s of the %s database with %d rows, Obot doesn't use it", table.Table, table.Database, table.Rows)
+			continue
+		}
+
+		src := sources[slices.IndexFunc(sources, func(s source) bool { return s.name == table.Database })]
+		if report.Tables[i].Copied, err = copyTable(ctx, src.tx, tx, table.Table); err != nil {
+			return nil, fmt.Errorf("failed to copy table %s of the %s database: %w", table.Table, table.Database, err)
+		}
+		log.Infof("Copied %d rows of table %s of the %s database", report.Tables[i].Copied, table.Table, table.Database)
+	}
+
+	if err := resetSequences(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if opts.EncryptionConfig != nil {
+		if err := encryptGatewayData(ctx, gatewayDB, opts.EncryptionConfig); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// source is a SQLite database of the installation. All of it is read in one transaction, so the copy is consistent.
+type source struct {
+	name   string
+	file   string
+	db     *sql.DB
+	tx     *sql.Tx
+	tables []string
+}
+
+func (s *source) open(ctx context.Context) (err error) {
+	s.db, err = sql.Open("sqlite", "file:"+s.file+"?_pragma=busy_timeout(30000)")
+	if err != nil {
+		return err
+	}
+
+	s.tx, err = s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", s.file, err)
+	}
+
+	rows, err := s.tx.QueryContext(ctx, "SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name")
+	if err != nil {
+		return fmt.Errorf("failed to list the tables of %s: %w", s.file, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return err
+		}
+		s.tables = append(s.tables, table)
+	}
+	return rows.Err()
+}
+
+func (s *source) count(ctx context.Context, table string) (int64, error) {
+	var rows int64
+	if err := s.tx.QueryRowContext(ctx, "SELECT count(*) FROM "+quote(table)).Scan(&rows); err != nil {
+		return 0, fmt.Errorf("failed to count the rows of table %s of the %s database: %w", table, s.name, err)
+	}
+	return rows, nil
+}
+
+func (s *source) close() {
+	if s.tx != nil {
+		_ = s.tx.Rollback()
+	}
+	if s.db != nil {
+		_ = s.db.Close()
+	}
+}
+
+// checkEmpty fails if the target has data. The migrations table is allowed to have rows, so that a failed migration can
+// be retried.
+func checkEmpty(ctx context.Context, db *sql.DB) error {
+	tables, err := targetTables(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range tables {
+		if table == migrationsTable {
+			continue
+		}
+
+		var exists bool
+		if err := db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM "+quote(table)+")").Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check table %s of the target database: %w", table, err)
+		}
+		if exists {
+			return fmt.Errorf("the target database isn't empty, table %s has data", table)
+		}
+	}
+	return nil
+}
+
+func targetTables(ctx context.Context, db *sql.DB) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "SELECT table_name FROM information_schema.tables WHERE table_schema = current_schema() AND table_type = 'BASE TABLE'")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the tables of the target database: %w", err)
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		if err := rows.Scan(&table); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return tables, rows.Err()
+}
+
+// storageTypes returns the types that the storage server keeps in the database by their table names, the same types
+// that the storage server registers.
+func storageTypes() (map[string]kclient.Object, error) {
+	result := map[string]kclient.Object{
+		tableName(&coordinationv1.Lease{}, "Lease"): &coordinationv1.Lease{},
+	}
+	for gvk := range scheme.Scheme.AllKnownTypes() {
+		if gvk.Group != v1.SchemeGroupVersion.Group || gvk.Version != v1.SchemeGroupVersion.Version {
+			continue
+		}
+
+		obj, err := scheme.Scheme.New(gvk)
+		if err != nil {
+			return nil, err
+		}
+		if o, ok := obj.(kclient.Object); ok {
+			result[tableName(o, gvk.Kind)] = o
+		}
+	}
+	return result, nil
+}
+
+// tableName returns the table of a type, the same way that the storage server names it.
+func tableName(obj kclient.Object, kind string) string {
+	if tn, ok := obj.(kdb.TableNamer); ok {
+		return tn.TableName()
+	}
+	return strings.ToLower(kind)
+}
+
+func knownTables(gatewayDB *gdb.DB) (map[string]bool, error) {
+	known := map[string]bool{compactionTable: true}
+
+	types, err := storageTypes()
+	if err != nil {
+		return nil, err
+	}
+	for table := range types {
+		known[table] = true
+	}
+
+	gatewayTables, err := gatewayDB.TableNames()
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range gatewayTables {
+		known[table] = true
+	}
+
+	return known, nil
+}
+
+// createSchema creates the tables the same way that Obot does when it starts.
+func createSchema(gatewayDB *gdb.DB, factory *kdb.Factory) error {
+	types, err := storageTypes()
+	if err != nil {
+		return err
+	}
+	for table, obj := range types {
+		if _, err := factory.NewDBStrategy(obj); err != nil {
+			return fmt.Errorf("failed to create table %s: %w", table, err)
+		}
+	}
+
+	if err := gatewayDB.AutoMigrate(); err != nil {
+		return fmt.Errorf("failed to create the gateway tables: %w", err)
+	}
+	return nil
+}
+
+// encryptGatewayData encrypts the users and identities that the SQLite installation stored unencrypted, the same way
+// as the encrypt endpoint of the users API.
+func encryptGatewayData(ctx context.Context, gatewayDB *gdb.DB, encryptionConfig *encryptionconfig.EncryptionConfiguration) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	gatewayClient := client.New(ctx, gatewayDB, nil, encryptionConfig, nil, nil, time.Minute, 1)
+	return errors.Join(
+		gatewayClient.EncryptUsers(ctx, false),
+		gatewayClient.EncryptIdentities(ctx, false),
+	)
+}
+
+func quote(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
@@ -0,0 +1,121 @@
+package dbmigration
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSource(t *testing.T) {
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "obot.db")
+
+	db, err := sql.Open("sqlite", "file:"+file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for _, stmt := range []string{
+		"CREATE TABLE users (id INTEGER PRIMARY KEY, username TEXT, created_at DATETIME, encrypted NUMERIC)",
+		"INSERT INTO users (username, created_at, encrypted) VALUES ('one', '2025-06-01 10:00:00.123+00:00', 1), ('two', '2025-06-02 10:00:00+00:00', 0)",
+		"CREATE TABLE compaction (name TEXT, id INTEGER)",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	src := source{name: DatabaseObot, file: file}
+	if err := src.open(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer src.close()
+
+	if !slices.Equal(src.tables, []string{"compaction", "users"}) {
+		t.Fatalf("unexpected tables %v", src.tables)
+	}
+	if rows, err := src.count(ctx, "users"); err != nil || rows != 2 {
+		t.Fatalf("expected 2 users, got %d, %v", rows, err)
+	}
+
+	// Values are converted from what the driver returns for the columns that gorm creates in SQLite.
+	var createdAt, encrypted any
+	if err := src.tx.QueryRowContext(ctx, "SELECT created_at, encrypted FROM users WHERE id = 1").Scan(&createdAt, &encrypted); err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := convertValue(createdAt, "timestamp with time zone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := time.Date(2025, 6, 1, 10, 0, 0, 123000000, time.UTC); !created.(time.Time).Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, created)
+	}
+
+	if value, err := convertValue(encrypted, "boolean"); err != nil || value != true {
+		t.Errorf("expected true, got %v, %v", value, err)
+	}
+}
+
+func TestConvertValue(t *testing.T) {
+	for _, tt := range []struct {
+		value    any
+		dataType string
+		expected any
+	}{
+		{nil, "boolean", nil},
+		{int64(0), "boolean", false},
+		{"true", "boolean", true},
+		{[]byte("data"), "text", "data"},
+		{int64(42), "text", "42"},
+		{"data", "bytea", []byte("data")},
+		{true, "bigint", int64(1)},
+		{int64(7), "bigint", int64(7)},
+		{"2025-06-01T10:00:00Z", "timestamp with time zone", time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)},
+	} {
+		value, err := convertValue(tt.value, tt.dataType)
+		if err != nil {
+			t.Fatalf("converting %v to %s: %v", tt.value, tt.dataType, err)
+		}
+
+		switch expected := tt.expected.(type) {
+		case time.Time:
+			if !expected.Equal(value.(time.Time)) {
+				t.Errorf("converting %v to %s: expected %v, got %v", tt.value, tt.dataType, expected, value)
+			}
+		case []byte:
+			if string(expected) != string(value.([]byte)) {
+				t.Errorf("converting %v to %s: expected %v, got %v", tt.value, tt.dataType, expected, value)
+			}
+		default:
+			if value != tt.expected {
+				t.Errorf("converting %v to %s: expected %v, got %v", tt.value, tt.dataType, tt.expected, value)
+			}
+		}
+	}
+
+	if _, err := convertValue("yesterday", "timestamp with time zone"); err == nil {
+		t.Error("expected an error for an invalid time")
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	if got := placeholders(2, 3); got != "($1, $2, $3), ($4, $5, $6)" {
+		t.Errorf("unexpected placeholders %s", got)
+	}
+}
+
+func TestKnownStorageTables(t *testing.T) {
+	types, err := storageTypes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, table := range []string{"thread", "agent", "mcpserver", "lease"} {
+		if _, ok := types[table]; !ok {
+			t.Errorf("expected a storage type for table %s", table)
+		}
+	}
+}
@@ -529,7 +529,14 @@ func (e *Emitter) printParent(ctx context.Context, remaining int, state *printSt
 	}
 
 	if parent.Spec.ThreadName != "" && run.Spec.ThreadName != "" && parent.Spec.ThreadName != run.Spec.ThreadName {
-		return nil
+		// Only show the history of another thread if this thread was forked from it
+		var thread v1.Thread
+		if err := e.client.Get(ctx, kclient.ObjectKey{Namespace: run.Namespace, Name: run.Spec.ThreadName}, &thread); err != nil {
+			return err
+		}
+		if thread.Spec.ForkedFromThreadName != parent.Spec.ThreadName {
+			return nil
+		}
 	}
 	if err := e.printParent(ctx, remaining-1, state, parent, result); apierrors.IsNotFound(err) {
 		errNotFound = err
@@ -6,6 +6,7 @@ import (
 
 	"github.com/obot-platform/obot/logger"
 	"github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/metrics"
 )
 
 var log = logger.Package()
@@ -23,6 +24,7 @@ func (c *Client) LogMCPAuditEntry(entry types.MCPAuditLog) {
 	defer c.auditLock.Unlock()
 
 	c.auditBuffer = append(c.auditBuffer, entry)
+	metrics.SetAuditLogBufferSize(len(c.auditBuffer))
 	if len(c.auditBuffer) >= cap(c.auditBuffer)/2 {
 		select {
 		case c.kickAuditPersist <- struct{}{}:
@@ -66,12 +68,15 @@ func (c *Client) persistAuditLogs() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	if err := c.insertMCPAuditLogs(ctx, buf); err != nil {
-		c.auditLock.Lock()
+	start := time.Now()
+	err := c.insertMCPAuditLogs(ctx, buf)
+	metrics.ObserveAuditLogFlush(len(buf), time.Since(start), err)
+
+	c.auditLock.Lock()
+	defer c.auditLock.Unlock()
+	if err != nil {
 		c.auditBuffer = append(buf, c.auditBuffer...)
-		c.auditLock.Unlock()
-		return err
 	}
-
-	return nil
+	metrics.SetAuditLogBufferSize(len(c.auditBuffer))
+	return err
 }
@@ -154,7 +154,7 @@ func (c *Client) GetMCPAuditLogs(ctx context.Context, opts MCPAuditLogOptions) (
 		query := `user_id in (?) OR mcp_id %[1]s ? OR mcp_server_display_name %[1]s ? OR
 mcp_server_catalog_entry_name %[1]s ? OR client_name %[1]s ? OR client_version %[1]s ? OR
 client_ip %[1]s ? OR call_type %[1]s ? OR call_identifier %[1]s ? OR error %[1]s ? OR
-session_id %[1]s ? OR request_id %[1]s ? OR user_agent %[1]s ?`
+session_id %[1]s ? OR request_id %[1]s ? OR trace_id %[1]s ? OR user_agent %[1]s ?`
 
 		args := append([]any{userIDs}, slices.Repeat([]any{searchTerm}, strings.Count(query, "%[1]s ?"))...)
 
@@ -204,6 +204,9 @@ session_id %[1]s ? OR request_id %[1]s ? OR user_agent %[1]s ?`
 	if len(opts.SessionID) > 0 {
 		db = db.Where("session_id IN (?)", opts.SessionID)
 	}
+	if len(opts.TraceID) > 0 {
+		db = db.Where("trace_id IN (?)", opts.TraceID)
+	}
 	if len(opts.ClientName) > 0 {
 		db = db.Where("client_name IN (?)", opts.ClientName)
 	}
@@ -347,6 +350,9 @@ func (c *Client) GetAuditLogFilterOptions(ctx context.Context, option string, op
 	if len(opts.SessionID) > 0 {
 		db = db.Where("session_id IN (?)", opts.SessionID)
 	}
+	if len(opts.TraceID) > 0 {
+		db = db.Where("trace_id IN (?)", opts.TraceID)
+	}
 	if len(opts.ClientName) > 0 {
 		db = db.Where("client_name IN (?)", opts.ClientName)
 	}
@@ -547,6 +553,7 @@ type MCPAuditLogOptions struct {
 	CallType                  []string
 	CallIdentifier            []string
 	SessionID                 []string
+	TraceID                   []string
 	ClientName                []string
 	ClientVersion             []string
 	ResponseStatus            []string
@@ -23,6 +23,15 @@ func (c *Client) TokenUsageForUser(ctx context.Context, userID string, start, en
 	return activities, c.db.WithContext(ctx).Where("user_id = ?", userID).Where("created_at >= ? AND created_at <= ?", start, end).Order("created_at DESC").Find(&activities).Error
 }
 
+// TokenUsageForRun returns the total token usage of all model calls made by the run.
+func (c *Client) TokenUsageForRun(ctx context.Context, runName string) (types.RunTokenActivity, error) {
+	var activity types.RunTokenActivity
+	return activity, c.db.WithContext(ctx).Model(new(types.RunTokenActivity)).
+		Select("COALESCE(SUM(prompt_tokens), 0) as prompt_tokens, COALESCE(SUM(completion_tokens), 0) as completion_tokens, COALESCE(SUM(total_tokens), 0) as total_tokens").
+		Where("name = ?", runName).
+		Scan(&activity).Error
+}
+
 func (c *Client) TotalTokenUsageForUser(ctx context.Context, userID string, start, end time.Time, includePersonalTokenUsage bool) (types.RunTokenActivity, error) {
 	activity, err := c.tokenUsageByUser(ctx, userID, start, end, includePersonalTokenUsage)
 	if err != nil || len(activity) == 0 {
@@ -9,6 +9,29 @@ import (
 	"gorm.io/gorm"
 )
 
+var gatewayTypes = []any{
+	types.AuthToken{},
+	types.TokenRequest{},
+	types.LLMProxyActivity{},
+	types.OAuthTokenRequestChallenge{},
+	types.OAuthTokenResponse{},
+	types.User{},
+	types.Identity{},
+	types.Group{},
+	types.GroupMemberships{},
+	types.GroupRoleAssignment{},
+	types.APIActivity{},
+	types.Image{},
+	types.RunState{},
+	types.FileScannerConfig{},
+	types.RunTokenActivity{},
+	types.MCPOAuthToken{},
+	types.MCPAuditLog{},
+	types.TempSetupUser{},
+	types.Property{},
+	types.APIKey{},
+}
+
 type DB struct {
 	gormDB      *gorm.DB
 	sqlDB       *sql.DB
@@ -80,28 +103,7 @@ func (db *DB) AutoMigrate() (err error) {
 		return fmt.Errorf("failed to auto migrate GptscriptCredential: %w", err)
 	}
 
-	if err := tx.AutoMigrate(
-		types.AuthToken{},
-		types.TokenRequest{},
-		types.LLMProxyActivity{},
-		types.OAuthTokenRequestChallenge{},
-		types.OAuthTokenResponse{},
-		types.User{},
-		types.Identity{},
-		types.Group{},
-		types.GroupMemberships{},
-		types.GroupRoleAssignment{},
-		types.APIActivity{},
-		types.Image{},
-		types.RunState{},
-		types.FileScannerConfig{},
-		types.RunTokenActivity{},
-		types.MCPOAuthToken{},
-		types.MCPAuditLog{},
-		types.TempSetupUser{},
-		types.Property{},
-		types.APIKey{},
-	); err != nil {
+	if err := tx.AutoMigrate(gatewayTypes...); err != nil {
 		return fmt.Errorf("failed to auto migrate gateway types: %w", err)
 	}
 
@@ -121,6 +123,20 @@ func (db *DB) AutoMigrate() (err error) {
 	return nil
 }
 
+// TableNames returns the names of the tables that AutoMigrate creates.
+func (db *DB) TableNames() ([]string, error) {
+	models := append([]any{types.Migration{}, GptscriptCredential{}}, gatewayTypes...)
+	names := make([]string, 0, len(models))
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db.gormDB}
+		if err := stmt.Parse(model); err != nil {
+			return nil, err
+		}
+		names = append(names, stmt.Schema.Table)
+	}
+	return names, nil
+}
+
 func (db *DB) Check(ctx context.Context) error {
 	return db.sqlDB.PingContext(ctx)
 }
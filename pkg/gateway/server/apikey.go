@@ -18,16 +18,9 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-type createAPIKeyRequest struct {
-	Name         string     `json:"name"`
-	Description  string     `json:"description,omitempty"`
-	ExpiresAt    *time.Time `json:"expiresAt,omitempty"`
-	MCPServerIDs []string   `json:"mcpServerIds,omitempty"`
-}
-
 // createAPIKey creates an API key for the authenticated user.
 func (s *Server) createAPIKey(apiContext api.Context) error {
-	var req createAPIKeyRequest
+	var req types2.APIKeyCreateRequest
 	if err := apiContext.Read(&req); err != nil {
 		return types2.NewErrBadRequest("invalid request body: %v", err)
 	}
@@ -74,7 +67,12 @@ func (s *Server) createAPIKey(apiContext api.Context) error {
 		return types2.NewErrHTTP(http.StatusBadRequest, errors.Join(errs...).Error())
 	}
 
-	response, err := apiContext.GatewayClient.CreateAPIKey(apiContext.Context(), userID, req.Name, req.Description, req.ExpiresAt, req.MCPServerIDs)
+	var expiresAt *time.Time
+	if !req.ExpiresAt.IsZero() {
+		expiresAt = &req.ExpiresAt.Time
+	}
+
+	response, err := apiContext.GatewayClient.CreateAPIKey(apiContext.Context(), userID, req.Name, req.Description, expiresAt, req.MCPServerIDs)
 	if err != nil {
 		return types2.NewErrHTTP(http.StatusInternalServerError, fmt.Sprintf("failed to create API key: %v", err))
 	}
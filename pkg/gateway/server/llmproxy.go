@@ -25,6 +25,7 @@ import (
 	"github.com/obot-platform/obot/pkg/gateway/client"
 	"github.com/obot-platform/obot/pkg/gateway/server/dispatcher"
 	"github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/metrics"
 	"github.com/obot-platform/obot/pkg/modelaccesspolicy"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
@@ -363,6 +364,7 @@ func (r *responseModifier) Close() error {
 		PersonalToken:    r.personalToken,
 	}
 	r.lock.Unlock()
+	metrics.AddLLMTokens(r.model, activity.PromptTokens, activity.CompletionTokens)
 	if err := r.client.InsertTokenUsage(context.Background(), activity); err != nil {
 		logger.Warnf("failed to save token usage for run %s: %v", r.runID, err)
 	}
@@ -34,6 +34,7 @@ type MCPAuditLog struct {
 
 	// Additional metadata
 	RequestID       string          `json:"requestID,omitempty" gorm:"index"`
+	TraceID         string          `json:"traceID,omitempty" gorm:"index"`
 	UserAgent       string          `json:"userAgent,omitempty"`
 	RequestHeaders  json.RawMessage `json:"requestHeaders,omitempty"`
 	ResponseHeaders json.RawMessage `json:"responseHeaders,omitempty"`
@@ -134,6 +135,7 @@ func ConvertMCPAuditLog(a MCPAuditLog) types2.MCPAuditLog {
 		ProcessingTimeMs: a.ProcessingTimeMs,
 		SessionID:        a.SessionID,
 		RequestID:        a.RequestID,
+		TraceID:          a.TraceID,
 		UserAgent:        a.UserAgent,
 		RequestHeaders:   a.RequestHeaders,
 		ResponseHeaders:  a.ResponseHeaders,
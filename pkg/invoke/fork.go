@@ -0,0 +1,81 @@
+package invoke
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/pkg/mcp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type ForkOptions struct {
+	Input         string
+	ModelProvider string
+	Model         string
+	Temperature   *float32
+	// Tools replaces the tools of the thread if not nil
+	Tools   []string
+	UserUID string
+}
+
+// Fork creates a new thread from thread at the given run and runs the input of that run again in the new thread,
+// continuing from the chat state of the run before it. The new thread starts with a copy of the thread's current
+// workspace files. The original thread and its runs are not modified.
+func (i *Invoker) Fork(ctx context.Context, mcpSessionManager *mcp.SessionManager, gptClient *gptscript.GPTScript, c kclient.WithWatch, thread *v1.Thread, run *v1.Run, opt ForkOptions) (*Response, error) {
+	if thread.Spec.Project || thread.Spec.WorkflowName != "" || thread.Spec.SystemTask {
+		return nil, fmt.Errorf("only chat threads can be forked")
+	}
+	if run.Spec.ThreadName != thread.Name {
+		return nil, fmt.Errorf("run %s does not belong to thread %s", run.Name, thread.Name)
+	}
+
+	input := run.Spec.Input
+	if opt.Input != "" {
+		input = opt.Input
+	}
+
+	fork := v1.Thread{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.ThreadPrefix,
+			Namespace:    thread.Namespace,
+			Finalizers:   []string{v1.ThreadFinalizer},
+		},
+		Spec: v1.ThreadSpec{
+			Manifest:             *thread.Spec.Manifest.DeepCopy(),
+			AgentName:            thread.Spec.AgentName,
+			ParentThreadName:     thread.Spec.ParentThreadName,
+			UserID:               opt.UserUID,
+			SystemTools:          thread.Spec.SystemTools,
+			ForkedFromThreadName: thread.Name,
+			ForkedFromRunName:    run.Name,
+		},
+	}
+
+	if opt.Model != "" || opt.ModelProvider != "" {
+		fork.Spec.Manifest.Model = opt.Model
+		fork.Spec.Manifest.ModelProvider = opt.ModelProvider
+	}
+	if opt.Temperature != nil {
+		fork.Spec.Manifest.Temperature = opt.Temperature
+	}
+	if opt.Tools != nil {
+		fork.Spec.Manifest.Tools = opt.Tools
+	}
+
+	if err := c.Create(ctx, &fork); err != nil {
+		return nil, err
+	}
+
+	// An empty previous run name means the run was the first in the thread, and the new thread has no runs yet
+	// so the fork also starts without a chat state.
+	return i.Thread(ctx, mcpSessionManager, gptClient, c, &fork, input, Options{
+		GenerateName:    system.ChatRunPrefix,
+		PreviousRunName: run.Spec.PreviousRunName,
+		UserUID:         opt.UserUID,
+		IgnoreMCPErrors: true,
+	})
+}
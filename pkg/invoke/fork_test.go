@@ -0,0 +1,27 @@
+package invoke
+
+import (
+	"testing"
+
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestForkRejectsInvalidThreads(t *testing.T) {
+	run := &v1.Run{
+		ObjectMeta: metav1.ObjectMeta{Name: "r1", Namespace: "default"},
+		Spec:       v1.RunSpec{ThreadName: "t1"},
+	}
+
+	for name, thread := range map[string]*v1.Thread{
+		"project":  {ObjectMeta: metav1.ObjectMeta{Name: "t1"}, Spec: v1.ThreadSpec{Project: true}},
+		"workflow": {ObjectMeta: metav1.ObjectMeta{Name: "t1"}, Spec: v1.ThreadSpec{WorkflowName: "w1"}},
+		"other":    {ObjectMeta: metav1.ObjectMeta{Name: "t2"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := (&Invoker{}).Fork(t.Context(), nil, nil, nil, thread, run, ForkOptions{})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -7,13 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"net/http"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/gptscript-ai/go-gptscript"
+	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/logger"
@@ -30,7 +34,12 @@ import (
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	threadmodel "github.com/obot-platform/obot/pkg/thread"
+	"github.com/obot-platform/obot/pkg/toolapprovalpolicy"
 	"github.com/obot-platform/obot/pkg/wait"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ktypes "k8s.io/apimachinery/pkg/types"
@@ -40,11 +49,13 @@ import (
 
 var (
 	log              = logger.Package()
+	tracer           = otel.Tracer("obot/invoke")
 	ephemeralCounter atomic.Int32
 )
 
 const (
 	ephemeralRunPrefix = "ephemeral-run"
+	groupIDsCacheTTL   = 5 * time.Minute
 	runOutputMaxLength = 2000
 )
 
@@ -56,6 +67,8 @@ type Invoker struct {
 	serverURL                string
 	internalServerURL        string
 	autonomousToolUseEnabled bool
+	// groupIDs caches the auth provider group IDs of the user of a thread by thread name.
+	groupIDs *expirable.LRU[string, []string]
 }
 
 func NewInvoker(c kclient.WithWatch, gatewayClient *client.Client, serverURL string, serverPort int, tokenService *persistent.TokenService, events *events.Emitter, autonomousToolUseEnabled bool) *Invoker {
@@ -67,6 +80,7 @@ func NewInvoker(c kclient.WithWatch, gatewayClient *client.Client, serverURL str
 		serverURL:                serverURL,
 		internalServerURL:        fmt.Sprintf("http://localhost:%d", serverPort),
 		autonomousToolUseEnabled: autonomousToolUseEnabled,
+		groupIDs:                 expirable.NewLRU[string, []string](1000, nil, groupIDsCacheTTL),
 	}
 }
 
@@ -569,6 +583,20 @@ func (i *Invoker) createRun(ctx context.Context, gptClient *gptscript.GPTScript,
 }
 
 func (i *Invoker) Resume(ctx context.Context, gptClient *gptscript.GPTScript, c kclient.WithWatch, thread *v1.Thread, run *v1.Run) (err error) {
+	// The MCP tool calls of the run are children of this span.
+	ctx, span := tracer.Start(ctx, "run", trace.WithAttributes(
+		attribute.String("obot.run.id", run.Name),
+		attribute.String("obot.thread.id", thread.Name),
+		attribute.String("obot.agent.id", run.Spec.AgentName),
+		attribute.String("obot.workflow.id", run.Spec.WorkflowName),
+	))
+	defer func() {
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	defer func() {
 		if err != nil {
 			errStr, _, _ := strings.Cut(err.Error(), ": exit status")
@@ -633,6 +661,7 @@ func (i *Invoker) Resume(ctx context.Context, gptClient *gptscript.GPTScript, c
 	var (
 		userAutonomousToolUseEnabled              *bool
 		userID, userName, userEmail, userTimezone string
+		authGroupIDs                              []string
 		// For runs that are not from a user, ensure the token has the basic and authenticated groups.
 		userGroups = []string{types.GroupBasic, types.GroupAuthenticated}
 	)
@@ -649,6 +678,10 @@ func (i *Invoker) Resume(ctx context.Context, gptClient *gptscript.GPTScript, c
 
 		// Add groups based on user's role
 		userGroups = u.Role.Groups()
+
+		if authGroupIDs, err = i.groupIDsForThread(ctx, thread, u.ID); err != nil {
+			return fmt.Errorf("failed to list groups for user: %w", err)
+		}
 	}
 
 	model, modelProvider, err := threadmodel.GetModelAndModelProviderForThread(ctx, c, thread)
@@ -704,9 +737,22 @@ func (i *Invoker) Resume(ctx context.Context, gptClient *gptscript.GPTScript, c
 		(userAutonomousToolUseEnabled != nil && *userAutonomousToolUseEnabled) ||
 		slices.Contains(thread.Spec.ApprovedTools, "*")
 
+	var policies v1.ToolApprovalPolicyList
+	if err := c.List(ctx, &policies, kclient.InNamespace(thread.Namespace)); err != nil {
+		return fmt.Errorf("failed to list tool approval policies: %w", err)
+	}
+
+	approval := toolApproval{
+		policies:    toolapprovalpolicy.ForUser(policies.Items, userID, authGroupIDs),
+		userID:      userID,
+		groupIDs:    authGroupIDs,
+		autonomous:  autonomousToolUseEnabled,
+		interactive: !thread.Spec.SystemTask && thread.Spec.WorkflowName == "",
+	}
+
 	options := gptscript.Options{
 		GlobalOptions: gptscript.GlobalOptions{
-			Env: append(run.Spec.Env,
+			Env: append(append(run.Spec.Env, mcp.TraceEnv(ctx)...),
 				fmt.Sprintf("GPTSCRIPT_MODEL_PROVIDER_PROXY_URL=%s/api/llm-proxy", i.internalServerURL),
 				"GPTSCRIPT_MODEL_PROVIDER_PROXY_TOKEN="+token,
 				"GPTSCRIPT_MODEL_PROVIDER_TOKEN="+token,
@@ -740,7 +786,9 @@ func (i *Invoker) Resume(ctx context.Context, gptClient *gptscript.GPTScript, c
 		IncludeEvents:      true,
 		ForceSequential:    true,
 		Prompt:             true,
-		Confirm:            !autonomousToolUseEnabled,
+		// Tool approval policies are evaluated when a call needs confirmation, so keep confirmation on when they can
+		// reject a call.
+		Confirm: approval.confirm(),
 	}
 
 	if len(run.Spec.Tool) == 0 {
@@ -786,7 +834,7 @@ func (i *Invoker) Resume(ctx context.Context, gptClient *gptscript.GPTScript, c
 		return fmt.Errorf("invalid tool definition: %s", run.Spec.Tool)
 	}
 
-	if err := i.stream(ctx, gptClient, c, thread, run, runResp); err != nil {
+	if err := i.stream(ctx, gptClient, c, thread, run, runResp, approval); err != nil {
 		return fmt.Errorf("failed to stream: %w", err)
 	}
 
@@ -825,6 +873,21 @@ func (i *Invoker) saveState(ctx context.Context, c kclient.Client, thread *v1.Th
 	return retErr
 }
 
+// providerStatusCode matches the HTTP status code in the errors of failed model provider requests.
+var providerStatusCode = regexp.MustCompile(`status code: (\d{3})\b`)
+
+// errorReason classifies the error of a failed run by the type of the error that ended it, or by the status code of
+// the model provider's response.
+func errorReason(retErr error, errOutput string) v1.ErrorReason {
+	if retErr != nil && mcp.IsLaunchFailure(retErr) {
+		return v1.ErrorReasonMCPServerLaunch
+	}
+	if m := providerStatusCode.FindStringSubmatch(errOutput); m != nil && m[1] == strconv.Itoa(http.StatusTooManyRequests) {
+		return v1.ErrorReasonRateLimit
+	}
+	return ""
+}
+
 func (i *Invoker) doSaveState(ctx context.Context, c kclient.Client, thread *v1.Thread, run *v1.Run, runResp *gptscript.Run, retErr error) error {
 	var (
 		runStateSpec gtypes.RunState
@@ -927,6 +990,7 @@ func (i *Invoker) doSaveState(ctx context.Context, c kclient.Client, thread *v1.
 		}
 		if run.Status.Error != errString {
 			run.Status.Error = errString
+			run.Status.ErrorReason = errorReason(retErr, errString)
 			runChanged = true
 		}
 	case v1.Continue, v1.Finished, v1.Waiting:
@@ -956,6 +1020,9 @@ func (i *Invoker) doSaveState(ctx context.Context, c kclient.Client, thread *v1.
 		if run.Status.Error == "" {
 			run.Status.Error = retErr.Error()
 		}
+		if run.Status.ErrorReason == "" {
+			run.Status.ErrorReason = errorReason(retErr, run.Status.Error)
+		}
 		runChanged = true
 	}
 
@@ -1023,7 +1090,7 @@ func getCredentialCallingTool(runResp *gptscript.Run) (result gptscript.Tool) {
 	return
 }
 
-func (i *Invoker) stream(ctx context.Context, gptClient *gptscript.GPTScript, c kclient.WithWatch, thread *v1.Thread, run *v1.Run, runResp *gptscript.Run) (retErr error) {
+func (i *Invoker) stream(ctx context.Context, gptClient *gptscript.GPTScript, c kclient.WithWatch, thread *v1.Thread, run *v1.Run, runResp *gptscript.Run, approval toolApproval) (retErr error) {
 	var (
 		runEvent = runResp.Events()
 		wg       sync.WaitGroup
@@ -1181,12 +1248,17 @@ func (i *Invoker) stream(ctx context.Context, gptClient *gptscript.GPTScript, c
 						latestThread = *thread.DeepCopy()
 					}
 
-					// Auto-confirm pre-approved tools.
-					if frame.Call.ToolCategory != gptscript.NoCategory || isApprovedTool(toolName, latestThread.Spec.ApprovedTools) {
-						if err := gptClient.Confirm(runCtx, gptscript.AuthResponse{
+					// Decide without asking the user when possible, and log where the decision came from.
+					if accept, source, decided := approval.decide(frame.Call, latestThread.Spec.ApprovedTools); decided {
+						log.Infof("Tool call decided without confirmation: run=%s, call=%s, tool=%q, accept=%t, source=%s", run.Name, callID, toolName, accept, source)
+						response := gptscript.AuthResponse{
 							ID:     callID,
-							Accept: true,
-						}); err != nil {
+							Accept: accept,
+						}
+						if !accept {
+							response.Message = fmt.Sprintf("The call to %s was denied by an administrator policy.", toolName)
+						}
+						if err := gptClient.Confirm(runCtx, response); err != nil {
 							return err
 						}
 						break
@@ -1242,6 +1314,7 @@ func watchRunCallDecision(
 			return false, nil
 		}
 
+		log.Infof("Tool call decided by user: run=%s, call=%s, accept=%t, source=user", run.Name, callID, accept)
 		return true, gptClient.Confirm(ctx, gptscript.AuthResponse{
 			ID:     callID,
 			Accept: accept,
@@ -1287,6 +1360,21 @@ func addRequestedCallDecision(
 	return c.Patch(ctx, run, kclient.RawPatch(ktypes.JSONPatchType, patchBytes))
 }
 
+// groupIDsForThread returns the auth provider group IDs of the user of the thread. They are cached so that each turn of
+// a thread doesn't list them again, which means group changes apply to a thread within groupIDsCacheTTL.
+func (i *Invoker) groupIDsForThread(ctx context.Context, thread *v1.Thread, userID uint) ([]string, error) {
+	if groupIDs, ok := i.groupIDs.Get(thread.Name); ok {
+		return groupIDs, nil
+	}
+
+	groupIDs, err := i.gatewayClient.ListGroupIDsForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	i.groupIDs.Add(thread.Name, groupIDs)
+	return groupIDs, nil
+}
+
 func timeoutAfter(ctx context.Context, cancel func(err error), d time.Duration) {
 	select {
 	case <-ctx.Done():
@@ -1294,28 +1382,3 @@ func timeoutAfter(ctx context.Context, cancel func(err error), d time.Duration)
 		cancel(fmt.Errorf("run exceeded maximum time of %v", d))
 	}
 }
-
-// isApprovedTool returns true IFF a tool has been approved for use in the set of approved tools.
-// It returns true when toolName matches a pattern in the approvedTools list.
-func isApprovedTool(toolName string, approvedTools []string) bool {
-	if toolName == "" {
-		return false
-	}
-
-	for _, approved := range approvedTools {
-		if prefix, hasWildcard := strings.CutSuffix(approved, "*"); hasWildcard {
-			if strings.HasPrefix(toolName, prefix) {
-				// Trailing wildcard - match tools with the prefix
-				// If prefix is empty (approved == "*"), this matches all tools
-				return true
-			}
-			continue
-		}
-
-		// No wildcard, exact match required
-		if toolName == approved {
-			return true
-		}
-	}
-	return false
-}
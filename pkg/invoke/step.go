@@ -2,6 +2,7 @@ package invoke
 
 import (
 	"context"
+	"strings"
 
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/obot-platform/nah/pkg/router"
@@ -13,6 +14,8 @@ import (
 type StepOptions struct {
 	PreviousRunName string
 	IgnoreMCPErrors bool
+	// Context is added before the step's prompt, like the outputs of steps that completed without running the agent.
+	Context []string
 }
 
 func (i *Invoker) Step(ctx context.Context, mcpSessionManager *mcp.SessionManager, gptClient *gptscript.GPTScript, c kclient.WithWatch, step *v1.WorkflowStep, opt StepOptions) (*Response, error) {
@@ -20,6 +23,9 @@ func (i *Invoker) Step(ctx context.Context, mcpSessionManager *mcp.SessionManage
 	if err != nil {
 		return nil, err
 	}
+	if len(opt.Context) > 0 {
+		input = strings.Join(opt.Context, "\n\n") + "\n\n" + input
+	}
 
 	var wfe v1.WorkflowExecution
 	if err := c.Get(ctx, router.Key(step.Namespace, step.Spec.WorkflowExecutionName), &wfe); err != nil {
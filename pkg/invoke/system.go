@@ -14,6 +14,7 @@ import (
 	"github.com/obot-platform/obot/pkg/render"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type SystemTaskOptions struct {
@@ -109,6 +110,36 @@ func (i *Invoker) EphemeralThreadTask(ctx context.Context, mcpSessionManager *mc
 	return result.String(), nil
 }
 
+// ThreadTask starts a run of the tool in the thread that doesn't continue the thread's conversation. Unlike
+// EphemeralThreadTask, the run is stored and processed by the run controller, so the caller doesn't wait for it and
+// reads the result from the status of the run.
+func (i *Invoker) ThreadTask(ctx context.Context, c kclient.WithWatch, thread *v1.Thread, tool, input any, opts ...SystemTaskOptions) (*v1.Run, error) {
+	opt := complete(opts)
+
+	inputString, err := inputToString(input)
+	if err != nil {
+		return nil, err
+	}
+
+	credContexts := []string{thread.Name}
+	if thread.Spec.AgentName != "" {
+		credContexts = append(credContexts, thread.Spec.AgentName)
+	}
+	credContexts = append(credContexts, thread.Namespace)
+
+	resp, err := i.createRun(ctx, nil, c, thread, tool, inputString, runOptions{
+		ForceNoResume:        true,
+		Env:                  opt.Env,
+		CredentialContextIDs: append(opt.CredentialContextIDs, credContexts...),
+		Timeout:              opt.Timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Close()
+	return resp.Run, nil
+}
+
 func inputToString(input any) (string, error) {
 	var inputString string
 	switch v := input.(type) {
@@ -0,0 +1,73 @@
+package invoke
+
+import (
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/toolapprovalpolicy"
+)
+
+// toolApproval holds what is needed to decide tool calls of a run without asking the user.
+type toolApproval struct {
+	// policies are the tool approval policies that apply to the user of the run.
+	policies []v1.ToolApprovalPolicy
+	userID   string
+	groupIDs []string
+	// autonomous is true when tool calls don't need confirmation unless a policy requires it.
+	autonomous bool
+	// interactive is false for system tasks and workflows, where there is no user to confirm tool calls.
+	interactive bool
+}
+
+// confirm returns true if tool calls of the run have to be confirmed, either by the user or by a policy. When tool
+// use is autonomous, only policies that can reject a call need to see it.
+func (a toolApproval) confirm() bool {
+	if !a.autonomous {
+		return true
+	}
+
+	for _, policy := range a.policies {
+		manifest := policy.Spec.Manifest
+		if len(manifest.DeniedTools) > 0 {
+			return true
+		}
+		if len(manifest.ConfirmTools) > 0 || manifest.ConfirmDestructive {
+			if a.interactive || toolapprovalpolicy.NonInteractiveDecision(manifest) == types.ToolApprovalDecisionDeny {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// decide returns whether the tool call should be accepted and the source of the decision. It returns false for
+// decided when the user should be asked to confirm the call.
+func (a toolApproval) decide(call *gptscript.CallFrame, approvedTools []string) (accept bool, source string, decided bool) {
+	if call.ToolCategory != gptscript.NoCategory {
+		return true, "tool category", true
+	}
+
+	toolName := call.Tool.Name
+	result := toolapprovalpolicy.Evaluate(a.policies, a.userID, a.groupIDs, toolName, toolapprovalpolicy.AnnotationsFromMetadata(call.Tool.MetaData))
+	switch result.Decision {
+	case types.ToolApprovalDecisionDeny:
+		return false, result.Source(), true
+	case types.ToolApprovalDecisionConfirm:
+		if !a.interactive {
+			// Nobody can confirm the call, so the policy decides it instead.
+			return result.NonInteractive == types.ToolApprovalDecisionApprove, result.Source() + " (no user to confirm)", true
+		}
+		return false, "", false
+	case types.ToolApprovalDecisionApprove:
+		return true, result.Source(), true
+	}
+
+	if toolapprovalpolicy.MatchesTool(toolName, approvedTools) {
+		return true, "thread approval", true
+	}
+	if a.autonomous {
+		return true, "autonomous tool use", true
+	}
+
+	return false, "", false
+}
@@ -0,0 +1,54 @@
+package invoke
+
+import (
+	"testing"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func confirmPolicy(name string, nonInteractive types.ToolApprovalDecision) v1.ToolApprovalPolicy {
+	return v1.ToolApprovalPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1.ToolApprovalPolicySpec{Manifest: types.ToolApprovalPolicyManifest{
+			Subjects:           []types.Subject{{Type: types.SubjectTypeSelector, ID: "*"}},
+			ConfirmDestructive: true,
+			NonInteractive:     nonInteractive,
+		}},
+	}
+}
+
+func TestToolApprovalNonInteractive(t *testing.T) {
+	call := &gptscript.CallFrame{CallContext: gptscript.CallContext{Tool: gptscript.Tool{
+		ToolDef: gptscript.ToolDef{
+			Name:     "GitHub -> close_issue",
+			MetaData: map[string]string{"mcp-tool-annotations": `{"destructiveHint":true}`},
+		},
+	}}}
+
+	interactive := toolApproval{
+		policies:    []v1.ToolApprovalPolicy{confirmPolicy("org", "")},
+		autonomous:  true,
+		interactive: true,
+	}
+	assert.True(t, interactive.confirm())
+	_, _, decided := interactive.decide(call, nil)
+	assert.False(t, decided, "expected the user to be asked")
+
+	// Tasks and workflows approve the call by default, so calls don't need to be confirmed at all.
+	task := interactive
+	task.interactive = false
+	assert.False(t, task.confirm())
+	accept, source, decided := task.decide(call, nil)
+	assert.True(t, accept && decided)
+	assert.Equal(t, "tool approval policy org (no user to confirm)", source)
+
+	task.policies = []v1.ToolApprovalPolicy{confirmPolicy("org", types.ToolApprovalDecisionDeny)}
+	assert.True(t, task.confirm())
+	accept, _, decided = task.decide(call, nil)
+	assert.False(t, accept)
+	assert.True(t, decided)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -49,8 +50,42 @@ var (
 	ErrPodSchedulingFailed    = errors.New("pod could not be scheduled")
 	ErrPodConfigurationFailed = errors.New("pod configuration is invalid")
 	ErrInsufficientCapacity   = errors.New("insufficient cluster capacity to deploy MCP server")
+	ErrContainerStartFailed   = errors.New("failed to start container")
 )
 
+// launchFailureReasons name the errors of failed launches in metrics. Other errors are reported as other.
+var launchFailureReasons = []struct {
+	err    error
+	reason string
+}{
+	{ErrHealthCheckTimeout, "ErrHealthCheckTimeout"},
+	{ErrHealthCheckFailed, "ErrHealthCheckFailed"},
+	{ErrPodCrashLoopBackOff, "ErrPodCrashLoopBackOff"},
+	{ErrImagePullFailed, "ErrImagePullFailed"},
+	{ErrPodSchedulingFailed, "ErrPodSchedulingFailed"},
+	{ErrPodConfigurationFailed, "ErrPodConfigurationFailed"},
+	{ErrInsufficientCapacity, "ErrInsufficientCapacity"},
+	{ErrContainerStartFailed, "ErrContainerStartFailed"},
+}
+
+// IsLaunchFailure returns true if the error is one of the errors of failed MCP server launches.
+func IsLaunchFailure(err error) bool {
+	reason := launchFailureReason(err)
+	return reason != "" && reason != "other"
+}
+
+func launchFailureReason(err error) string {
+	if err == nil {
+		return ""
+	}
+	for _, r := range launchFailureReasons {
+		if errors.Is(err, r.err) {
+			return r.reason
+		}
+	}
+	return "other"
+}
+
 func ensureServerReady(ctx context.Context, url string, server ServerConfig) error {
 	// Ensure we can actually hit the service URL.
 	ctx, cancel := context.WithTimeout(ctx, time.Minute)
@@ -176,30 +211,38 @@ func webhookToServerConfig(webhook Webhook, baseImage, mcpServerName, userID, sc
 	}, nil
 }
 
-func constructNanobotYAMLForCompositeServer(servers []ComponentServer) (string, error) {
-	mcpServers := make(map[string]nanobotConfigMCPServer, len(servers))
-	names := make([]string, 0, len(servers))
-	replacer := strings.NewReplacer("/", "-", ":", "-", "?", "-")
+func constructNanobotYAMLForCompositeServer(servers []ComponentServer, conflictPolicy types.ToolConflictPolicy) (string, error) {
+	components, err := resolveCompositeTools(servers, conflictPolicy)
+	if err != nil {
+		return "", err
+	}
 
-	for _, component := range servers {
-		tools := make(map[string]toolOverride, len(component.Tools))
-		for _, tool := range component.Tools {
-			if !tool.Enabled {
-				continue
-			}
-			tools[tool.Name] = toolOverride{
-				Name:        tool.OverrideName,
+	mcpServers := make(map[string]nanobotConfigMCPServer, len(components))
+	names := make([]string, 0, len(components))
+	for _, component := range components {
+		if component.restricted && len(component.tools) == 0 {
+			// All of this component's tools lost a conflict. Leave it out entirely, because a component
+			// without tool overrides exposes all of its tools.
+			continue
+		}
+
+		tools := make(map[string]toolOverride, len(component.tools))
+		for _, tool := range component.tools {
+			override := toolOverride{
 				Description: tool.OverrideDescription,
 			}
+			if tool.exposedName != tool.Name {
+				override.Name = tool.exposedName
+			}
+			tools[tool.Name] = override
 		}
 
-		name := replacer.Replace(component.Name)
-		mcpServers[name] = nanobotConfigMCPServer{
-			BaseURL:       component.URL,
+		mcpServers[component.name] = nanobotConfigMCPServer{
+			BaseURL:       component.url,
 			ToolOverrides: tools,
 		}
 
-		names = append(names, name)
+		names = append(names, component.name)
 	}
 
 	config := nanobotConfig{
@@ -217,6 +260,116 @@ func constructNanobotYAMLForCompositeServer(servers []ComponentServer) (string,
 	return string(data), nil
 }
 
+// CompositeToolArguments returns the argument overrides of the tools exposed by a composite server, keyed by the names
+// the tools are exposed with. Nanobot's tool overrides can only rename tools and replace their descriptions or input
+// schemas, so the arguments can't be written into the composite's config and the gateway applies them instead.
+func CompositeToolArguments(server ServerConfig) (map[string][]types.ToolArgumentOverride, error) {
+	if server.Runtime != types.RuntimeComposite {
+		return nil, nil
+	}
+
+	components, err := resolveCompositeTools(server.Components, server.ToolConflictPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var arguments map[string][]types.ToolArgumentOverride
+	for _, component := range components {
+		for _, tool := range component.tools {
+			if len(tool.Arguments) == 0 {
+				continue
+			}
+			if arguments == nil {
+				arguments = make(map[string][]types.ToolArgumentOverride)
+			}
+			arguments[tool.exposedName] = tool.Arguments
+		}
+	}
+
+	return arguments, nil
+}
+
+type compositeComponent struct {
+	name string
+	url  string
+	// restricted is true if the component has tool overrides, so only the listed tools are exposed.
+	restricted bool
+	tools      []compositeTool
+}
+
+type compositeTool struct {
+	types.ToolOverride
+	exposedName string
+}
+
+// resolveCompositeTools returns the components of a composite server with their enabled tools and the names they are
+// exposed with once conflicts are resolved. Components are considered in order of their names, and tools that lose a
+// conflict are left out.
+func resolveCompositeTools(servers []ComponentServer, conflictPolicy types.ToolConflictPolicy) ([]compositeComponent, error) {
+	servers = slices.SortedStableFunc(slices.Values(servers), func(a, b ComponentServer) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	replacer := strings.NewReplacer("/", "-", ":", "-", "?", "-")
+
+	// Count the components exposing each tool name so that conflicts can be resolved.
+	exposedCount := make(map[string]int)
+	for _, component := range servers {
+		for _, tool := range component.Tools {
+			if tool.Enabled {
+				exposedCount[exposedToolName(tool)]++
+			}
+		}
+	}
+
+	components := make([]compositeComponent, 0, len(servers))
+	exposed := make(map[string]struct{}, len(exposedCount))
+	for _, component := range servers {
+		resolved := compositeComponent{
+			name:       replacer.Replace(component.Name),
+			url:        component.URL,
+			restricted: len(component.Tools) > 0,
+		}
+
+		for _, tool := range component.Tools {
+			if !tool.Enabled {
+				continue
+			}
+
+			exposedName := exposedToolName(tool)
+			if exposedCount[exposedName] > 1 {
+				switch conflictPolicy {
+				case types.ToolConflictPolicyError:
+					return nil, fmt.Errorf("tool %s is exposed by more than one component server", exposedName)
+				case types.ToolConflictPolicyFirst:
+					if _, ok := exposed[exposedName]; ok {
+						continue
+					}
+					exposed[exposedName] = struct{}{}
+				case types.ToolConflictPolicyPrefix:
+					exposedName = fmt.Sprintf("%s_%s", resolved.name, exposedName)
+				}
+			}
+
+			resolved.tools = append(resolved.tools, compositeTool{
+				ToolOverride: tool,
+				exposedName:  exposedName,
+			})
+		}
+
+		components = append(components, resolved)
+	}
+
+	return components, nil
+}
+
+// exposedToolName returns the name a component tool is exposed with before conflicts are resolved.
+func exposedToolName(tool types.ToolOverride) string {
+	if tool.OverrideName != "" {
+		return tool.OverrideName
+	}
+	return tool.Name
+}
+
 func constructNanobotYAMLForServer(name, url, command string, args []string, env, headers map[string]string, webhooks []Webhook) (string, error) {
 	replacer := strings.NewReplacer("/", "-", ":", "-", "?", "-")
 
@@ -0,0 +1,163 @@
+package mcp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/oasdiff/yaml"
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+// compositeTestServers are out of name order, to check that conflicts are resolved in order of the component names.
+var compositeTestServers = []ComponentServer{
+	{
+		Name: "gitlab",
+		URL:  "http://localhost:8080/mcp-connect/gitlab",
+		Tools: []types.ToolOverride{
+			{Name: "search", Enabled: true},
+		},
+	},
+	{
+		Name: "github",
+		URL:  "http://localhost:8080/mcp-connect/github",
+		Tools: []types.ToolOverride{
+			{
+				Name:    "search",
+				Enabled: true,
+				Arguments: []types.ToolArgumentOverride{
+					{Name: "sort", Default: json.RawMessage(`"updated"`)},
+				},
+			},
+			{
+				Name:    "list_issues",
+				Enabled: true,
+				Arguments: []types.ToolArgumentOverride{
+					{Name: "owner", Default: json.RawMessage(`"our-org"`), Hidden: true},
+				},
+			},
+		},
+	},
+}
+
+func TestConstructNanobotYAMLForCompositeServer(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        types.ToolConflictPolicy
+		expectErr     bool
+		expectServers []string
+		expectTools   map[string]map[string]toolOverride
+	}{
+		{
+			name:          "leave conflicting tools unchanged by default",
+			expectServers: []string{"github", "gitlab"},
+			expectTools: map[string]map[string]toolOverride{
+				"github": {
+					"search":      {},
+					"list_issues": {},
+				},
+				"gitlab": {
+					"search": {},
+				},
+			},
+		},
+		{
+			name:          "prefix conflicting tools",
+			policy:        types.ToolConflictPolicyPrefix,
+			expectServers: []string{"github", "gitlab"},
+			expectTools: map[string]map[string]toolOverride{
+				"github": {
+					"search":      {Name: "github_search"},
+					"list_issues": {},
+				},
+				"gitlab": {
+					"search": {Name: "gitlab_search"},
+				},
+			},
+		},
+		{
+			name:          "first component by name wins",
+			policy:        types.ToolConflictPolicyFirst,
+			expectServers: []string{"github"},
+			expectTools: map[string]map[string]toolOverride{
+				"github": {
+					"search":      {},
+					"list_issues": {},
+				},
+			},
+		},
+		{
+			name:      "error on conflict",
+			policy:    types.ToolConflictPolicyError,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := constructNanobotYAMLForCompositeServer(compositeTestServers, tt.policy)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var config nanobotConfig
+			if err := yaml.Unmarshal([]byte(data), &config); err != nil {
+				t.Fatalf("failed to unmarshal nanobot config: %v", err)
+			}
+
+			if len(config.Publish.MCPServers) != len(tt.expectServers) {
+				t.Fatalf("expected published servers %v, got %v", tt.expectServers, config.Publish.MCPServers)
+			}
+			for i, name := range tt.expectServers {
+				if config.Publish.MCPServers[i] != name {
+					t.Errorf("expected published server %d to be %s, got %s", i, name, config.Publish.MCPServers[i])
+				}
+			}
+
+			for serverName, expectedTools := range tt.expectTools {
+				tools := config.MCPServers[serverName].ToolOverrides
+				if len(tools) != len(expectedTools) {
+					t.Fatalf("expected %d tools for %s, got %d", len(expectedTools), serverName, len(tools))
+				}
+				for toolName, expected := range expectedTools {
+					if actual := tools[toolName]; actual != expected {
+						t.Errorf("expected %s/%s to be overridden with %+v, got %+v", serverName, toolName, expected, actual)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCompositeToolArguments(t *testing.T) {
+	server := ServerConfig{
+		Runtime:            types.RuntimeComposite,
+		Components:         compositeTestServers,
+		ToolConflictPolicy: types.ToolConflictPolicyPrefix,
+	}
+
+	arguments, err := CompositeToolArguments(server)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(arguments) != 2 {
+		t.Fatalf("expected arguments for 2 tools, got %v", arguments)
+	}
+	if search := arguments["github_search"]; len(search) != 1 || string(search[0].Default) != `"updated"` {
+		t.Errorf("expected the arguments of search to be keyed by its prefixed name, got %v", arguments)
+	}
+	if listIssues := arguments["list_issues"]; len(listIssues) != 1 || !listIssues[0].Hidden {
+		t.Errorf("expected the owner argument of list_issues to be hidden, got %v", listIssues)
+	}
+
+	server.Runtime = types.RuntimeRemote
+	if arguments, err := CompositeToolArguments(server); err != nil || arguments != nil {
+		t.Errorf("expected no arguments for servers that aren't composite, got %v, %v", arguments, err)
+	}
+}
@@ -42,6 +42,7 @@ type dockerBackend struct {
 	remoteShimBaseImage           string
 	auditLogsBatchSize            int
 	auditLogsFlushIntervalSeconds int
+	otelExportEndpoint            string
 }
 
 func newDockerBackend(ctx context.Context, exposedPort int, opts Options) (backend, error) {
@@ -78,6 +79,7 @@ func newDockerBackend(ctx context.Context, exposedPort int, opts Options) (backe
 		remoteShimBaseImage:           opts.MCPRemoteShimBaseImage,
 		auditLogsBatchSize:            opts.MCPAuditLogsPersistBatchSize,
 		auditLogsFlushIntervalSeconds: opts.MCPAuditLogPersistIntervalSeconds,
+		otelExportEndpoint:            opts.MCPOtelExportEndpoint,
 	}
 	if err = d.cleanupContainersWithOldID(ctx); err != nil {
 		return nil, fmt.Errorf("failed to cleanup containers with old ID: %w", err)
@@ -269,7 +271,7 @@ func (d *dockerBackend) ensureDeployment(ctx context.Context, server ServerConfi
 		case container.StateCreated:
 			// Container exists and is created, start it and wait for it to be ready.
 			if err := d.client.ContainerStart(ctx, existing.ID, container.StartOptions{}); err != nil {
-				return ServerConfig{}, fmt.Errorf("failed to start container: %w", err)
+				return ServerConfig{}, fmt.Errorf("%w: %w", ErrContainerStartFailed, err)
 			}
 
 			if err := d.waitForContainer(ctx, existing.ID); err != nil {
@@ -687,6 +689,15 @@ func (d *dockerBackend) createAndStartContainer(ctx context.Context, server Serv
 		}
 	}
 
+	// Pass the trace settings to the MCP server. Remote and composite servers don't run a server process.
+	if server.Runtime != otypes.RuntimeRemote && server.Runtime != otypes.RuntimeComposite {
+		otelVars := otelEnv(d.otelExportEndpoint, mcpServerName)
+		server.Env = slices.Clone(server.Env)
+		for _, k := range slices.Sorted(maps.Keys(otelVars)) {
+			server.Env = append(server.Env, k+"="+otelVars[k])
+		}
+	}
+
 	// Configure based on runtime
 	switch server.Runtime {
 	case otypes.RuntimeUVX, otypes.RuntimeNPX, otypes.RuntimeRemote, otypes.RuntimeComposite:
@@ -847,7 +858,7 @@ func (d *dockerBackend) createAndStartContainer(ctx context.Context, server Serv
 
 	// Start container
 	if err := d.client.ContainerStart(ctx, containerID, container.StartOptions{}); err != nil {
-		return "", 0, fmt.Errorf("failed to start container: %w", err)
+		return "", 0, fmt.Errorf("%w: %w", ErrContainerStartFailed, err)
 	}
 
 	return containerID, containerPort, nil
@@ -862,7 +873,7 @@ func (d *dockerBackend) waitForContainer(ctx context.Context, containerID string
 	for {
 		select {
 		case <-timeout:
-			return fmt.Errorf("timeout waiting for container to start")
+			return fmt.Errorf("%w: timed out waiting for it to run", ErrContainerStartFailed)
 		case <-ticker.C:
 			inspect, err := d.client.ContainerInspect(ctx, containerID)
 			if err != nil {
@@ -1082,7 +1093,7 @@ func (d *dockerBackend) prepareNanobotConfig(ctx context.Context, server ServerC
 		err         error
 	)
 	if server.Runtime == otypes.RuntimeComposite {
-		nanobotYAML, err = constructNanobotYAMLForCompositeServer(server.Components)
+		nanobotYAML, err = constructNanobotYAMLForCompositeServer(server.Components, server.ToolConflictPolicy)
 	} else {
 		nanobotYAML, err = constructNanobotYAMLForServer(server.MCPServerDisplayName, server.URL, server.Command, server.Args, allEnvVars, headers, webhooks)
 	}
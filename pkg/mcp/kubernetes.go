@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"reflect"
 	"sort"
 	"strconv"
@@ -47,6 +48,7 @@ type kubernetesBackend struct {
 	imagePullSecrets              []string
 	auditLogsBatchSize            int
 	auditLogsFlushIntervalSeconds int
+	otelExportEndpoint            string
 	obotClient                    kclient.Client
 }
 
@@ -68,6 +70,7 @@ func newKubernetesBackend(clientset *kubernetes.Clientset, client kclient.WithWa
 		imagePullSecrets:              opts.MCPImagePullSecrets,
 		auditLogsBatchSize:            opts.MCPAuditLogsPersistBatchSize,
 		auditLogsFlushIntervalSeconds: opts.MCPAuditLogPersistIntervalSeconds,
+		otelExportEndpoint:            opts.MCPOtelExportEndpoint,
 		obotClient:                    obotClient,
 	}
 }
@@ -419,6 +422,11 @@ func (k *kubernetesBackend) k8sObjects(ctx context.Context, server ServerConfig,
 	// API key authentication webhook URL
 	secretEnvStringData["NANOBOT_RUN_APIKEY_AUTH_WEBHOOK_URL"] = k.transformObotHostname(server.Issuer + "/api/api-keys/auth")
 	secretEnvStringData["NANOBOT_RUN_MCPSERVER_ID"] = strings.TrimSuffix(server.MCPServerName, "-shim")
+	// Trace settings, for the MCP server that nanobot runs with this environment. Remote and composite servers don't run
+	// a server process.
+	if server.Runtime != types.RuntimeRemote && server.Runtime != types.RuntimeComposite {
+		maps.Copy(secretEnvStringData, otelEnv(k.otelExportEndpoint, server.MCPServerName))
+	}
 
 	annotations["obot-revision"] = hash.Digest(hash.Digest(secretEnvStringData) + hash.Digest(secretVolumeStringData) + hash.Digest(webhooks))
 
@@ -716,7 +724,7 @@ func (k *kubernetesBackend) k8sObjects(ctx context.Context, server ServerConfig,
 		// Setup the nanobot config file and add it to the last container in the deployment.
 		var nanobotFileString string
 		if server.Runtime == types.RuntimeComposite {
-			nanobotFileString, err = constructNanobotYAMLForCompositeServer(server.Components)
+			nanobotFileString, err = constructNanobotYAMLForCompositeServer(server.Components, server.ToolConflictPolicy)
 			annotations["nanobot-composite-file-rev"] = hash.Digest(nanobotFileString)
 		} else {
 			nanobotFileString, err = constructNanobotYAMLForServer(server.MCPServerDisplayName, server.URL, server.Command, server.Args, secretEnvStringData, headerData, webhooks)
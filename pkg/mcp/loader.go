@@ -8,12 +8,14 @@ import (
 	"net/url"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/gptscript-ai/gptscript/pkg/hash"
 	"github.com/gptscript-ai/gptscript/pkg/types"
 	otypes "github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/metrics"
 	"github.com/obot-platform/obot/pkg/storage"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -49,6 +51,9 @@ type Options struct {
 	MCPAuditLogPersistIntervalSeconds int `usage:"The interval in seconds to persist MCP audit logs to the database" default:"5"`
 	MCPAuditLogsPersistBatchSize      int `usage:"The number of MCP audit logs to persist in a single batch" default:"1000"`
 
+	// Tracing configuration
+	MCPOtelExportEndpoint string `usage:"The OTLP endpoint that MCP servers with OpenTelemetry support export traces to. It must be reachable from MCP servers and is passed to them without credentials." name:"mcp-otel-export-endpoint" env:"OBOT_SERVER_MCP_OTEL_EXPORT_ENDPOINT"`
+
 	// Pod Security Admission configuration for MCP namespace
 	MCPPodSecurityEnabled        bool   `usage:"Enable Pod Security Admission labels on the MCP namespace" default:"true" env:"OBOT_SERVER_MCPPOD_SECURITY_ENABLED"`
 	MCPPodSecurityEnforce        string `usage:"Pod Security Standards level to enforce (privileged, baseline, or restricted)" default:"restricted" env:"OBOT_SERVER_MCPPOD_SECURITY_ENFORCE"`
@@ -345,7 +350,10 @@ func (sm *SessionManager) ensureDeployment(ctx context.Context, server ServerCon
 		}
 	}
 
-	return sm.backend.ensureServerDeployment(ctx, server, webhooks)
+	start := time.Now()
+	deployed, err := sm.backend.ensureServerDeployment(ctx, server, webhooks)
+	metrics.ObserveMCPServerLaunch(string(server.Runtime), time.Since(start), launchFailureReason(err))
+	return deployed, err
 }
 
 func clientID(server ServerConfig) string {
@@ -14,3 +14,13 @@ func (sm *SessionManager) PingServer(ctx context.Context, serverConfig ServerCon
 
 	return client.Ping(ctx)
 }
+
+// PingRunningServer pings a server without deploying it.
+// If the server is not already running, then [ErrServerNotRunning] is returned.
+func (sm *SessionManager) PingRunningServer(ctx context.Context, serverConfig ServerConfig) (*nmcp.PingResult, error) {
+	if _, err := sm.backend.getServerDetails(ctx, serverConfig.MCPServerName); err != nil {
+		return nil, err
+	}
+
+	return sm.PingServer(ctx, serverConfig)
+}
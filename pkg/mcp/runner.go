@@ -9,6 +9,8 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/gptscript-ai/gptscript/pkg/engine"
 	gtypes "github.com/gptscript-ai/gptscript/pkg/types"
+	nmcp "github.com/nanobot-ai/nanobot/pkg/mcp"
+	"go.opentelemetry.io/otel/codes"
 )
 
 // Run is responsible for calling MCP tools when the LLM requests their execution. This method is called by GPTScript.
@@ -72,8 +74,17 @@ func (sm *SessionManager) Run(ctx engine.Context, _ chan<- gtypes.CompletionStat
 		}
 	}
 
-	result, err := session.Call(ctx.Ctx, toolName, arguments)
+	// Continue the trace of the run, which is passed to tools in their environment.
+	callCtx := ctx.Ctx
+	if ctx.Engine != nil {
+		callCtx = contextFromEnv(callCtx, ctx.Engine.Env)
+	}
+	callCtx, span := StartSpan(callCtx, id, "tools/call", toolName)
+	defer span.End()
+
+	result, err := session.Call(callCtx, toolName, arguments, nmcp.CallOption{Meta: TraceMeta(callCtx)})
 	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
 		if ctx.ToolCategory == engine.NoCategory && ctx.Parent != nil {
 			var output []byte
 			if result != nil {
@@ -85,6 +96,10 @@ func (sm *SessionManager) Run(ctx engine.Context, _ chan<- gtypes.CompletionStat
 		return "", fmt.Errorf("failed to call tool %s: %w", toolName, err)
 	}
 
+	if result.IsError {
+		span.SetStatus(codes.Error, "the tool returned an error")
+	}
+
 	str, err := json.Marshal(result)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal result: %w", err)
@@ -25,6 +25,16 @@ func (sm *SessionManager) ListTools(ctx context.Context, serverConfig ServerConf
 	return resp.Tools, nil
 }
 
+// ListRunningServerTools lists the tools of a server without deploying it.
+// If the server is not already running, then [ErrServerNotRunning] is returned.
+func (sm *SessionManager) ListRunningServerTools(ctx context.Context, serverConfig ServerConfig) ([]mcp.Tool, error) {
+	if _, err := sm.backend.getServerDetails(ctx, serverConfig.MCPServerName); err != nil {
+		return nil, err
+	}
+
+	return sm.ListTools(ctx, serverConfig)
+}
+
 func ConvertTools(tools []mcp.Tool, allowedTools, unsupportedTools []string) ([]otypes.MCPServerTool, error) {
 	allTools := allowedTools == nil || slices.Contains(allowedTools, "*")
 
@@ -0,0 +1,117 @@
+package mcp
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("obot/mcp")
+
+// StartSpan starts the span of a request to an MCP server. Like the OpenTelemetry semantic conventions for MCP, the span
+// is named after the JSON-RPC method and, for tool calls, the tool.
+func StartSpan(ctx context.Context, serverID, method, tool string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	name := method
+	attributes := []attribute.KeyValue{
+		attribute.String("obot.mcp_server.id", serverID),
+		attribute.String("mcp.method.name", method),
+	}
+	if tool != "" {
+		name += " " + tool
+		attributes = append(attributes, attribute.String("gen_ai.tool.name", tool))
+	}
+
+	return tracer.Start(ctx, name, append(opts, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))...)
+}
+
+// EndSpan ends the span of a request to an MCP server with the HTTP status code of the response. Codes of 400 and above
+// and JSON-RPC error responses mark the span as failed.
+func EndSpan(span trace.Span, statusCode int, jsonRPCError bool) {
+	span.SetAttributes(attribute.Int("http.response.status_code", statusCode))
+	switch {
+	case statusCode >= 400:
+		span.SetStatus(codes.Error, "")
+	case jsonRPCError:
+		span.SetStatus(codes.Error, "the server returned a JSON-RPC error")
+	}
+	span.End()
+}
+
+// TraceEnv returns the environment variable that carries the trace of ctx into a run, following the OpenTelemetry
+// convention for environment variables. Tool calls of the run continue the trace, see contextFromEnv.
+func TraceEnv(ctx context.Context) []string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	var env []string
+	for key, value := range carrier {
+		env = append(env, strings.ToUpper(key)+"="+value)
+	}
+	return env
+}
+
+// contextFromEnv returns ctx with the trace that TraceEnv added to the environment of a run, if any.
+func contextFromEnv(ctx context.Context, env []string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, envCarrier(env))
+}
+
+// TraceMeta returns the trace of ctx as the _meta of an MCP request. The nanobot shim doesn't forward trace headers to
+// MCP servers, but it passes _meta on, so servers can continue the trace from it.
+func TraceMeta(ctx context.Context) map[string]any {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+
+	meta := make(map[string]any, len(carrier))
+	for key, value := range carrier {
+		meta[key] = value
+	}
+	return meta
+}
+
+// envCarrier reads the propagation fields from environment variables, which have the upper case names of the fields.
+type envCarrier []string
+
+func (e envCarrier) Get(key string) string {
+	prefix := strings.ToUpper(key) + "="
+	for _, env := range e {
+		if value, ok := strings.CutPrefix(env, prefix); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+func (e envCarrier) Set(string, string) {}
+
+func (e envCarrier) Keys() []string {
+	keys := make([]string, 0, len(e))
+	for _, env := range e {
+		if key, _, ok := strings.Cut(env, "="); ok {
+			keys = append(keys, strings.ToLower(key))
+		}
+	}
+	return keys
+}
+
+// otelEnv returns the environment variables that configure OpenTelemetry in MCP servers that support it. The nanobot
+// shim doesn't export traces, so they are only passed to server processes. They only sample traces that the gateway or
+// a run sampled, so their spans continue the traces of Obot.
+func otelEnv(endpoint, serverName string) map[string]string {
+	if endpoint == "" {
+		return nil
+	}
+	return map[string]string{
+		"OTEL_EXPORTER_OTLP_ENDPOINT": endpoint,
+		"OTEL_SERVICE_NAME":           serverName,
+		"OTEL_PROPAGATORS":            "tracecontext,baggage",
+		"OTEL_TRACES_SAMPLER":         "parentbased_always_off",
+	}
+}
@@ -0,0 +1,40 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracePropagation(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	ctx, run := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "run")
+	defer run.End()
+
+	env := TraceEnv(ctx)
+	if len(env) != 1 {
+		t.Fatalf("expected a TRACEPARENT variable, got %v", env)
+	}
+
+	// The tool call of a run continues the trace of the run, and passes it on in the _meta of the request.
+	callCtx := contextFromEnv(context.Background(), append([]string{"OBOT_RUN_ID=run1"}, env...))
+	parent := trace.SpanContextFromContext(callCtx)
+	if parent.TraceID() != run.SpanContext().TraceID() || parent.SpanID() != run.SpanContext().SpanID() {
+		t.Fatalf("expected the span of the run as parent, got %v", parent)
+	}
+
+	meta := TraceMeta(callCtx)
+	traceparent, _ := meta["traceparent"].(string)
+	if traceparent == "" || "TRACEPARENT="+traceparent != env[0] {
+		t.Errorf("expected the traceparent of the run in the request meta, got %v", meta)
+	}
+
+	if meta := TraceMeta(context.Background()); meta != nil {
+		t.Errorf("expected no meta without a trace, got %v", meta)
+	}
+}
@@ -2,7 +2,9 @@ package mcp
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
 	"regexp"
 	"slices"
 	"strings"
@@ -45,7 +47,8 @@ type ServerConfig struct {
 	ContainerPath  string `json:"containerPath"`
 
 	// Composite configuration.
-	Components []ComponentServer `json:"components"`
+	Components         []ComponentServer        `json:"components"`
+	ToolConflictPolicy types.ToolConflictPolicy `json:"toolConflictPolicy"`
 
 	Scope                string `json:"scope"`
 	UserID               string `json:"userID"`
@@ -203,6 +206,12 @@ func CompositeServerToServerConfig(mcpServer v1.MCPServer, components []v1.MCPSe
 		}
 	}
 
+	// Argument defaults can reference the composite server's configuration and the user's ID.
+	templateEnv := make(map[string]string, len(credEnv)+1)
+	maps.Copy(templateEnv, credEnv)
+	templateEnv["OBOT_USER_ID"] = userID
+
+	config.ToolConflictPolicy = mcpServer.Spec.Manifest.CompositeConfig.ToolConflictPolicy
 	config.Components = make([]ComponentServer, 0, len(components)+len(instances))
 	for _, component := range components {
 		name := component.Spec.Manifest.Name
@@ -215,17 +224,7 @@ func CompositeServerToServerConfig(mcpServer v1.MCPServer, components []v1.MCPSe
 			continue
 		}
 
-		tools := make([]types.ToolOverride, 0, len(override.ToolOverrides))
-		for _, tool := range override.ToolOverrides {
-			if tool.Enabled {
-				tools = append(tools, types.ToolOverride{
-					Name:                tool.Name,
-					OverrideName:        tool.OverrideName,
-					OverrideDescription: tool.OverrideDescription,
-					Enabled:             tool.Enabled,
-				})
-			}
-		}
+		tools := enabledToolOverrides(override.ToolOverrides, templateEnv)
 
 		config.Components = append(config.Components, ComponentServer{
 			Name:  name,
@@ -240,17 +239,7 @@ func CompositeServerToServerConfig(mcpServer v1.MCPServer, components []v1.MCPSe
 			continue
 		}
 
-		tools := make([]types.ToolOverride, 0, len(override.ToolOverrides))
-		for _, tool := range override.ToolOverrides {
-			if tool.Enabled {
-				tools = append(tools, types.ToolOverride{
-					Name:                tool.Name,
-					OverrideName:        tool.OverrideName,
-					OverrideDescription: tool.OverrideDescription,
-					Enabled:             tool.Enabled,
-				})
-			}
-		}
+		tools := enabledToolOverrides(override.ToolOverrides, templateEnv)
 
 		config.Components = append(config.Components, ComponentServer{
 			Name:  instance.Name,
@@ -272,6 +261,46 @@ func CompositeServerToServerConfig(mcpServer v1.MCPServer, components []v1.MCPSe
 	return config, missing, err
 }
 
+// expandDefault expands the templates in an argument default that is a string. Other defaults are returned as they are.
+func expandDefault(value json.RawMessage, templateEnv map[string]string) json.RawMessage {
+	var text string
+	if err := json.Unmarshal(value, &text); err != nil {
+		return value
+	}
+
+	expanded, err := json.Marshal(expandEnvVars(text, templateEnv, nil))
+	if err != nil {
+		return value
+	}
+	return expanded
+}
+
+// enabledToolOverrides returns the enabled tool overrides with the templates in their argument defaults expanded.
+func enabledToolOverrides(overrides []types.ToolOverride, templateEnv map[string]string) []types.ToolOverride {
+	tools := make([]types.ToolOverride, 0, len(overrides))
+	for _, tool := range overrides {
+		if !tool.Enabled {
+			continue
+		}
+
+		var arguments []types.ToolArgumentOverride
+		for _, argument := range tool.Arguments {
+			argument.Default = expandDefault(argument.Default, templateEnv)
+			arguments = append(arguments, argument)
+		}
+
+		tools = append(tools, types.ToolOverride{
+			Name:                tool.Name,
+			OverrideName:        tool.OverrideName,
+			OverrideDescription: tool.OverrideDescription,
+			Enabled:             tool.Enabled,
+			Arguments:           arguments,
+		})
+	}
+
+	return tools
+}
+
 func ServerToServerConfig(mcpServer v1.MCPServer, audiences []string, issuer, userID, scope, mcpCatalogName string, credEnv, secretsCred map[string]string) (ServerConfig, []string, error) {
 	fileEnvVars := make(map[string]struct{})
 	for _, file := range mcpServer.Spec.Manifest.Env {
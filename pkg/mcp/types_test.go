@@ -1,6 +1,7 @@
 package mcp
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/obot-platform/obot/apiclient/types"
@@ -640,3 +641,22 @@ func TestServerToServerConfig_StaticHeaders_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandDefault(t *testing.T) {
+	env := map[string]string{"ORG": "our-org", "OBOT_USER_ID": "1"}
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: `"${ORG}/${OBOT_USER_ID}"`, expected: `"our-org/1"`},
+		{value: `"${MISSING}"`, expected: `"${MISSING}"`},
+		{value: `10`, expected: `10`},
+		{value: `{"owner":"${ORG}"}`, expected: `{"owner":"${ORG}"}`},
+	}
+
+	for _, tt := range tests {
+		if got := expandDefault(json.RawMessage(tt.value), env); string(got) != tt.expected {
+			t.Errorf("expected %s to expand to %s, got %s", tt.value, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+// Package mcphealth probes multi-user and system MCP servers on a schedule, records their health and alerts admins
+// when a server becomes unhealthy or recovers.
+package mcphealth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	nmcp "github.com/nanobot-ai/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/notification"
+)
+
+var log = logger.Package()
+
+type Options struct {
+	MCPHealthCheckIntervalMinutes  int      `usage:"How often, in minutes, to probe multi-user and system MCP servers. Set to 0 to disable." default:"5" env:"OBOT_SERVER_MCP_HEALTH_CHECK_INTERVAL_MINUTES"`
+	MCPHealthCheckListTools        bool     `usage:"List the tools of MCP servers when probing them, in addition to pinging them" default:"false" env:"OBOT_SERVER_MCP_HEALTH_CHECK_LIST_TOOLS"`
+	MCPHealthCheckFailureThreshold int      `usage:"The number of consecutive failed probes after which an MCP server is unhealthy" default:"3" env:"OBOT_SERVER_MCP_HEALTH_CHECK_FAILURE_THRESHOLD"`
+	MCPHealthAlertEmails           []string `usage:"Email addresses to alert when an MCP server becomes unhealthy or recovers" env:"OBOT_SERVER_MCP_HEALTH_ALERT_EMAILS"`
+	MCPHealthAlertWebhookURL       string   `usage:"A URL to post JSON alerts to when an MCP server becomes unhealthy or recovers" env:"OBOT_SERVER_MCP_HEALTH_ALERT_WEBHOOK_URL"`
+	MCPHealthAlertSlackWebhookURL  string   `usage:"A Slack incoming webhook URL to alert when an MCP server becomes unhealthy or recovers" env:"OBOT_SERVER_MCP_HEALTH_ALERT_SLACK_WEBHOOK_URL"`
+}
+
+const (
+	// maxHistoryLength is the number of probes kept on each server.
+	maxHistoryLength = 100
+
+	probeTimeout = time.Minute
+	// maxConcurrentProbes limits the probes that run at the same time.
+	maxConcurrentProbes = 10
+	// resultPollInterval is how often controllers look for the result of a probe that runs in the background.
+	resultPollInterval = 5 * time.Second
+
+	eventUnhealthy = "unhealthy"
+	eventRecovered = "recovered"
+)
+
+// Server identifies the server that is probed in logs and alerts.
+type Server struct {
+	ID   string `json:"serverID"`
+	Name string `json:"serverName"`
+	// Kind is either "mcpserver" or "systemmcpserver".
+	Kind string `json:"kind"`
+}
+
+// Checker probes MCP servers in the background and sends the alerts about their health.
+type Checker struct {
+	sessionManager   *mcp.SessionManager
+	notifier         *notification.Sender
+	interval         time.Duration
+	listTools        bool
+	failureThreshold int
+	alertEmails      []string
+	alertWebhookURL  string
+	alertSlackURL    string
+
+	// probe is replaced in tests.
+	probe      func(context.Context, mcp.ServerConfig) (types.MCPServerProbe, error)
+	probeSlots chan struct{}
+
+	lock sync.Mutex
+	// probing has the servers with a probe in the background, and results the probes that aren't recorded yet.
+	probing map[string]bool
+	results map[string]probeResult
+}
+
+type probeResult struct {
+	probe types.MCPServerProbe
+	err   error
+}
+
+func New(opts Options, sessionManager *mcp.SessionManager, notifier *notification.Sender) *Checker {
+	failureThreshold := opts.MCPHealthCheckFailureThreshold
+	if failureThreshold < 1 {
+		failureThreshold = 1
+	}
+	c := &Checker{
+		sessionManager:   sessionManager,
+		notifier:         notifier,
+		interval:         time.Duration(opts.MCPHealthCheckIntervalMinutes) * time.Minute,
+		listTools:        opts.MCPHealthCheckListTools,
+		failureThreshold: failureThreshold,
+		alertEmails:      opts.MCPHealthAlertEmails,
+		alertWebhookURL:  opts.MCPHealthAlertWebhookURL,
+		alertSlackURL:    opts.MCPHealthAlertSlackWebhookURL,
+		probeSlots:       make(chan struct{}, maxConcurrentProbes),
+		probing:          map[string]bool{},
+		results:          map[string]probeResult{},
+	}
+	c.probe = c.probeServer
+	return c
+}
+
+// Enabled returns true if servers should be probed.
+func (c *Checker) Enabled() bool {
+	return c != nil && c.interval > 0
+}
+
+// Interval returns how often servers are probed.
+func (c *Checker) Interval() time.Duration {
+	return c.interval
+}
+
+// NextProbe returns how long to wait before probing a server with the given health. It is zero if a probe is due.
+func (c *Checker) NextProbe(health *types.MCPServerHealth) time.Duration {
+	if health == nil || health.LastChecked.IsZero() {
+		return 0
+	}
+	return max(time.Until(health.LastChecked.Time.Add(c.interval)), 0)
+}
+
+// Check records the result of the last probe of the server, if there is one, and otherwise starts a probe in the
+// background, so that probes don't hold up controllers. It returns how long to wait before calling it again.
+//
+// persist must store the health with the new result. The alert about the server becoming unhealthy or recovering is
+// only sent once persist succeeded, and a result that failed to persist is recorded again on the next call, against
+// the health as it is stored then. Servers that aren't running are skipped, so that probes don't launch them.
+func (c *Checker) Check(server Server, serverConfig mcp.ServerConfig, health *types.MCPServerHealth, persist func(*types.MCPServerHealth) error) (time.Duration, error) {
+	key := server.Kind + "/" + server.ID
+
+	c.lock.Lock()
+	result, ok := c.results[key]
+	if !ok && !c.probing[key] {
+		c.probing[key] = true
+		go c.runProbe(key, serverConfig)
+	}
+	c.lock.Unlock()
+
+	if !ok {
+		return resultPollInterval, nil
+	}
+
+	if errors.Is(result.err, mcp.ErrServerNotRunning) {
+		c.forget(key)
+		return c.interval, nil
+	}
+	if !result.probe.Healthy {
+		log.Debugf("health probe of %s %s failed: %s", server.Kind, server.ID, result.probe.Error)
+	}
+
+	health, event := record(health, result.probe, c.failureThreshold)
+	if err := persist(health); err != nil {
+		return 0, err
+	}
+	c.forget(key)
+
+	if event != "" {
+		c.alert(server, health, event)
+	}
+	return c.interval, nil
+}
+
+func (c *Checker) runProbe(key string, serverConfig mcp.ServerConfig) {
+	c.probeSlots <- struct{}{}
+	defer func() { <-c.probeSlots }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+
+	probe, err := c.probe(ctx, serverConfig)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.probing, key)
+	c.results[key] = probeResult{probe: probe, err: err}
+}
+
+func (c *Checker) forget(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.results, key)
+}
+
+// probeServer pings the server and, if configured, lists its tools. Servers that require the user to authorize with
+// OAuth answered the request, so they are healthy. It returns [mcp.ErrServerNotRunning] for servers that aren't
+// running, without probing them.
+func (c *Checker) probeServer(ctx context.Context, serverConfig mcp.ServerConfig) (types.MCPServerProbe, error) {
+	start := time.Now()
+	_, err := c.sessionManager.PingRunningServer(ctx, serverConfig)
+	if errors.Is(err, mcp.ErrServerNotRunning) {
+		return types.MCPServerProbe{}, err
+	}
+	if err == nil && c.listTools {
+		_, err = c.sessionManager.ListTools(ctx, serverConfig)
+	}
+
+	probe := types.MCPServerProbe{
+		Time:      *types.NewTime(start),
+		Healthy:   true,
+		LatencyMS: time.Since(start).Milliseconds(),
+	}
+	if are := (nmcp.AuthRequiredErr{}); err != nil && !errors.As(err, &are) {
+		probe.Healthy = false
+		probe.Error = err.Error()
+	}
+	return probe, nil
+}
+
+// record adds the probe to a copy of the health and returns it, along with the event to alert about, if any. Servers
+// become unhealthy when failureThreshold probes in a row failed, and recover with the next successful probe.
+func record(health *types.MCPServerHealth, probe types.MCPServerProbe, failureThreshold int) (*types.MCPServerHealth, string) {
+	if health == nil {
+		health = new(types.MCPServerHealth)
+	} else {
+		health = health.DeepCopy()
+	}
+
+	var event string
+	health.LastChecked = &probe.Time
+	if probe.Healthy {
+		if health.State == types.MCPServerHealthStateUnhealthy {
+			event = eventRecovered
+		}
+		health.State = types.MCPServerHealthStateHealthy
+		health.LastHealthy = &probe.Time
+		health.ConsecutiveFailures = 0
+		health.LastError = ""
+	} else {
+		health.ConsecutiveFailures++
+		health.LastError = probe.Error
+		if health.ConsecutiveFailures >= failureThreshold {
+			if health.State != types.MCPServerHealthStateUnhealthy {
+				event = eventUnhealthy
+			}
+			health.State = types.MCPServerHealthStateUnhealthy
+		} else if health.State != types.MCPServerHealthStateUnhealthy {
+			health.State = types.MCPServerHealthStateFailing
+		}
+	}
+
+	health.History = append(health.History, probe)
+	if extra := len(health.History) - maxHistoryLength; extra > 0 {
+		health.History = health.History[extra:]
+	}
+
+	var healthy int
+	for _, p := range health.History {
+		if p.Healthy {
+			healthy++
+		}
+	}
+	health.Uptime = float64(healthy) * 100 / float64(len(health.History))
+
+	return health, event
+}
+
+// alert sends the alert about a change in the health of a server in the background. Alerts that fail to send are
+// logged and not retried, so that an unreachable destination doesn't hold up the probes.
+func (c *Checker) alert(server Server, health *types.MCPServerHealth, event string) {
+	name := server.Name
+	if name == "" {
+		name = server.ID
+	}
+
+	subject := fmt.Sprintf("MCP server %q recovered", name)
+	message := fmt.Sprintf("MCP server %q (%s) is healthy again.", name, server.ID)
+	if event == eventUnhealthy {
+		subject = fmt.Sprintf("MCP server %q is unhealthy", name)
+		message = fmt.Sprintf("MCP server %q (%s) failed %d health checks in a row: %s", name, server.ID, health.ConsecutiveFailures, health.LastError)
+	}
+
+	var sends []func(context.Context) error
+	if len(c.alertEmails) > 0 {
+		sends = append(sends, func(context.Context) error {
+			return c.notifier.Email(c.alertEmails, subject, message)
+		})
+	}
+	if c.alertSlackURL != "" {
+		sends = append(sends, func(ctx context.Context) error {
+			return c.notifier.Slack(ctx, c.alertSlackURL, message)
+		})
+	}
+	if c.alertWebhookURL != "" {
+		payload := struct {
+			Server
+			Event               string                     `json:"event"`
+			Message             string                     `json:"message"`
+			ConsecutiveFailures int                        `json:"consecutiveFailures"`
+			Error               string                     `json:"error,omitempty"`
+			Uptime              float64                    `json:"uptime"`
+			Time                *types.Time                `json:"time"`
+			State               types.MCPServerHealthState `json:"state"`
+		}{
+			Server:              server,
+			Event:               event,
+			Message:             message,
+			ConsecutiveFailures: health.ConsecutiveFailures,
+			Error:               health.LastError,
+			Uptime:              health.Uptime,
+			Time:                health.LastChecked,
+			State:               health.State,
+		}
+		sends = append(sends, func(ctx context.Context) error {
+			return c.notifier.Webhook(ctx, c.alertWebhookURL, payload)
+		})
+	}
+
+	for _, send := range sends {
+		if err := c.notifier.Enqueue(send, func(err error) {
+			if err != nil {
+				log.Warnf("failed to send %s alert for %s %s: %v", event, server.Kind, server.ID, err)
+			}
+		}); err != nil {
+			log.Warnf("failed to send %s alert for %s %s: %v", event, server.Kind, server.ID, err)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package mcphealth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/notification"
+)
+
+func TestRecord(t *testing.T) {
+	start := time.Now()
+	probe := func(i int, healthy bool) types.MCPServerProbe {
+		p := types.MCPServerProbe{Time: *types.NewTime(start.Add(time.Duration(i) * time.Minute)), Healthy: healthy}
+		if !healthy {
+			p.Error = "connection refused"
+		}
+		return p
+	}
+
+	tests := []struct {
+		healthy []bool
+		state   types.MCPServerHealthState
+		event   string
+	}{
+		{healthy: []bool{true}, state: types.MCPServerHealthStateHealthy},
+		{healthy: []bool{true, false}, state: types.MCPServerHealthStateFailing},
+		{healthy: []bool{true, false, false}, state: types.MCPServerHealthStateFailing},
+		{healthy: []bool{true, false, false, false}, state: types.MCPServerHealthStateUnhealthy, event: eventUnhealthy},
+		// The alert is only sent once for each outage.
+		{healthy: []bool{false, false, false, false}, state: types.MCPServerHealthStateUnhealthy},
+		{healthy: []bool{false, false, false, true}, state: types.MCPServerHealthStateHealthy, event: eventRecovered},
+		// Servers that didn't reach the threshold don't recover.
+		{healthy: []bool{true, false, true}, state: types.MCPServerHealthStateHealthy},
+	}
+
+	for _, test := range tests {
+		var (
+			health *types.MCPServerHealth
+			event  string
+		)
+		for i, healthy := range test.healthy {
+			previous := health
+			health, event = record(health, probe(i, healthy), 3)
+			if previous != nil && len(previous.History) != i {
+				t.Fatalf("%v: record modified the previous health", test.healthy)
+			}
+		}
+
+		if health.State != test.state {
+			t.Errorf("%v: expected state %s, got %s", test.healthy, test.state, health.State)
+		}
+		if event != test.event {
+			t.Errorf("%v: expected event %q, got %q", test.healthy, test.event, event)
+		}
+	}
+}
+
+func TestRecordUptime(t *testing.T) {
+	var health *types.MCPServerHealth
+	for i := range maxHistoryLength + 10 {
+		health, _ = record(health, types.MCPServerProbe{Healthy: i%4 != 0, Time: *types.NewTime(time.Now())}, 3)
+	}
+
+	if len(health.History) != maxHistoryLength {
+		t.Fatalf("expected %d probes in the history, got %d", maxHistoryLength, len(health.History))
+	}
+	if health.Uptime != 75 {
+		t.Errorf("expected an uptime of 75%%, got %v", health.Uptime)
+	}
+	if health.LastHealthy == nil || health.ConsecutiveFailures != 0 {
+		t.Errorf("expected the last probe to be healthy, got %+v", health)
+	}
+}
+
+func TestCheckAlertsAfterPersisting(t *testing.T) {
+	alerts := make(chan string, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert struct {
+			Event string `json:"event"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&alert)
+		alerts <- alert.Event
+	}))
+	defer webhook.Close()
+	u, _ := url.Parse(webhook.URL)
+
+	running := true
+	c := New(Options{
+		MCPHealthCheckIntervalMinutes:  5,
+		MCPHealthCheckFailureThreshold: 1,
+		MCPHealthAlertWebhookURL:       webhook.URL,
+	}, nil, notification.New(notification.Options{AllowedHosts: []string{u.Hostname()}}))
+	c.probe = func(context.Context, mcp.ServerConfig) (types.MCPServerProbe, error) {
+		if !running {
+			return types.MCPServerProbe{}, mcp.ErrServerNotRunning
+		}
+		return types.MCPServerProbe{Time: *types.NewTime(time.Now()), Error: "connection refused"}, nil
+	}
+
+	server := Server{ID: "ms1", Kind: "mcpserver"}
+	var (
+		stored   *types.MCPServerHealth
+		conflict = true
+	)
+	persist := func(health *types.MCPServerHealth) error {
+		if conflict {
+			conflict = false
+			return errors.New("conflict")
+		}
+		stored = health
+		return nil
+	}
+
+	// check calls Check until the probe in the background finished and its result was handled.
+	check := func() (time.Duration, error) {
+		t.Helper()
+		for range 100 {
+			retryAfter, err := c.Check(server, mcp.ServerConfig{}, stored, persist)
+			if err != nil || retryAfter != resultPollInterval {
+				return retryAfter, err
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatal("the probe didn't finish")
+		return 0, nil
+	}
+
+	// The alert isn't sent when the health fails to persist, and the result is recorded again on the next call.
+	if _, err := check(); err == nil {
+		t.Fatal("expected the conflict to be returned")
+	}
+	if retryAfter, err := c.Check(server, mcp.ServerConfig{}, stored, persist); err != nil || retryAfter != c.Interval() {
+		t.Fatalf("expected the result to be recorded, got %v, %v", retryAfter, err)
+	}
+	if stored == nil || stored.State != types.MCPServerHealthStateUnhealthy || len(stored.History) != 1 {
+		t.Fatalf("expected an unhealthy server with one probe, got %+v", stored)
+	}
+
+	select {
+	case event := <-alerts:
+		if event != eventUnhealthy {
+			t.Fatalf("expected an unhealthy alert, got %q", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected an alert")
+	}
+
+	// Servers that aren't running are skipped.
+	running = false
+	if retryAfter, err := check(); err != nil || retryAfter != c.Interval() {
+		t.Fatalf("expected the server to be skipped, got %v, %v", retryAfter, err)
+	}
+	if len(stored.History) != 1 {
+		t.Errorf("expected no probe to be recorded for a server that isn't running, got %+v", stored.History)
+	}
+
+	select {
+	case event := <-alerts:
+		t.Fatalf("expected a single alert, got another %q", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
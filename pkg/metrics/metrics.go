@@ -0,0 +1,176 @@
+// Package metrics defines the Prometheus metrics of Obot. They are served at /debug/metrics, next to the metrics of the
+// Kubernetes libraries.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type Options struct {
+	MetricsMaxServerLabels int `usage:"The maximum number of MCP servers that metrics are labeled with, others are labeled as other. Set to 0 to not label metrics by MCP server." default:"100" env:"OBOT_SERVER_METRICS_MAX_SERVER_LABELS"`
+	MetricsMaxModelLabels  int `usage:"The maximum number of models that metrics are labeled with, others are labeled as other. Set to 0 to not label metrics by model." default:"50" env:"OBOT_SERVER_METRICS_MAX_MODEL_LABELS"`
+}
+
+// Registry holds the metrics of Obot.
+var Registry = prometheus.NewRegistry()
+
+const otherLabel = "other"
+
+var (
+	serverLabels = &limitedLabels{limit: 100}
+	modelLabels  = &limitedLabels{limit: 50}
+
+	mcpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "obot_mcp_gateway_requests_total",
+		Help: "Requests proxied to MCP servers, by MCP server, JSON-RPC method, HTTP status code and result. The result is error for HTTP errors and for JSON-RPC error responses.",
+	}, []string{"server", "method", "code", "result"})
+	mcpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "obot_mcp_gateway_request_duration_seconds",
+		Help:    "Latency of requests proxied to MCP servers, by MCP server and JSON-RPC method. Streams are measured until they close.",
+		Buckets: []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"server", "method"})
+
+	serverLaunchDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "obot_mcp_server_launch_duration_seconds",
+		Help:    "Time to ensure that an MCP server is deployed and ready, by runtime and result. Servers that are already running return quickly.",
+		Buckets: []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 120, 300},
+	}, []string{"runtime", "result"})
+	serverLaunchFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "obot_mcp_server_launch_failures_total",
+		Help: "Failed MCP server launches, by runtime and reason.",
+	}, []string{"runtime", "reason"})
+
+	auditLogBufferSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "obot_mcp_audit_log_buffer_size",
+		Help: "MCP audit log entries waiting to be written to the database.",
+	})
+	auditLogFlushDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "obot_mcp_audit_log_flush_duration_seconds",
+		Help:    "Time to write buffered MCP audit log entries to the database, by result.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"result"})
+	auditLogFlushedEntries = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "obot_mcp_audit_log_flushed_entries_total",
+		Help: "MCP audit log entries written to the database.",
+	})
+
+	llmTokens = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "obot_llm_proxy_tokens_total",
+		Help: "Tokens used through the LLM proxy, by model and type (prompt or completion).",
+	}, []string{"model", "type"})
+)
+
+func init() {
+	Registry.MustRegister(
+		mcpRequests,
+		mcpRequestDuration,
+		serverLaunchDuration,
+		serverLaunchFailures,
+		auditLogBufferSize,
+		auditLogFlushDuration,
+		auditLogFlushedEntries,
+		llmTokens,
+	)
+}
+
+// Init applies the label limits of opts. It must be called before metrics are recorded.
+func Init(opts Options) {
+	serverLabels.setLimit(opts.MetricsMaxServerLabels)
+	modelLabels.setLimit(opts.MetricsMaxModelLabels)
+}
+
+// ObserveMCPRequest records a request that the MCP gateway proxied to a server. jsonRPCError is true if the server
+// responded with a JSON-RPC error, which servers return with status 200 or in event streams.
+func ObserveMCPRequest(server, method string, code int, jsonRPCError bool, duration time.Duration) {
+	result := "success"
+	if code >= http.StatusBadRequest || jsonRPCError {
+		result = "error"
+	}
+	server = serverLabels.value(server)
+	mcpRequests.WithLabelValues(server, method, strconv.Itoa(code), result).Inc()
+	mcpRequestDuration.WithLabelValues(server, method).Observe(duration.Seconds())
+}
+
+// ObserveMCPServerLaunch records how long it took to ensure that an MCP server is deployed. failureReason is empty if
+// the launch succeeded.
+func ObserveMCPServerLaunch(runtime string, duration time.Duration, failureReason string) {
+	result := "success"
+	if failureReason != "" {
+		result = "failure"
+		serverLaunchFailures.WithLabelValues(runtime, failureReason).Inc()
+	}
+	serverLaunchDuration.WithLabelValues(runtime, result).Observe(duration.Seconds())
+}
+
+// SetAuditLogBufferSize records the number of audit log entries waiting to be written.
+func SetAuditLogBufferSize(size int) {
+	auditLogBufferSize.Set(float64(size))
+}
+
+// ObserveAuditLogFlush records a write of buffered audit log entries to the database.
+func ObserveAuditLogFlush(entries int, duration time.Duration, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	} else {
+		auditLogFlushedEntries.Add(float64(entries))
+	}
+	auditLogFlushDuration.WithLabelValues(result).Observe(duration.Seconds())
+}
+
+// AddLLMTokens records the tokens of a response of the LLM proxy.
+func AddLLMTokens(model string, promptTokens, completionTokens int) {
+	model = modelLabels.value(model)
+	if promptTokens > 0 {
+		llmTokens.WithLabelValues(model, "prompt").Add(float64(promptTokens))
+	}
+	if completionTokens > 0 {
+		llmTokens.WithLabelValues(model, "completion").Add(float64(completionTokens))
+	}
+}
+
+// limitedLabels bounds the cardinality of a label: the first values are kept and all others are reported as other.
+type limitedLabels struct {
+	lock  sync.RWMutex
+	limit int
+	seen  map[string]struct{}
+}
+
+func (l *limitedLabels) setLimit(limit int) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.limit = limit
+}
+
+func (l *limitedLabels) value(v string) string {
+	l.lock.RLock()
+	_, ok := l.seen[v]
+	limit := l.limit
+	l.lock.RUnlock()
+
+	switch {
+	case limit <= 0:
+		return ""
+	case ok:
+		return v
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if _, ok := l.seen[v]; ok {
+		return v
+	}
+	if len(l.seen) >= l.limit {
+		return otherLabel
+	}
+	if l.seen == nil {
+		l.seen = map[string]struct{}{}
+	}
+	l.seen[v] = struct{}{}
+	return v
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestLimitedLabels(t *testing.T) {
+	l := &limitedLabels{limit: 2}
+	for _, tt := range []struct {
+		value, expected string
+	}{
+		{"a", "a"},
+		{"b", "b"},
+		{"c", otherLabel},
+		{"a", "a"},
+	} {
+		if got := l.value(tt.value); got != tt.expected {
+			t.Errorf("expected %q for %q, got %q", tt.expected, tt.value, got)
+		}
+	}
+
+	l.setLimit(0)
+	if got := l.value("a"); got != "" {
+		t.Errorf("expected no label when the limit is 0, got %q", got)
+	}
+}
+
+func TestObserveMCPServerLaunch(t *testing.T) {
+	ObserveMCPServerLaunch("containerized", time.Second, "")
+	ObserveMCPServerLaunch("containerized", time.Second, "ErrImagePullFailed")
+
+	if got := testutil.ToFloat64(serverLaunchFailures.WithLabelValues("containerized", "ErrImagePullFailed")); got != 1 {
+		t.Errorf("expected 1 failure, got %v", got)
+	}
+	if got := testutil.CollectAndCount(serverLaunchDuration); got != 2 {
+		t.Errorf("expected durations for successes and failures, got %d series", got)
+	}
+}
@@ -0,0 +1,206 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// queueSize is the number of notifications that can wait to be sent before Enqueue fails.
+	queueSize = 1000
+	workers   = 5
+)
+
+// ErrQueueFull is returned by Enqueue when too many notifications are waiting to be sent.
+var ErrQueueFull = errors.New("too many notifications are waiting to be sent")
+
+type Options struct {
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
+	// AllowedHosts can be reached by webhooks even though they resolve to private, loopback, or link-local addresses.
+	AllowedHosts []string
+}
+
+// Sender delivers notifications by email, to generic webhooks, and to Slack incoming webhooks.
+type Sender struct {
+	options Options
+	// client can only reach public addresses, trustedClient is used for the hosts that admins allowed.
+	client        *http.Client
+	trustedClient *http.Client
+	queue         chan func()
+}
+
+func New(options Options) *Sender {
+	s := &Sender{
+		options: options,
+		queue:   make(chan func(), queueSize),
+		trustedClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: checkAddress,
+	}).DialContext
+	s.client = &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.New("too many redirects")
+			}
+			return nil
+		},
+	}
+
+	for range workers {
+		go func() {
+			for send := range s.queue {
+				send()
+			}
+		}()
+	}
+	return s
+}
+
+// Enqueue sends notifications in the background, so that callers aren't blocked by slow destinations. The send
+// function is called with a context that is cancelled after a minute and done, if set, is called with its result.
+func (s *Sender) Enqueue(send func(context.Context) error, done func(error)) error {
+	select {
+	case s.queue <- func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		err := send(ctx)
+		if done != nil {
+			done(err)
+		}
+	}:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// checkAddress is called after DNS resolution, before connecting, so that webhooks can't reach internal services
+// through names that resolve to private addresses.
+func checkAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || IsPublicIP(ip) {
+		return nil
+	}
+	return fmt.Errorf("notifications cannot be sent to non-public address %s", host)
+}
+
+func (s *Sender) clientFor(rawURL string) *http.Client {
+	if u, err := url.Parse(rawURL); err == nil && s.allowedHost(u.Hostname()) {
+		return s.trustedClient
+	}
+	return s.client
+}
+
+func (s *Sender) allowedHost(host string) bool {
+	for _, allowed := range s.options.AllowedHosts {
+		if strings.EqualFold(allowed, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPublicIP returns false for loopback, private, link-local, unspecified, and multicast addresses.
+func IsPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified()
+}
+
+// EmailEnabled returns true if an SMTP server is configured.
+func (s *Sender) EmailEnabled() bool {
+	return s.options.SMTPHost != "" && s.options.SMTPFrom != ""
+}
+
+func (s *Sender) Email(to []string, subject, body string) error {
+	if !s.EmailEnabled() {
+		return errors.New("email notifications require an SMTP server to be configured")
+	}
+	for _, addr := range append([]string{s.options.SMTPFrom, subject}, to...) {
+		if strings.ContainsAny(addr, "\r\n") {
+			return errors.New("email headers cannot contain line breaks")
+		}
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", s.options.SMTPFrom)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	var auth smtp.Auth
+	if s.options.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", s.options.SMTPUsername, s.options.SMTPPassword, s.options.SMTPHost)
+	}
+
+	port := s.options.SMTPPort
+	if port == 0 {
+		port = 587
+	}
+	return smtp.SendMail(net.JoinHostPort(s.options.SMTPHost, strconv.Itoa(port)), auth, s.options.SMTPFrom, to, msg.Bytes())
+}
+
+// Webhook posts the payload as JSON to the URL. URLs that resolve to non-public addresses are rejected unless their
+// host is one of the allowed hosts.
+func (s *Sender) Webhook(ctx context.Context, webhookURL string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.clientFor(webhookURL).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("webhook responded with %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// Slack posts the text to a Slack incoming webhook URL.
+func (s *Sender) Slack(ctx context.Context, url, text string) error {
+	return s.Webhook(ctx, url, map[string]string{
+		"text": text,
+	})
+}
@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	for ip, public := range map[string]bool{
+		"8.8.8.8":         true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"10.1.2.3":        false,
+		"172.16.0.1":      false,
+		"192.168.1.1":     false,
+		"169.254.169.254": false,
+		"0.0.0.0":         false,
+		"::1":             false,
+		"fe80::1":         false,
+		"fd00::1":         false,
+		"224.0.0.1":       false,
+	} {
+		if got := IsPublicIP(net.ParseIP(ip)); got != public {
+			t.Errorf("expected IsPublicIP(%s) to be %v", ip, public)
+		}
+	}
+}
+
+func TestWebhookRejectsPrivateAddresses(t *testing.T) {
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		received++
+	}))
+	defer server.Close()
+
+	err := New(Options{}).Webhook(t.Context(), server.URL, map[string]string{"text": "hello"})
+	if err == nil || !strings.Contains(err.Error(), "non-public address") {
+		t.Fatalf("expected the loopback address to be rejected, got %v", err)
+	}
+	if received != 0 {
+		t.Fatal("expected the webhook to not be delivered")
+	}
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := New(Options{AllowedHosts: []string{u.Hostname()}}).Webhook(t.Context(), server.URL, map[string]string{"text": "hello"}); err != nil {
+		t.Fatalf("expected allowed hosts to be reachable, got %v", err)
+	}
+	if received != 1 {
+		t.Fatalf("expected the webhook to be delivered once, got %d", received)
+	}
+}
+
+func TestEnqueue(t *testing.T) {
+	s := New(Options{})
+	done := make(chan error)
+	if err := s.Enqueue(func(ctx context.Context) error {
+		return ctx.Err()
+	}, func(err error) {
+		done <- err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("expected the send to run with a live context, got %v", err)
+	}
+}
@@ -106,6 +106,10 @@ func Agent(ctx context.Context, mcpSessionManager *mcp.SessionManager, db kclien
 		}
 	}
 
+	if opts.Thread != nil && opts.Thread.Spec.Manifest.Temperature != nil {
+		mainTool.Temperature = opts.Thread.Spec.Manifest.Temperature
+	}
+
 	if opts.Thread != nil {
 		prompts, err := projects.GetStrings(ctx, db, opts.Thread, func(thread *v1.Thread) []string {
 			if thread.Spec.Manifest.Prompt == "" {
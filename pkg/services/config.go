@@ -48,7 +48,10 @@ import (
 	"github.com/obot-platform/obot/pkg/jwt/persistent"
 	"github.com/obot-platform/obot/pkg/logutil"
 	"github.com/obot-platform/obot/pkg/mcp"
+	"github.com/obot-platform/obot/pkg/mcphealth"
+	"github.com/obot-platform/obot/pkg/metrics"
 	"github.com/obot-platform/obot/pkg/modelaccesspolicy"
+	"github.com/obot-platform/obot/pkg/notification"
 	"github.com/obot-platform/obot/pkg/proxy"
 	"github.com/obot-platform/obot/pkg/storage"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
@@ -77,6 +80,8 @@ type (
 	RateLimiterConfig ratelimiter.Options
 	EncryptionConfig  encryption.Options
 	MCPConfig         mcp.Options
+	MetricsConfig     metrics.Options
+	MCPHealthConfig   mcphealth.Options
 )
 
 type Config struct {
@@ -111,6 +116,19 @@ type Config struct {
 	EnableRegistryAuth      bool   `usage:"Enable authentication for the MCP registry API" default:"false" env:"OBOT_SERVER_ENABLE_REGISTRY_AUTH"`
 	NanobotIntegration      bool   `usage:"Enable Nanobot integration" default:"false"`
 	MCPServerSearchImage    string `usage:"Container image for the obot MCP server" default:"ghcr.io/obot-platform/obot-mcp-server:main"`
+	// Power user workspace catalog entry approval
+	EnableWorkspaceEntryApproval bool `usage:"Require admin approval before new or modified power user workspace catalog entries can be used" default:"false" env:"OBOT_SERVER_ENABLE_WORKSPACE_ENTRY_APPROVAL"`
+	// MCP tool change detection
+	MCPToolChangeCheckIntervalMinutes int  `usage:"How often, in minutes, to check running multi-user MCP servers for tool changes. Set to 0 to disable." default:"60" env:"OBOT_SERVER_MCP_TOOL_CHANGE_CHECK_INTERVAL_MINUTES"`
+	MCPToolChangeAutoDisable          bool `usage:"Disable tools that newly appear on multi-user MCP servers until an admin approves them" default:"false" env:"OBOT_SERVER_MCP_TOOL_CHANGE_AUTO_DISABLE"`
+	// Task notifications
+	SMTPHost     string `usage:"The SMTP server used to send task notification emails" env:"OBOT_SERVER_SMTP_HOST"`
+	SMTPPort     int    `usage:"The port of the SMTP server" default:"587" env:"OBOT_SERVER_SMTP_PORT"`
+	SMTPUsername string `usage:"The username to authenticate with the SMTP server" env:"OBOT_SERVER_SMTP_USERNAME"`
+	SMTPPassword string `usage:"The password to authenticate with the SMTP server" env:"OBOT_SERVER_SMTP_PASSWORD"`
+	SMTPFrom     string `usage:"The address task notification emails are sent from" env:"OBOT_SERVER_SMTP_FROM"`
+	// NotificationAllowedHosts are hosts that notification webhooks can reach even though they resolve to private addresses.
+	NotificationAllowedHosts []string `usage:"Hosts that notification webhooks can post to even if they resolve to private, loopback, or link-local addresses" env:"OBOT_SERVER_NOTIFICATION_ALLOWED_HOSTS"`
 
 	GeminiConfig
 	GatewayConfig
@@ -119,6 +137,8 @@ type Config struct {
 	AuditConfig
 	RateLimiterConfig
 	MCPConfig
+	MetricsConfig
+	MCPHealthConfig
 	services.Config
 }
 
@@ -194,6 +214,18 @@ type Services struct {
 	AutonomousToolUseEnabled bool
 	NanobotIntegration       bool
 	MCPServerSearchImage     string
+
+	// WorkspaceEntryApprovalEnabled requires admin approval of power user workspace catalog entries.
+	WorkspaceEntryApprovalEnabled bool
+
+	// MCPToolChangeCheckInterval is how often running multi-user MCP servers are checked for tool changes.
+	MCPToolChangeCheckInterval time.Duration
+	// MCPToolChangeAutoDisable disables newly appearing tools until an admin approves them.
+	MCPToolChangeAutoDisable bool
+	// Notifier sends task run notifications.
+	Notifier *notification.Sender
+	// MCPHealthChecker probes multi-user and system MCP servers and alerts about unhealthy servers.
+	MCPHealthChecker *mcphealth.Checker
 }
 
 const (
@@ -404,6 +436,7 @@ func New(ctx context.Context, config Config) (*Services, error) {
 	}
 
 	system.SetBinToSelf()
+	metrics.Init(metrics.Options(config.MetricsConfig))
 
 	devPort, config := configureDevMode(config)
 
@@ -825,6 +858,15 @@ func New(ctx context.Context, config Config) (*Services, error) {
 	// When EnableRegistryAuth is false (default), registry is in no-auth mode
 	registryNoAuth := !config.EnableRegistryAuth
 
+	notifier := notification.New(notification.Options{
+		SMTPHost:     config.SMTPHost,
+		SMTPPort:     config.SMTPPort,
+		SMTPUsername: config.SMTPUsername,
+		SMTPPassword: config.SMTPPassword,
+		SMTPFrom:     config.SMTPFrom,
+		AllowedHosts: config.NotificationAllowedHosts,
+	})
+
 	// For now, always auto-migrate the gateway database
 	return &Services{
 		EncryptionConfig:      encryptionConfig,
@@ -899,6 +941,11 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		RegistryNoAuth:                registryNoAuth,
 		NanobotIntegration:            config.NanobotIntegration,
 		MCPServerSearchImage:          config.MCPServerSearchImage,
+		WorkspaceEntryApprovalEnabled: config.EnableWorkspaceEntryApproval,
+		MCPToolChangeCheckInterval:    time.Duration(config.MCPToolChangeCheckIntervalMinutes) * time.Minute,
+		MCPToolChangeAutoDisable:      config.MCPToolChangeAutoDisable,
+		Notifier:                      notifier,
+		MCPHealthChecker:              mcphealth.New(mcphealth.Options(config.MCPHealthConfig), mcpSessionManager, notifier),
 	}, nil
 }
 
@@ -66,6 +66,12 @@ type CronJobSpec struct {
 type CronJobStatus struct {
 	LastRunStartedAt           *metav1.Time `json:"lastRunStartedAt,omitempty"`
 	LastSuccessfulRunCompleted *metav1.Time `json:"lastSuccessfulRunCompleted,omitempty"`
+	// LastScheduledAt is the last scheduled time that was handled, whether a run was started for it or not.
+	LastScheduledAt *metav1.Time `json:"lastScheduledAt,omitempty"`
+	// Paused is true while the schedule is paused, so that the time it was resumed can be recorded.
+	Paused bool `json:"paused,omitempty"`
+	// SkippedRuns counts the scheduled times for which no run was started because of the concurrency or catch-up policy.
+	SkippedRuns int `json:"skippedRuns,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	_ fields.Fields = (*EvalDataset)(nil)
+	_ DeleteRefs    = (*EvalDataset)(nil)
+	_ fields.Fields = (*EvalRun)(nil)
+	_ DeleteRefs    = (*EvalRun)(nil)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EvalDataset is a set of inputs and graders that eval runs invoke a project with.
+type EvalDataset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              EvalDatasetSpec `json:"spec,omitempty"`
+}
+
+func (e *EvalDataset) Has(field string) (exists bool) {
+	return slices.Contains(e.FieldNames(), field)
+}
+
+func (e *EvalDataset) Get(field string) (value string) {
+	switch field {
+	case "spec.threadName":
+		return e.Spec.ThreadName
+	}
+	return ""
+}
+
+func (e *EvalDataset) FieldNames() []string {
+	return []string{"spec.threadName"}
+}
+
+func (*EvalDataset) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Display Name", "Spec.Manifest.Name"},
+		{"Project", "Spec.ThreadName"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (e *EvalDataset) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &Thread{}, Name: e.Spec.ThreadName},
+	}
+}
+
+type EvalDatasetSpec struct {
+	Manifest types.EvalDatasetManifest `json:"manifest,omitempty"`
+	// ThreadName is the project thread that the dataset belongs to.
+	ThreadName string `json:"threadName,omitempty"`
+	UserID     string `json:"userID,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type EvalDatasetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EvalDataset `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EvalRun invokes every case of an eval dataset with one configuration and grades the results.
+type EvalRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              EvalRunSpec   `json:"spec,omitempty"`
+	Status            EvalRunStatus `json:"status,omitempty"`
+}
+
+func (e *EvalRun) Has(field string) (exists bool) {
+	return slices.Contains(e.FieldNames(), field)
+}
+
+func (e *EvalRun) Get(field string) (value string) {
+	switch field {
+	case "spec.evalDatasetName":
+		return e.Spec.EvalDatasetName
+	case "spec.threadName":
+		return e.Spec.ThreadName
+	}
+	return ""
+}
+
+func (e *EvalRun) FieldNames() []string {
+	return []string{"spec.evalDatasetName", "spec.threadName"}
+}
+
+func (*EvalRun) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Dataset", "Spec.EvalDatasetName"},
+		{"State", "Status.State"},
+		{"Pass Rate", "Status.Summary.PassRate"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (e *EvalRun) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &EvalDataset{}, Name: e.Spec.EvalDatasetName},
+		{ObjType: &Thread{}, Name: e.Spec.ThreadName},
+	}
+}
+
+type EvalRunSpec struct {
+	Manifest        types.EvalRunManifest `json:"manifest,omitempty"`
+	EvalDatasetName string                `json:"evalDatasetName,omitempty"`
+	// ThreadName is the project thread that the cases are run in.
+	ThreadName string `json:"threadName,omitempty"`
+	UserID     string `json:"userID,omitempty"`
+	// Cases is a copy of the cases of the dataset when the run was created, so that editing the dataset
+	// doesn't change the run.
+	Cases []types.EvalCase `json:"cases,omitempty"`
+}
+
+type EvalRunStatus struct {
+	State     types.EvalRunState `json:"state,omitempty"`
+	Error     string             `json:"error,omitempty"`
+	StartTime *metav1.Time       `json:"startTime,omitempty"`
+	EndTime   *metav1.Time       `json:"endTime,omitempty"`
+	Results   []EvalCaseStatus   `json:"results,omitempty"`
+	Summary   types.EvalSummary  `json:"summary,omitempty"`
+}
+
+type EvalCaseStatus struct {
+	types.EvalCaseResult `json:",inline"`
+	// ThreadName is the ephemeral thread that the case is run in.
+	ThreadName string `json:"threadName,omitempty"`
+	// JudgeThreadName is the ephemeral thread that the llmJudge grader runs in, and JudgeRunName is its run.
+	JudgeThreadName string `json:"judgeThreadName,omitempty"`
+	JudgeRunName    string `json:"judgeRunName,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type EvalRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EvalRun `json:"items"`
+}
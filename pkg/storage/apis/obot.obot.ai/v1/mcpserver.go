@@ -156,6 +156,18 @@ type MCPServerStatus struct {
 	// OAuthCredentialConfigured indicates whether OAuth credentials have been configured
 	// for this server's catalog entry. Only relevant for remote servers that require static OAuth.
 	OAuthCredentialConfigured bool `json:"oauthCredentialConfigured,omitempty"`
+	// ToolSnapshot contains the tools this server exposed the last time they were checked.
+	// This field is only populated for running multi-user MCP servers.
+	ToolSnapshot []types.MCPServerToolSnapshot `json:"toolSnapshot,omitempty"`
+	// ToolsLastChecked is the last time this server's tools were compared with its previous snapshot.
+	ToolsLastChecked *metav1.Time `json:"toolsLastChecked,omitempty"`
+	// ToolChangelog contains the most recent tool changes detected on this server, oldest first.
+	ToolChangelog []types.MCPServerToolChange `json:"toolChangelog,omitempty"`
+	// PendingApprovalTools are tools that newly appeared on this server and are disabled until an admin approves them.
+	PendingApprovalTools []string `json:"pendingApprovalTools,omitempty"`
+	// Health is the result of the periodic health probes of this server.
+	// This field is only populated for multi-user MCP servers.
+	Health *types.MCPServerHealth `json:"health,omitempty"`
 }
 
 type DeploymentCondition struct {
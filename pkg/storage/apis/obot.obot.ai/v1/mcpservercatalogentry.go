@@ -51,6 +51,17 @@ func (in *MCPServerCatalogEntry) FieldNames() []string {
 	}
 }
 
+// IsApproved returns true if this entry can be launched. Entries that have never been through
+// the approval workflow, or that have at least one approved revision, are launchable.
+func (in *MCPServerCatalogEntry) IsApproved() bool {
+	return in.Spec.Approval == nil || in.Spec.Approval.HasApprovedRevision
+}
+
+// HasPendingRevision returns true if this entry has a submitted revision awaiting admin review.
+func (in *MCPServerCatalogEntry) HasPendingRevision() bool {
+	return in.Spec.Approval != nil && in.Spec.Approval.State == types.CatalogEntryApprovalStatePending
+}
+
 func (in *MCPServerCatalogEntry) DeleteRefs() []Ref {
 	return []Ref{
 		{ObjType: &MCPCatalog{}, Name: in.Spec.MCPCatalogName},
@@ -66,6 +77,9 @@ type MCPServerCatalogEntrySpec struct {
 	SourceURL        string                              `json:"sourceURL,omitempty"`
 	// PowerUserWorkspaceID contains the name of the PowerUserWorkspace that owns this catalog entry, if there is one.
 	PowerUserWorkspaceID string `json:"powerUserWorkspaceID,omitempty"`
+	// Approval tracks the admin review of this entry. It is only set for power user workspace entries
+	// created or modified while workspace entry approval is enabled.
+	Approval *types.CatalogEntryApproval `json:"approval,omitempty"`
 }
 
 type MCPServerCatalogEntryStatus struct {
@@ -26,6 +26,7 @@ const (
 	AccessControlRuleFinalizer     = "obot.obot.ai/access-control-rule"
 	SystemMCPServerFinalizer       = "obot.obot.ai/system-mcp-server"
 	NanobotAgentFinalizer          = "obot.obot.ai/nanobot-agent"
+	WebhookFinalizer               = "obot.obot.ai/webhook"
 
 	ModelProviderSyncAnnotation         = "obot.ai/model-provider-sync"
 	WorkflowSyncAnnotation              = "obot.ai/workflow-sync"
@@ -143,10 +144,26 @@ type RunStatus struct {
 	Output                 string             `json:"output"`
 	EndTime                metav1.Time        `json:"endTime,omitempty"`
 	Error                  string             `json:"error,omitempty"`
+	ErrorReason            ErrorReason        `json:"errorReason,omitempty"`
 	ExternalCall           *ExternalCall      `json:"externalCall,omitempty"`
 	RequestedCallDecisions []string           `json:"requestedCallDecisions,omitempty"`
 }
 
+// ErrorReason classifies why a run, workflow step, or workflow execution failed, so that retries don't depend on the
+// text of the error.
+type ErrorReason string
+
+const (
+	// ErrorReasonRateLimit is set when the model provider responded with 429 Too Many Requests.
+	ErrorReasonRateLimit ErrorReason = "rateLimit"
+	// ErrorReasonMCPServerLaunch is set when an MCP server failed to launch.
+	ErrorReasonMCPServerLaunch ErrorReason = "mcpServerLaunch"
+	// ErrorReasonAborted is set when the run was aborted.
+	ErrorReasonAborted ErrorReason = "aborted"
+	// ErrorReasonRejected is set when an approval step was rejected.
+	ErrorReasonRejected ErrorReason = "rejected"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type RunList struct {
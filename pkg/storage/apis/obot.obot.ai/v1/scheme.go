@@ -119,6 +119,14 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&NanobotAgentList{},
 		&ProjectV2{},
 		&ProjectV2List{},
+		&Webhook{},
+		&WebhookList{},
+		&EvalDataset{},
+		&EvalDatasetList{},
+		&EvalRun{},
+		&EvalRunList{},
+		&ToolApprovalPolicy{},
+		&ToolApprovalPolicyList{},
 	); err != nil {
 		return err
 	}
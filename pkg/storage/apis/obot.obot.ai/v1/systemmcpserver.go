@@ -36,6 +36,8 @@ type SystemMCPServerStatus struct {
 	K8sSettingsHash string `json:"k8sSettingsHash,omitempty"`
 	// AuditLogTokenHash contains the hash of the audit log token
 	AuditLogTokenHash string `json:"auditLogTokenHash,omitempty"`
+	// Health is the result of the periodic health probes of this server
+	Health *types.MCPServerHealth `json:"health,omitempty"`
 }
 
 func (in *SystemMCPServer) ValidConnectURLs(base string) []string {
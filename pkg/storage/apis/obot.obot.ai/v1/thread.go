@@ -185,6 +185,10 @@ type ThreadSpec struct {
 	ParentThreadName string `json:"parentThreadName,omitempty"`
 	// SourceThreadName is the thread that this thread was copied from
 	SourceThreadName string `json:"sourceThreadName,omitempty"`
+	// ForkedFromThreadName is the thread that this thread was forked from
+	ForkedFromThreadName string `json:"forkedFromThreadName,omitempty"`
+	// ForkedFromRunName is the run of ForkedFromThreadName that this thread was forked at
+	ForkedFromRunName string `json:"forkedFromRunName,omitempty"`
 	// AgentName is the associated agent for this thread.
 	AgentName string `json:"agentName,omitempty"`
 	// WorkspaceName is the workspace that will be used by this thread and a new workspace will not be created
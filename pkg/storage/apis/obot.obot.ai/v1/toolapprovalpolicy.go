@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ToolApprovalPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ToolApprovalPolicySpec `json:"spec,omitempty"`
+	Status EmptyStatus            `json:"status,omitempty"`
+}
+
+type ToolApprovalPolicySpec struct {
+	Manifest types.ToolApprovalPolicyManifest `json:"manifest"`
+}
+
+func (in *ToolApprovalPolicy) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Display Name", "Spec.Manifest.DisplayName"},
+		{"Subjects", "{{len .Spec.Manifest.Subjects}}"},
+		{"Read Only", "Spec.Manifest.AutoApproveReadOnly"},
+		{"Destructive", "Spec.Manifest.ConfirmDestructive"},
+	}
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ToolApprovalPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ToolApprovalPolicy `json:"items"`
+}
@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	_ fields.Fields = (*Webhook)(nil)
+	_ DeleteRefs    = (*Webhook)(nil)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Webhook starts runs of a task when requests are posted to its URL. Its name is part of the webhook URL, so it is
+// randomly generated.
+type Webhook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              WebhookSpec   `json:"spec,omitempty"`
+	Status            WebhookStatus `json:"status,omitempty"`
+}
+
+func (w *Webhook) Has(field string) (exists bool) {
+	return slices.Contains(w.FieldNames(), field)
+}
+
+func (w *Webhook) Get(field string) (value string) {
+	switch field {
+	case "spec.threadName":
+		return w.Spec.ThreadName
+	case "spec.workflowName":
+		return w.Spec.WorkflowName
+	}
+	return ""
+}
+
+func (w *Webhook) FieldNames() []string {
+	return []string{"spec.threadName", "spec.workflowName"}
+}
+
+func (*Webhook) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Workflow", "Spec.WorkflowName"},
+		{"Scheme", "Spec.SignatureScheme"},
+		{"Last Delivery", "{{ago .Status.LastDeliveryAt}}"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (w *Webhook) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &Workflow{}, Name: w.Spec.WorkflowName},
+	}
+}
+
+type WebhookSpec struct {
+	// WebhookManifest is the webhook's configuration. The secret is kept in the credential store instead.
+	types.WebhookManifest `json:",inline"`
+	ThreadName            string `json:"threadName,omitempty"`
+	// HasSecret is true when a secret is stored in the credential store for this webhook.
+	HasSecret bool `json:"hasSecret,omitempty"`
+}
+
+type WebhookStatus struct {
+	LastDeliveryAt *metav1.Time `json:"lastDeliveryAt,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type WebhookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Webhook `json:"items"`
+}
@@ -33,6 +33,14 @@ func (in *WorkflowExecution) Get(field string) string {
 			return in.Spec.CronJobName
 		case "spec.workflowName":
 			return in.Spec.WorkflowName
+		case "spec.webhookName":
+			return in.Spec.WebhookName
+		case "spec.parentWorkflowExecutionName":
+			return in.Spec.ParentWorkflowExecutionName
+		case "status.awaitingApproval":
+			if in.AwaitingApproval() {
+				return "true"
+			}
 		}
 	}
 
@@ -46,9 +54,21 @@ func (in *WorkflowExecution) FieldNames() []string {
 		"spec.cronJobName",
 		"spec.workflowName",
 		"spec.parentRunName",
+		"spec.parentWorkflowExecutionName",
+		"status.awaitingApproval",
 	}
 }
 
+// AwaitingApproval returns true if an approval step of the current workflow generation is waiting for a decision.
+func (in *WorkflowExecution) AwaitingApproval() bool {
+	for _, pending := range in.Status.PendingApprovals {
+		if pending.WorkflowGeneration == in.Spec.WorkflowGeneration {
+			return true
+		}
+	}
+	return false
+}
+
 func (in *WorkflowExecution) GetColumns() [][]string {
 	return [][]string{
 		{"Name", "Name"},
@@ -65,6 +85,7 @@ type WorkflowExecutionSpec struct {
 	ThreadName         string `json:"threadName,omitempty"`
 	WorkflowName       string `json:"workflowName,omitempty"`
 	CronJobName        string `json:"cronJobName,omitempty"`
+	WebhookName        string `json:"webhookName,omitempty"`
 	WorkflowGeneration int64  `json:"workflowGeneration,omitempty"`
 	RunUntilStep       string `json:"runUntilStep,omitempty"`
 	// The Run that started this execution
@@ -72,6 +93,38 @@ type WorkflowExecutionSpec struct {
 	// TaskBreadCrumb is a comma-delimited list of taskID calls made to execute this task.
 	// This helps to prevent cycles when tasks call tasks.
 	TaskBreakCrumb string `json:"taskBreakCrumb,omitempty"`
+	// ParentWorkflowExecutionName is set when this execution was started by a sub-workflow step.
+	ParentWorkflowExecutionName string `json:"parentWorkflowExecutionName,omitempty"`
+	// ApprovalDecisions are the decisions made on the approval steps of this execution.
+	ApprovalDecisions []ApprovalDecision `json:"approvalDecisions,omitempty"`
+	// WebhookDelivery describes the webhook request that started this execution.
+	WebhookDelivery *WebhookDelivery `json:"webhookDelivery,omitempty"`
+	// Attempt is set when this execution retries failed executions, starting at 2 for the first retry.
+	Attempt int `json:"attempt,omitempty"`
+	// PreviousAttempts are the failed executions that this execution retries, oldest first.
+	PreviousAttempts []WorkflowExecutionAttempt `json:"previousAttempts,omitempty"`
+}
+
+type WorkflowExecutionAttempt struct {
+	WorkflowExecutionName string       `json:"workflowExecutionName,omitempty"`
+	Error                 string       `json:"error,omitempty"`
+	StartTime             metav1.Time  `json:"startTime,omitempty"`
+	EndTime               *metav1.Time `json:"endTime,omitempty"`
+}
+
+type WebhookDelivery struct {
+	DeliveryID string      `json:"deliveryID,omitempty"`
+	Event      string      `json:"event,omitempty"`
+	ReceivedAt metav1.Time `json:"receivedAt,omitempty"`
+}
+
+type ApprovalDecision struct {
+	StepID             string      `json:"stepID,omitempty"`
+	WorkflowGeneration int64       `json:"workflowGeneration,omitempty"`
+	Approved           bool        `json:"approved,omitempty"`
+	Comment            string      `json:"comment,omitempty"`
+	UserID             string      `json:"userID,omitempty"`
+	DecidedAt          metav1.Time `json:"decidedAt,omitempty"`
 }
 
 func (in *WorkflowExecution) DeleteRefs() []Ref {
@@ -79,18 +132,48 @@ func (in *WorkflowExecution) DeleteRefs() []Ref {
 		{ObjType: &Workflow{}, Name: in.Spec.WorkflowName},
 		{ObjType: &Thread{}, Name: in.Status.ThreadName},
 		{ObjType: &Run{}, Name: in.Spec.RunName},
+		{ObjType: &WorkflowExecution{}, Name: in.Spec.ParentWorkflowExecutionName},
 	}
 }
 
 type WorkflowExecutionStatus struct {
-	State              types.WorkflowState     `json:"state,omitempty"`
-	Output             string                  `json:"output,omitempty"`
-	Warning            string                  `json:"warning,omitempty"`
+	State   types.WorkflowState `json:"state,omitempty"`
+	Output  string              `json:"output,omitempty"`
+	Warning string              `json:"warning,omitempty"`
+	// StructuredOutput is the output as JSON text, when the workflow has an output schema.
+	StructuredOutput   string                  `json:"structuredOutput,omitempty"`
 	Error              string                  `json:"error,omitempty"`
+	ErrorReason        ErrorReason             `json:"errorReason,omitempty"`
 	ThreadName         string                  `json:"threadName,omitempty"`
 	WorkflowManifest   *types.WorkflowManifest `json:"workflowManifest,omitempty"`
 	EndTime            *metav1.Time            `json:"endTime,omitempty"`
 	WorkflowGeneration int64                   `json:"workflowGeneration,omitempty"`
+	// SubWorkflowExecutions are the executions started by the sub-workflow steps of this execution.
+	SubWorkflowExecutions []SubWorkflowExecution `json:"subWorkflowExecutions,omitempty"`
+	// PendingApprovals are the approval steps of this execution that are waiting for a decision.
+	PendingApprovals []PendingApproval `json:"pendingApprovals,omitempty"`
+	// RetryAt is when the failed execution will be retried.
+	RetryAt *metav1.Time `json:"retryAt,omitempty"`
+	// RetryWorkflowExecutionName is the execution that retries this one.
+	RetryWorkflowExecutionName string `json:"retryWorkflowExecutionName,omitempty"`
+	// NotifiedAt is when the notifications for the end of this execution were sent.
+	NotifiedAt *metav1.Time `json:"notifiedAt,omitempty"`
+	// NotificationError is the last error from sending notifications.
+	NotificationError string `json:"notificationError,omitempty"`
+}
+
+type PendingApproval struct {
+	StepID             string         `json:"stepID,omitempty"`
+	WorkflowGeneration int64          `json:"workflowGeneration,omitempty"`
+	Approval           types.Approval `json:"approval,omitempty"`
+	RequestedAt        metav1.Time    `json:"requestedAt,omitempty"`
+	ExpiresAt          metav1.Time    `json:"expiresAt,omitempty"`
+}
+
+type SubWorkflowExecution struct {
+	StepID                string `json:"stepID,omitempty"`
+	WorkflowName          string `json:"workflowName,omitempty"`
+	WorkflowExecutionName string `json:"workflowExecutionName,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -78,10 +78,14 @@ type WorkflowStepStatus struct {
 	WorkflowGeneration int64               `json:"workflowGeneration,omitempty"`
 	State              types.WorkflowState `json:"state,omitempty"`
 	Error              string              `json:"message,omitempty"`
+	ErrorReason        ErrorReason         `json:"errorReason,omitempty"`
 	RunMessage         string              `json:"runMessage,omitempty"`
 	ThreadName         string              `json:"threadName,omitempty"`
 	RunNames           []string            `json:"runNames,omitempty"`
 	LastRunName        string              `json:"lastRunName,omitempty"`
+	// Output is the result of a step that completes without running the agent, like a sub-workflow step. Such steps
+	// have no runs, and the steps after them continue from the last run before them.
+	Output string `json:"output,omitempty"`
 }
 
 func (in WorkflowStepStatus) FirstRun() string {
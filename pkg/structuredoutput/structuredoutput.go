@@ -0,0 +1,49 @@
+// Package structuredoutput parses and validates JSON responses of models against JSON Schemas.
+package structuredoutput
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+)
+
+// ResolveSchema parses the JSON Schema and resolves it for validation.
+func ResolveSchema(schema json.RawMessage) (*jsonschema.Resolved, error) {
+	var s jsonschema.Schema
+	if err := json.Unmarshal(schema, &s); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	resolved, err := s.Resolve(nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	return resolved, nil
+}
+
+// Parse extracts the JSON value from the output and validates it against the schema.
+func Parse(schema *jsonschema.Resolved, output string) (json.RawMessage, error) {
+	output = TrimCodeFence(output)
+
+	var value any
+	if err := json.Unmarshal([]byte(output), &value); err != nil {
+		return nil, fmt.Errorf("output is not valid JSON: %w", err)
+	}
+	if err := schema.Validate(value); err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(output), nil
+}
+
+// TrimCodeFence removes the code fence that models sometimes wrap JSON in despite being told not to.
+func TrimCodeFence(output string) string {
+	output = strings.TrimSpace(output)
+	if trimmed, ok := strings.CutPrefix(output, "```"); ok {
+		trimmed = strings.TrimPrefix(trimmed, "json")
+		trimmed, _ = strings.CutSuffix(strings.TrimSpace(trimmed), "```")
+		output = strings.TrimSpace(trimmed)
+	}
+	return output
+}
@@ -0,0 +1,45 @@
+package structuredoutput
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	schema, err := ResolveSchema(json.RawMessage(`{"type":"object","properties":{"count":{"type":"integer"}},"required":["count"]}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		output  string
+		want    string
+		wantErr bool
+	}{
+		{name: "valid", output: ` {"count": 3} `, want: `{"count": 3}`},
+		{name: "code fence", output: "```json\n{\"count\": 3}\n```", want: `{"count": 3}`},
+		{name: "not JSON", output: "There are 3.", wantErr: true},
+		{name: "missing property", output: `{"total": 3}`, wantErr: true},
+		{name: "wrong type", output: `{"count": "3"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(schema, tt.output)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestResolveSchema(t *testing.T) {
+	_, err := ResolveSchema(json.RawMessage(`{"type":`))
+	require.Error(t, err)
+	_, err = ResolveSchema(json.RawMessage(`{"type":"object","$ref":"#/missing"}`))
+	require.Error(t, err)
+}
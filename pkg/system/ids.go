@@ -51,6 +51,9 @@ const (
 	ModelAccessPolicyPrefix       = "map1"
 	NanobotAgentPrefix            = "nba1"
 	ProjectV2Prefix               = "pv21"
+	EvalDatasetPrefix             = "ed1"
+	EvalRunPrefix                 = "evr1"
+	ToolApprovalPolicyPrefix      = "tap1"
 
 	ObotMCPServerName = SystemMCPServerPrefix + "obot-mcp-server"
 )
@@ -38,5 +38,9 @@ const (
 
 	MCPWebhookValidationCredentialContext = "mcp-webhook-context"
 
+	// WebhookCredential is the tool name of the credential holding a webhook's secret.
+	// The credential context is the name of the webhook.
+	WebhookCredential = "webhook"
+
 	JWKCredentialContext = "jwk"
 )
@@ -0,0 +1,192 @@
+package toolapprovalpolicy
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	nmcp "github.com/nanobot-ai/nanobot/pkg/mcp"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// annotationsMetadataKey is the tool metadata key that MCP tool annotations are stored under.
+const annotationsMetadataKey = "mcp-tool-annotations"
+
+// Result is the outcome of evaluating tool approval policies for a tool call.
+type Result struct {
+	// Decision is empty when no policy applies to the tool call.
+	Decision types.ToolApprovalDecision
+	// PolicyName is the name of the policy that made the decision.
+	PolicyName string
+	// NonInteractive replaces a confirm decision when there is no user to confirm the tool call.
+	NonInteractive types.ToolApprovalDecision
+}
+
+// Source describes where the decision came from, for logging.
+func (r Result) Source() string {
+	if r.PolicyName == "" {
+		return ""
+	}
+	return "tool approval policy " + r.PolicyName
+}
+
+// Annotations are the MCP tool annotations that policies use.
+type Annotations struct {
+	ReadOnly    bool
+	Destructive bool
+}
+
+// AnnotationsFromMetadata returns the MCP tool annotations stored in the metadata of a tool.
+// Tools without annotations are neither read-only nor destructive.
+func AnnotationsFromMetadata(metadata map[string]string) Annotations {
+	raw := metadata[annotationsMetadataKey]
+	if raw == "" {
+		return Annotations{}
+	}
+
+	var annotations nmcp.ToolAnnotations
+	if err := json.Unmarshal([]byte(raw), &annotations); err != nil {
+		return Annotations{}
+	}
+
+	return Annotations{
+		ReadOnly:    annotations.ReadOnlyHint,
+		Destructive: annotations.DestructiveHint != nil && *annotations.DestructiveHint,
+	}
+}
+
+// ForUser returns the policies that apply to the user, either directly, through one of the groups of the user, or
+// through the wildcard selector.
+func ForUser(policies []v1.ToolApprovalPolicy, userID string, groupIDs []string) []v1.ToolApprovalPolicy {
+	var result []v1.ToolApprovalPolicy
+	for _, policy := range policies {
+		if !policy.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if specificity(policy, userID, groupIDs) > 0 {
+			result = append(result, policy)
+		}
+	}
+	return result
+}
+
+// Evaluate decides what to do with a call of the tool. Policies that name the user or one of the groups of the user
+// override policies for everyone. When several policies at the same level decide, the strictest decision wins: deny,
+// then confirm, then approve.
+func Evaluate(policies []v1.ToolApprovalPolicy, userID string, groupIDs []string, toolName string, annotations Annotations) Result {
+	var specific, wildcard Result
+	for _, policy := range policies {
+		level := specificity(policy, userID, groupIDs)
+		if level == 0 {
+			continue
+		}
+
+		decision := evaluatePolicy(policy.Spec.Manifest, toolName, annotations)
+		if decision == "" {
+			continue
+		}
+
+		var nonInteractive types.ToolApprovalDecision
+		if decision == types.ToolApprovalDecisionConfirm {
+			nonInteractive = NonInteractiveDecision(policy.Spec.Manifest)
+		}
+
+		result := &wildcard
+		if level > 1 {
+			result = &specific
+		}
+		if strictness(decision) > strictness(result.Decision) ||
+			decision == result.Decision && strictness(nonInteractive) > strictness(result.NonInteractive) {
+			*result = Result{
+				Decision:       decision,
+				PolicyName:     policy.Name,
+				NonInteractive: nonInteractive,
+			}
+		}
+	}
+
+	if specific.Decision != "" {
+		return specific
+	}
+	return wildcard
+}
+
+func evaluatePolicy(manifest types.ToolApprovalPolicyManifest, toolName string, annotations Annotations) types.ToolApprovalDecision {
+	switch {
+	case MatchesTool(toolName, manifest.DeniedTools):
+		return types.ToolApprovalDecisionDeny
+	case MatchesTool(toolName, manifest.ConfirmTools), manifest.ConfirmDestructive && annotations.Destructive:
+		return types.ToolApprovalDecisionConfirm
+	case MatchesTool(toolName, manifest.ApprovedTools), manifest.AutoApproveReadOnly && annotations.ReadOnly:
+		return types.ToolApprovalDecisionApprove
+	}
+	return ""
+}
+
+// NonInteractiveDecision returns the decision that replaces confirmation of tool calls under the policy when there is
+// no user to confirm them.
+func NonInteractiveDecision(manifest types.ToolApprovalPolicyManifest) types.ToolApprovalDecision {
+	if manifest.NonInteractive == types.ToolApprovalDecisionDeny {
+		return types.ToolApprovalDecisionDeny
+	}
+	return types.ToolApprovalDecisionApprove
+}
+
+// MatchesTool returns true if the tool name matches one of the patterns. A pattern is either the full name of a tool
+// or a prefix followed by the wildcard "*", so "*" matches every tool. It is used for the approved tools of threads as
+// well as for policies.
+func MatchesTool(toolName string, patterns []string) bool {
+	if toolName == "" {
+		return false
+	}
+
+	for _, pattern := range patterns {
+		if prefix, hasWildcard := strings.CutSuffix(pattern, "*"); hasWildcard {
+			if strings.HasPrefix(toolName, prefix) {
+				return true
+			}
+			continue
+		}
+
+		if toolName == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// specificity returns 0 if the policy doesn't apply to the user, 1 if it applies to everyone, and 2 if it names the
+// user or one of the groups of the user.
+func specificity(policy v1.ToolApprovalPolicy, userID string, groupIDs []string) int {
+	var level int
+	for _, subject := range policy.Spec.Manifest.Subjects {
+		switch subject.Type {
+		case types.SubjectTypeSelector:
+			if subject.ID == "*" {
+				level = max(level, 1)
+			}
+		case types.SubjectTypeUser:
+			if userID != "" && subject.ID == userID {
+				return 2
+			}
+		case types.SubjectTypeGroup:
+			if slices.Contains(groupIDs, subject.ID) {
+				return 2
+			}
+		}
+	}
+	return level
+}
+
+func strictness(decision types.ToolApprovalDecision) int {
+	switch decision {
+	case types.ToolApprovalDecisionDeny:
+		return 3
+	case types.ToolApprovalDecisionConfirm:
+		return 2
+	case types.ToolApprovalDecisionApprove:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,177 @@
+package toolapprovalpolicy
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func policy(name string, subject types.Subject, manifest types.ToolApprovalPolicyManifest) v1.ToolApprovalPolicy {
+	manifest.Subjects = []types.Subject{subject}
+	return v1.ToolApprovalPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       v1.ToolApprovalPolicySpec{Manifest: manifest},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	var (
+		everyone    = types.Subject{Type: types.SubjectTypeSelector, ID: "*"}
+		engineering = types.Subject{Type: types.SubjectTypeGroup, ID: "engineering"}
+		policies    = []v1.ToolApprovalPolicy{
+			policy("org", everyone, types.ToolApprovalPolicyManifest{
+				AutoApproveReadOnly: true,
+				ConfirmDestructive:  true,
+				DeniedTools:         []string{"GitHub -> delete_*"},
+			}),
+			policy("org-confirm", everyone, types.ToolApprovalPolicyManifest{
+				ConfirmTools:   []string{"Slack -> *"},
+				NonInteractive: types.ToolApprovalDecisionDeny,
+			}),
+			policy("engineering", engineering, types.ToolApprovalPolicyManifest{
+				ApprovedTools: []string{"GitHub -> delete_branch"},
+			}),
+		}
+		readOnly    = Annotations{ReadOnly: true}
+		destructive = Annotations{Destructive: true}
+	)
+
+	tests := []struct {
+		name        string
+		groupIDs    []string
+		toolName    string
+		annotations Annotations
+		want        Result
+	}{
+		{
+			name:        "read only tool is approved",
+			toolName:    "GitHub -> list_issues",
+			annotations: readOnly,
+			want:        Result{Decision: types.ToolApprovalDecisionApprove, PolicyName: "org"},
+		},
+		{
+			name:        "destructive tool needs confirmation",
+			toolName:    "GitHub -> close_issue",
+			annotations: destructive,
+			want:        Result{Decision: types.ToolApprovalDecisionConfirm, PolicyName: "org", NonInteractive: types.ToolApprovalDecisionApprove},
+		},
+		{
+			name:        "denied tool is denied even if read only",
+			toolName:    "GitHub -> delete_branch",
+			annotations: readOnly,
+			want:        Result{Decision: types.ToolApprovalDecisionDeny, PolicyName: "org"},
+		},
+		{
+			name:        "strictest decision wins at the same level",
+			toolName:    "Slack -> list_channels",
+			annotations: readOnly,
+			want:        Result{Decision: types.ToolApprovalDecisionConfirm, PolicyName: "org-confirm", NonInteractive: types.ToolApprovalDecisionDeny},
+		},
+		{
+			name:        "strictest non-interactive decision wins between confirmations",
+			toolName:    "Slack -> delete_channel",
+			annotations: destructive,
+			want:        Result{Decision: types.ToolApprovalDecisionConfirm, PolicyName: "org-confirm", NonInteractive: types.ToolApprovalDecisionDeny},
+		},
+		{
+			name:     "group policy overrides the policy for everyone",
+			groupIDs: []string{"engineering"},
+			toolName: "GitHub -> delete_branch",
+			want:     Result{Decision: types.ToolApprovalDecisionApprove, PolicyName: "engineering"},
+		},
+		{
+			name:     "no decision for tools without a matching rule",
+			groupIDs: []string{"engineering"},
+			toolName: "GitHub -> create_issue",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Evaluate(policies, "1", tt.groupIDs, tt.toolName, tt.annotations))
+		})
+	}
+}
+
+func TestMatchesTool(t *testing.T) {
+	tests := []struct {
+		name          string
+		toolName      string
+		approvedTools []string
+		expected      bool
+	}{
+		{
+			name:          "exact match",
+			toolName:      "myTool",
+			approvedTools: []string{"myTool"},
+			expected:      true,
+		},
+		{
+			name:          "no match",
+			toolName:      "myTool",
+			approvedTools: []string{"otherTool"},
+			expected:      false,
+		},
+		{
+			name:          "empty approved list",
+			toolName:      "myTool",
+			approvedTools: nil,
+			expected:      false,
+		},
+		{
+			name:          "wildcard matches all",
+			toolName:      "anything",
+			approvedTools: []string{"*"},
+			expected:      true,
+		},
+		{
+			name:          "prefix wildcard match",
+			toolName:      "fooBar",
+			approvedTools: []string{"foo*"},
+			expected:      true,
+		},
+		{
+			name:          "prefix wildcard no match",
+			toolName:      "barBaz",
+			approvedTools: []string{"foo*"},
+			expected:      false,
+		},
+		{
+			name:          "multiple entries match later",
+			toolName:      "baz",
+			approvedTools: []string{"foo", "bar", "baz"},
+			expected:      true,
+		},
+		{
+			name:          "empty tool name",
+			toolName:      "",
+			approvedTools: []string{"foo"},
+			expected:      false,
+		},
+		{
+			name:          "wildcard with empty tool name",
+			toolName:      "",
+			approvedTools: []string{"*"},
+			expected:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, MatchesTool(tt.toolName, tt.approvedTools))
+		})
+	}
+}
+
+func TestAnnotationsFromMetadata(t *testing.T) {
+	require.Equal(t, Annotations{ReadOnly: true}, AnnotationsFromMetadata(map[string]string{
+		annotationsMetadataKey: `{"readOnlyHint":true,"destructiveHint":false}`,
+	}))
+	require.Equal(t, Annotations{Destructive: true}, AnnotationsFromMetadata(map[string]string{
+		annotationsMetadataKey: `{"destructiveHint":true}`,
+	}))
+	require.Equal(t, Annotations{}, AnnotationsFromMetadata(nil))
+}
@@ -3,8 +3,10 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -498,7 +500,14 @@ func (v CompositeValidator) ValidateConfig(manifest types.MCPServerManifest) err
 		componentServerIDs[componentID] = struct{}{}
 	}
 
-	return nil
+	componentTools := make(map[string][]types.ToolOverride, len(manifest.CompositeConfig.ComponentServers))
+	for _, component := range manifest.CompositeConfig.ComponentServers {
+		if !component.Disabled {
+			componentTools[component.ComponentID()] = component.ToolOverrides
+		}
+	}
+
+	return validateToolConflictPolicy(manifest.CompositeConfig.ToolConflictPolicy, componentTools)
 }
 
 func (v CompositeValidator) ValidateCatalogConfig(manifest types.MCPServerCatalogEntryManifest) error {
@@ -579,6 +588,52 @@ func (v CompositeValidator) ValidateCatalogConfig(manifest types.MCPServerCatalo
 		componentServerIDs[componentID] = struct{}{}
 	}
 
+	componentTools := make(map[string][]types.ToolOverride, len(manifest.CompositeConfig.ComponentServers))
+	for _, component := range manifest.CompositeConfig.ComponentServers {
+		componentTools[component.ComponentID()] = component.ToolOverrides
+	}
+
+	return validateToolConflictPolicy(manifest.CompositeConfig.ToolConflictPolicy, componentTools)
+}
+
+// validateToolConflictPolicy checks that the policy is known and, for the error policy,
+// that no two components expose an enabled tool with the same name.
+func validateToolConflictPolicy(policy types.ToolConflictPolicy, componentTools map[string][]types.ToolOverride) error {
+	switch policy {
+	case "", types.ToolConflictPolicyPrefix, types.ToolConflictPolicyFirst:
+		return nil
+	case types.ToolConflictPolicyError:
+	default:
+		return types.RuntimeValidationError{
+			Runtime: types.RuntimeComposite,
+			Field:   "compositeConfig.toolConflictPolicy",
+			Message: fmt.Sprintf("unknown tool conflict policy: %s", policy),
+		}
+	}
+
+	exposedBy := make(map[string]string)
+	for _, componentID := range slices.Sorted(maps.Keys(componentTools)) {
+		for _, override := range componentTools[componentID] {
+			if !override.Enabled {
+				continue
+			}
+
+			effectiveName := override.OverrideName
+			if effectiveName == "" {
+				effectiveName = override.Name
+			}
+
+			if other, ok := exposedBy[effectiveName]; ok {
+				return types.RuntimeValidationError{
+					Runtime: types.RuntimeComposite,
+					Field:   "compositeConfig.componentServers",
+					Message: fmt.Sprintf("tool %s is exposed by both %s and %s", effectiveName, other, componentID),
+				}
+			}
+			exposedBy[effectiveName] = componentID
+		}
+	}
+
 	return nil
 }
 
@@ -607,6 +662,10 @@ func validateToolOverrides(overrides []types.ToolOverride) error {
 		}
 		toolNames[override.Name] = struct{}{}
 
+		if err := validateToolArgumentOverrides(i, override.Arguments); err != nil {
+			return err
+		}
+
 		// For disabled tools, we don't care about exposed-name conflicts.
 		if !override.Enabled {
 			continue
@@ -632,6 +691,38 @@ func validateToolOverrides(overrides []types.ToolOverride) error {
 	return nil
 }
 
+func validateToolArgumentOverrides(toolIndex int, arguments []types.ToolArgumentOverride) error {
+	argumentNames := make(map[string]struct{}, len(arguments))
+	for i, argument := range arguments {
+		if argument.Name == "" {
+			return types.RuntimeValidationError{
+				Runtime: types.RuntimeComposite,
+				Field:   fmt.Sprintf("toolOverrides[%d].arguments[%d].name", toolIndex, i),
+				Message: "argument name is required",
+			}
+		}
+
+		if _, ok := argumentNames[argument.Name]; ok {
+			return types.RuntimeValidationError{
+				Runtime: types.RuntimeComposite,
+				Field:   fmt.Sprintf("toolOverrides[%d].arguments[%d].name", toolIndex, i),
+				Message: fmt.Sprintf("duplicate argument name: %s", argument.Name),
+			}
+		}
+		argumentNames[argument.Name] = struct{}{}
+
+		if argument.Hidden && !argument.HasDefault() {
+			return types.RuntimeValidationError{
+				Runtime: types.RuntimeComposite,
+				Field:   fmt.Sprintf("toolOverrides[%d].arguments[%d].default", toolIndex, i),
+				Message: fmt.Sprintf("hidden argument %s requires a default", argument.Name),
+			}
+		}
+	}
+
+	return nil
+}
+
 // getRuntimeValidators returns a map of all available runtime validators
 func getRuntimeValidators() RuntimeValidators {
 	return RuntimeValidators{
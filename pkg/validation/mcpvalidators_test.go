@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"encoding/json"
 	"errors"
 	"strings"
 	"testing"
@@ -1194,6 +1195,47 @@ func TestValidateToolOverrides(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "hidden argument with a default",
+			overrides: []types.ToolOverride{
+				{
+					Name:      "tool-1",
+					Enabled:   true,
+					Arguments: []types.ToolArgumentOverride{{Name: "owner", Default: json.RawMessage(`"our-org"`), Hidden: true}},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "hidden argument without a default",
+			overrides: []types.ToolOverride{
+				{
+					Name:      "tool-1",
+					Enabled:   true,
+					Arguments: []types.ToolArgumentOverride{{Name: "owner", Hidden: true}},
+				},
+			},
+			expectedError: types.RuntimeValidationError{
+				Runtime: types.RuntimeComposite,
+				Field:   "toolOverrides[0].arguments[0].default",
+				Message: "hidden argument owner requires a default",
+			},
+		},
+		{
+			name: "hidden argument with a null default",
+			overrides: []types.ToolOverride{
+				{
+					Name:      "tool-1",
+					Enabled:   true,
+					Arguments: []types.ToolArgumentOverride{{Name: "owner", Default: json.RawMessage("null"), Hidden: true}},
+				},
+			},
+			expectedError: types.RuntimeValidationError{
+				Runtime: types.RuntimeComposite,
+				Field:   "toolOverrides[0].arguments[0].default",
+				Message: "hidden argument owner requires a default",
+			},
+		},
 	}
 
 	for _, tt := range tests {
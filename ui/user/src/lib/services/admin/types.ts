@@ -402,6 +402,7 @@ export interface AuditLog {
 	error?: string;
 	sessionID?: string;
 	requestID?: string;
+	traceID?: string;
 }
 
 export interface AuditLogToolCallStatItem {